@@ -8,6 +8,7 @@ import (
 	"github.com/krisch/crm-backend/internal/configs"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/logs"
+	"github.com/krisch/crm-backend/internal/outbox"
 	"github.com/krisch/crm-backend/pkg/postgres"
 
 	"github.com/sirupsen/logrus"
@@ -78,4 +79,25 @@ func main() {
 
 		time.Sleep(time.Second * 5)
 	}
+
+	if opt.OUTBOX_CAPTURE != "" {
+		if opt.OUTBOX_CAPTURE != "on" && opt.OUTBOX_CAPTURE != "off" {
+			logrus.Error("OUTBOX_CAPTURE should be on or off")
+			return
+		}
+
+		logrus.Debug("outbox capture: ", opt.OUTBOX_CAPTURE)
+		gdb, err := postgres.NewGDB(opt.DB_CREDS, false)
+		if err != nil {
+			logrus.Error(err)
+			return
+		}
+
+		err = outbox.NewRepository(gdb).SetCapture(opt.OUTBOX_CAPTURE == "on")
+		if err != nil {
+			logrus.Error(err)
+		} else {
+			logrus.Info("outbox capture: ", opt.OUTBOX_CAPTURE)
+		}
+	}
 }
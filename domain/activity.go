@@ -13,4 +13,11 @@ var (
 	ActivityTaskTeamArray      = ActivityType(6)
 	ActivityTaskWasDeleted     = ActivityType(8)
 	ActivityTaskFileWasDeleted = ActivityType(9)
+	ActivityTaskLinked         = ActivityType(13)
+	ActivityTaskUnlinked       = ActivityType(14)
+	ActivityTaskChecklist      = ActivityType(15)
+
+	ActivityDealCreated    = ActivityType(10)
+	ActivityDealField      = ActivityType(11)
+	ActivityDealWasDeleted = ActivityType(12)
 )
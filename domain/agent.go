@@ -1,9 +1,17 @@
 package domain
 
 import (
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	AgentContactPhone = "phone"
+	AgentContactEmail = "email"
 )
 
 type Agent struct {
@@ -27,6 +35,20 @@ type AgentContacts struct {
 	Val  string `json:"val"`
 }
 
+// Key identifies the contact, phones and emails are normalized.
+func (c AgentContacts) Key() string {
+	tp := strings.ToLower(strings.TrimSpace(c.Type))
+
+	switch tp {
+	case AgentContactPhone:
+		return tp + ":" + NormalizePhone(c.Val)
+	case AgentContactEmail:
+		return tp + ":" + strings.ToLower(strings.TrimSpace(c.Val))
+	}
+
+	return tp + ":" + strings.TrimSpace(c.Val)
+}
+
 type AgentFilter struct {
 	FederationUUID uuid.UUID  `json:"federation_uuid"`
 	CompanyUUID    *uuid.UUID `json:"company_uuid"`
@@ -46,3 +68,40 @@ func NewAgent(federationUUID uuid.UUID, companyUUID *uuid.UUID, me Me, name stri
 		Contacts:       contacts,
 	}
 }
+
+// Phones returns phone contacts normalized the same way sms recipients are matched.
+func (a Agent) Phones() []string {
+	phones := []string{}
+	for _, c := range a.Contacts {
+		if strings.EqualFold(c.Type, AgentContactPhone) {
+			phones = append(phones, NormalizePhone(c.Val))
+		}
+	}
+
+	return lo.WithoutEmpty(lo.Uniq(phones))
+}
+
+// NormalizePhone reduces the phone to digits, a russian number to the 7XXXXXXXXXX form:
+// 8XXXXXXXXXX and the bare ten digits are the same number.
+func NormalizePhone(phone string) string {
+	digits := phoneDigits(phone)
+
+	switch {
+	case len(digits) == 11 && digits[0] == '8':
+		return "7" + digits[1:]
+	case len(digits) == 10:
+		return "7" + digits
+	}
+
+	return digits
+}
+
+func phoneDigits(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+
+		return -1
+	}, phone)
+}
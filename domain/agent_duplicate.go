@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+const (
+	AgentDuplicatePhone = "phone"
+	AgentDuplicateEmail = "email"
+	AgentDuplicateName  = "name"
+)
+
+// AgentDuplicates is a group of agents that look like the same counterparty.
+type AgentDuplicates struct {
+	Agents  []Agent
+	Reasons []string
+}
+
+// Emails returns lowercase email contacts.
+func (a Agent) Emails() []string {
+	emails := []string{}
+	for _, c := range a.Contacts {
+		if strings.EqualFold(c.Type, AgentContactEmail) {
+			emails = append(emails, strings.ToLower(strings.TrimSpace(c.Val)))
+		}
+	}
+
+	return lo.WithoutEmpty(lo.Uniq(emails))
+}
+
+// AgentNameKey transliterates the name and keeps only letters and digits, so "ООО Ромашка" and "OOO Romashka" match.
+func AgentNameKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+
+		return -1
+	}, strings.ToLower(helpers.ICAO(strings.ToLower(name))))
+}
+
+// SimilarAgentNames allows one typo per five letters, short names must match exactly.
+func SimilarAgentNames(a, b string) bool {
+	ka, kb := AgentNameKey(a), AgentNameKey(b)
+	if ka == "" || kb == "" {
+		return false
+	}
+
+	if ka == kb {
+		return true
+	}
+
+	n := min(len([]rune(ka)), len([]rune(kb)))
+	if n < 5 {
+		return false
+	}
+
+	return helpers.MinDistance(ka, kb) <= min(n/5, 2)
+}
+
+// DuplicateReasons returns why b looks like a duplicate of a, empty if it does not.
+func (a Agent) DuplicateReasons(b Agent) []string {
+	reasons := []string{}
+
+	if len(lo.Intersect(a.Phones(), b.Phones())) > 0 {
+		reasons = append(reasons, AgentDuplicatePhone)
+	}
+
+	if len(lo.Intersect(a.Emails(), b.Emails())) > 0 {
+		reasons = append(reasons, AgentDuplicateEmail)
+	}
+
+	if SimilarAgentNames(a.Name, b.Name) {
+		reasons = append(reasons, AgentDuplicateName)
+	}
+
+	return reasons
+}
+
+// MergeContacts unions contacts of others into the agent, phones and emails are compared normalized.
+func (a *Agent) MergeContacts(others ...Agent) {
+	contacts := append([]AgentContacts{}, a.Contacts...)
+	for _, o := range others {
+		contacts = append(contacts, o.Contacts...)
+	}
+
+	a.Contacts = lo.UniqBy(contacts, AgentContacts.Key)
+}
+
+// GroupAgentDuplicates groups agents sharing a phone, an email or a similar name.
+// Names are compared only inside buckets of the same first letters of the name key.
+func GroupAgentDuplicates(agents []Agent) []AgentDuplicates {
+	parent := make([]int, len(agents))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+
+		return parent[i]
+	}
+
+	reasons := make(map[int][]string)
+	union := func(i, j int, reason string) {
+		ri, rj := find(i), find(j)
+		if ri != rj {
+			parent[rj] = ri
+			reasons[ri] = append(reasons[ri], reasons[rj]...)
+			delete(reasons, rj)
+		}
+
+		reasons[ri] = append(reasons[ri], reason)
+	}
+
+	byPhone := make(map[string]int)
+	byEmail := make(map[string]int)
+	byName := make(map[string][]int)
+
+	for i, a := range agents {
+		for _, p := range a.Phones() {
+			if j, ok := byPhone[p]; ok {
+				union(j, i, AgentDuplicatePhone)
+			} else {
+				byPhone[p] = i
+			}
+		}
+
+		for _, e := range a.Emails() {
+			if j, ok := byEmail[e]; ok {
+				union(j, i, AgentDuplicateEmail)
+			} else {
+				byEmail[e] = i
+			}
+		}
+
+		key := []rune(AgentNameKey(a.Name))
+		if len(key) == 0 {
+			continue
+		}
+
+		bucket := string(key[:min(3, len(key))])
+		for _, j := range byName[bucket] {
+			if SimilarAgentNames(agents[j].Name, a.Name) {
+				union(j, i, AgentDuplicateName)
+			}
+		}
+
+		byName[bucket] = append(byName[bucket], i)
+	}
+
+	groups := make(map[int][]Agent)
+	order := []int{}
+	for i, a := range agents {
+		root := find(i)
+		if _, ok := groups[root]; !ok {
+			order = append(order, root)
+		}
+
+		groups[root] = append(groups[root], a)
+	}
+
+	res := []AgentDuplicates{}
+	for _, root := range order {
+		if len(groups[root]) < 2 {
+			continue
+		}
+
+		rs := lo.Uniq(reasons[find(root)])
+		sort.Strings(rs)
+
+		res = append(res, AgentDuplicates{
+			Agents:  groups[root],
+			Reasons: rs,
+		})
+	}
+
+	return res
+}
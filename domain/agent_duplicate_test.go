@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestGroupAgentDuplicates(t *testing.T) {
+	agents := []Agent{
+		{Name: "ООО Ромашка", Contacts: []AgentContacts{{Type: "phone", Val: "+7 999 111-22-33"}}},
+		{Name: "Иван Петров", Contacts: []AgentContacts{{Type: "email", Val: "Ivan@Mail.ru"}}},
+		{Name: "ooo romashka"},
+		{Name: "Петров Иван", Contacts: []AgentContacts{{Type: "email", Val: "ivan@mail.ru "}}},
+		{Name: "Ромашкин", Contacts: []AgentContacts{{Type: "phone", Val: "79991112233"}}},
+		{Name: "Сидоров"},
+	}
+
+	groups := GroupAgentDuplicates(agents)
+	if len(groups) != 2 {
+		t.Fatalf("GroupAgentDuplicates() = %d groups, want 2", len(groups))
+	}
+
+	if len(groups[0].Agents) != 3 || len(groups[0].Reasons) != 2 {
+		t.Errorf("GroupAgentDuplicates() first group = %v", groups[0])
+	}
+
+	if len(groups[1].Agents) != 2 || groups[1].Reasons[0] != AgentDuplicateEmail {
+		t.Errorf("GroupAgentDuplicates() second group = %v", groups[1])
+	}
+
+	if !SimilarAgentNames("Romashka", "Ромашко") || SimilarAgentNames("Ива", "Иво") {
+		t.Errorf("SimilarAgentNames() mismatch")
+	}
+
+	survivor := agents[0]
+	survivor.MergeContacts(agents[4], Agent{Contacts: []AgentContacts{{Type: "site", Val: "romashka.ru"}}})
+	if len(survivor.Contacts) != 2 {
+		t.Errorf("MergeContacts() = %v", survivor.Contacts)
+	}
+}
+
+func TestGroupAgentDuplicatesPhones(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   string
+		groups int
+	}{
+		{"plus seven", "+7 (999) 111-22-33", "79991112233", 1},
+		{"eight", "8 999 111 22 33", "+7 999 111-22-33", 1},
+		{"ten digits", "9991112233", "8(999)111-22-33", 1},
+		{"another number", "89991112233", "79991112234", 0},
+		{"foreign", "+1 202 555 0101", "12025550101", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := GroupAgentDuplicates([]Agent{
+				{Name: "Ромашка", Contacts: []AgentContacts{{Type: "phone", Val: tt.a}}},
+				{Name: "Сидоров", Contacts: []AgentContacts{{Type: "phone", Val: tt.b}}},
+			})
+			if len(groups) != tt.groups {
+				t.Errorf("GroupAgentDuplicates(%q, %q) = %d groups, want %d", tt.a, tt.b, len(groups), tt.groups)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+// AgentColumnName maps a spreadsheet column onto the agent name, any other target is a contact type.
+const AgentColumnName = "name"
+
+const AgentImportMaxRows = 10000
+
+var (
+	ErrAgentImportNoHeader = errors.New("файл пуст или нет строки заголовков")
+	ErrAgentImportNoName   = errors.New("не найдена колонка с названием агента")
+	ErrAgentImportTooLarge = fmt.Errorf("слишком много строк, максимум %d", AgentImportMaxRows)
+)
+
+var agentColumnNameAliases = []string{AgentColumnName, "название", "имя", "наименование", "контрагент"}
+
+type AgentImportError struct {
+	Row     int
+	Message string
+}
+
+// AgentImportResult - rows are counted the same way in dry-run and real import.
+type AgentImportResult struct {
+	Total   int
+	Created int
+	Updated int
+	Skipped int
+	Errors  []AgentImportError
+	DryRun  bool
+}
+
+// NewAgentColumns maps header cells onto targets. Explicit mapping wins, "-" or "" skips a column,
+// unmapped columns named like a name alias go to the name, others become contact types.
+func NewAgentColumns(header []string, mapping map[string]string) (map[int]string, error) {
+	columns := make(map[int]string)
+
+	for i, h := range header {
+		h = strings.TrimSpace(h)
+
+		target, ok := mapping[h]
+		if !ok {
+			target = strings.ToLower(h)
+			if lo.Contains(agentColumnNameAliases, target) {
+				target = AgentColumnName
+			}
+		}
+
+		target = strings.ToLower(strings.TrimSpace(target))
+		if target == "" || target == "-" {
+			continue
+		}
+
+		columns[i] = target
+	}
+
+	if !lo.Contains(lo.Values(columns), AgentColumnName) {
+		return columns, ErrAgentImportNoName
+	}
+
+	return columns, nil
+}
+
+// NewAgentFromRow validates a row the same way the agent create request does.
+// A cell may hold several contacts separated by comma, semicolon or a new line.
+func NewAgentFromRow(federationUUID uuid.UUID, companyUUID *uuid.UUID, me Me, row []string, columns map[int]string) (*Agent, error) {
+	name := ""
+	contacts := []AgentContacts{}
+
+	for i, cell := range row {
+		target, ok := columns[i]
+		if !ok {
+			continue
+		}
+
+		if target == AgentColumnName {
+			name = strings.TrimSpace(cell)
+			continue
+		}
+
+		for _, val := range strings.FieldsFunc(cell, splitAgentContacts) {
+			val = strings.TrimSpace(val)
+			if val == "" {
+				continue
+			}
+
+			if err := validateAgentContact(target, val); err != nil {
+				return nil, err
+			}
+
+			contacts = append(contacts, AgentContacts{Type: target, Val: val})
+		}
+	}
+
+	if l := utf8.RuneCountInString(name); l < 3 || l > 100 {
+		return nil, errors.New("название должно быть от 3 до 100 символов")
+	}
+
+	if len(contacts) == 0 {
+		return nil, errors.New("нет ни одного контакта")
+	}
+
+	return NewAgent(federationUUID, companyUUID, me, name, lo.UniqBy(contacts, AgentContacts.Key)), nil
+}
+
+func splitAgentContacts(r rune) bool {
+	return r == ',' || r == ';' || r == '\n'
+}
+
+func validateAgentContact(tp, val string) error {
+	if l := utf8.RuneCountInString(tp); l < 3 || l > 100 {
+		return fmt.Errorf("тип контакта %q должен быть от 3 до 100 символов", tp)
+	}
+
+	if l := utf8.RuneCountInString(val); l < 3 || l > 100 {
+		return fmt.Errorf("контакт %q должен быть от 3 до 100 символов", val)
+	}
+
+	switch tp {
+	case AgentContactPhone:
+		if l := len(NormalizePhone(val)); l < 10 || l > 15 {
+			return fmt.Errorf("неверный телефон %q", val)
+		}
+	case AgentContactEmail:
+		if helpers.ValidateEmail(strings.TrimSpace(val)) != nil {
+			return fmt.Errorf("неверная почта %q", val)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewAgentColumns(t *testing.T) {
+	columns, err := NewAgentColumns([]string{"Название", "Телефон", "Комментарий"}, map[string]string{
+		"Телефон":     AgentContactPhone,
+		"Комментарий": "-",
+	})
+	if err != nil {
+		t.Fatalf("NewAgentColumns() error = %v", err)
+	}
+
+	if len(columns) != 2 || columns[0] != AgentColumnName || columns[1] != AgentContactPhone {
+		t.Errorf("NewAgentColumns() = %v", columns)
+	}
+
+	if _, err := NewAgentColumns([]string{"Телефон"}, nil); err != ErrAgentImportNoName {
+		t.Errorf("NewAgentColumns() without name: %v", err)
+	}
+}
+
+func TestNewAgentFromRow(t *testing.T) {
+	columns := map[int]string{0: AgentColumnName, 1: AgentContactPhone, 2: AgentContactEmail}
+	me := Me{Email: "test@test.ru", UUID: uuid.New()}
+
+	dm, err := NewAgentFromRow(uuid.New(), nil, me, []string{" ООО Ромашка ", "+7 (900) 123-45-67; 7 900 123 45 67", "a@test.ru"}, columns)
+	if err != nil {
+		t.Fatalf("NewAgentFromRow() error = %v", err)
+	}
+
+	if dm.Name != "ООО Ромашка" || len(dm.Contacts) != 2 {
+		t.Errorf("NewAgentFromRow() = %v %v", dm.Name, dm.Contacts)
+	}
+
+	if _, err := NewAgentFromRow(uuid.New(), nil, me, []string{"ООО Ромашка", "", "not an email"}, columns); err == nil {
+		t.Errorf("NewAgentFromRow() invalid email should fail")
+	}
+
+	if _, err := NewAgentFromRow(uuid.New(), nil, me, []string{"ООО Ромашка", "", ""}, columns); err == nil {
+		t.Errorf("NewAgentFromRow() without contacts should fail")
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AgentTimelineTask     = "task"
+	AgentTimelineComment  = "comment"
+	AgentTimelineSms      = "sms"
+	AgentTimelineActivity = "activity"
+)
+
+// AgentTimelineItem is a single entry of the agent interaction history.
+type AgentTimelineItem struct {
+	Type     string
+	UUID     uuid.UUID
+	TaskUUID *uuid.UUID
+
+	Title     string
+	Text      string
+	CreatedBy string
+	Meta      map[string]interface{}
+
+	CreatedAt time.Time
+}
+
+// MergeTimeline merges sources sorted by created_at desc and returns the requested page.
+// Every source must hold at least offset+limit of its newest items.
+func MergeTimeline(limit, offset int, sources ...[]AgentTimelineItem) []AgentTimelineItem {
+	items := []AgentTimelineItem{}
+	for _, src := range sources {
+		items = append(items, src...)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].CreatedAt.After(items[j].CreatedAt)
+	})
+
+	if offset >= len(items) {
+		return []AgentTimelineItem{}
+	}
+
+	return items[offset:min(offset+limit, len(items))]
+}
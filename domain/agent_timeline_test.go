@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestMergeTimeline(t *testing.T) {
+	now := time.Now()
+	item := func(tp string, ago int) AgentTimelineItem {
+		return AgentTimelineItem{Type: tp, UUID: uuid.New(), CreatedAt: now.Add(-time.Duration(ago) * time.Hour)}
+	}
+
+	tasks := []AgentTimelineItem{item(AgentTimelineTask, 1), item(AgentTimelineTask, 5)}
+	sms := []AgentTimelineItem{item(AgentTimelineSms, 2), item(AgentTimelineSms, 3)}
+
+	got := MergeTimeline(2, 1, tasks, sms, nil)
+	if len(got) != 2 || got[0].UUID != sms[0].UUID || got[1].UUID != sms[1].UUID {
+		t.Errorf("MergeTimeline() = %v, want both sms", got)
+	}
+
+	if got := MergeTimeline(10, 4, tasks, sms); len(got) != 0 {
+		t.Errorf("MergeTimeline() out of range = %v", got)
+	}
+
+	agent := Agent{Contacts: []AgentContacts{
+		{Type: "Phone", Val: "+7 (999) 123-45-67"},
+		{Type: "phone", Val: "79991234567"},
+		{Type: "email", Val: "a@b.ru"},
+	}}
+
+	if phones := agent.Phones(); len(phones) != 1 || phones[0] != "79991234567" {
+		t.Errorf("Phones() = %v", phones)
+	}
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronExpr - a five field cron expression: minute, hour, day of month, month and day of week (0 - sunday, 7 too).
+// A field is *, a value, a range a-b or a list of them, each with an optional /step.
+type CronExpr struct {
+	minute, hour, dom, month, dow uint64
+
+	// as in vixie cron, when both days are restricted a day matching either of them fits
+	domAny, dowAny bool
+}
+
+func ParseCron(expr string) (CronExpr, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return CronExpr{}, fmt.Errorf("в cron выражении должно быть 5 полей: %s", expr)
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	bits := [5]uint64{}
+
+	for i, f := range fields {
+		b, err := parseCronField(f, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return CronExpr{}, err
+		}
+
+		bits[i] = b
+	}
+
+	// sunday is both 0 and 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return CronExpr{
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		domAny: fields[2] == "*",
+		dowAny: fields[4] == "*",
+	}, nil
+}
+
+func parseCronField(field string, lo, hi int) (bits uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+
+		if i := strings.Index(part, "/"); i >= 0 {
+			rng = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("неверный шаг в cron: %s", part)
+			}
+		}
+
+		from, to := lo, hi
+
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			ends := strings.SplitN(rng, "-", 2)
+			from, err = strconv.Atoi(ends[0])
+			if err == nil {
+				to, err = strconv.Atoi(ends[1])
+			}
+		default:
+			from, err = strconv.Atoi(rng)
+			to = from
+			if err == nil && step > 1 {
+				to = hi
+			}
+		}
+
+		if err != nil || from < lo || to > hi || from > to {
+			return 0, fmt.Errorf("неверное значение в cron: %s", part)
+		}
+
+		for v := from; v <= to; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+func (c CronExpr) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<t.Day()) != 0
+	dow := c.dow&(1<<int(t.Weekday())) != 0
+
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// Next - the first matching minute after t in the location of t, false when nothing matches in five years (e.g. 30 feb).
+func (c CronExpr) Next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	limit := t.AddDate(5, 0, 0)
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<int(t.Month())) == 0:
+			t = forward(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+		case !c.dayMatches(t):
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+		case c.hour&(1<<t.Hour()) == 0:
+			// not time.Date: the hour skipped by a DST switch would bring it back
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+		case c.minute&(1<<t.Minute()) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// forward - the midnight skipped by a DST switch is normalized back into the day it was jumped from.
+func forward(t, to time.Time) time.Time {
+	if to.After(t) {
+		return to
+	}
+
+	return t.Add(time.Duration(60-t.Minute()) * time.Minute)
+}
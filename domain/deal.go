@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+var ErrDealNotFound = errors.New("сделка не найдена")
+
+const (
+	DealStatusOpen = 0
+	DealStatusWon  = 1
+	DealStatusLost = 2
+)
+
+type Deal struct {
+	UUID           uuid.UUID
+	ID             int
+	Name           string    `validate:"lte=50,gte=3"  ru:"название"`
+	Description    string    `validate:"lte=5000"  ru:"описание"`
+	CreatedBy      string    `validate:"lte=100,gte=3"  ru:"автор"`
+	FederationUUID uuid.UUID `validate:"uuid"  ru:"федерация (uuid)"`
+	CompanyUUID    uuid.UUID `validate:"uuid"  ru:"компания (uuid)"`
+
+	Tags     []string
+	Status   int `validate:"gte=0,lte=2"  ru:"статус"`
+	Priority int
+
+	// StageUUID - pipeline stage, nil until the deal is put on the board
+	StageUUID *uuid.UUID
+	Amount    float64 `validate:"gte=0"  ru:"сумма"`
+
+	Fields    map[string]interface{}
+	RawFields map[string]interface{}
+	Meta      map[string]interface{}
+
+	FinishedAt *time.Time
+	ActivityAt time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  *time.Time
+}
+
+func NewDeal(
+	name, description string,
+	federationUUID, companyUUID uuid.UUID,
+	createdBy string,
+	tags []string,
+	priority int,
+	fields map[string]interface{},
+) (deal Deal, err error) {
+	if fields == nil {
+		fields = make(map[string]interface{})
+	}
+
+	deal = Deal{
+		UUID:           uuid.New(),
+		Name:           name,
+		Description:    description,
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		CreatedBy:      createdBy,
+		Tags:           lo.WithoutEmpty(lo.Uniq(tags)),
+		Status:         DealStatusOpen,
+		Priority:       priority,
+		RawFields:      fields,
+		Fields:         make(map[string]interface{}),
+		Meta:           make(map[string]interface{}),
+		CreatedAt:      time.Now(),
+	}
+
+	errs, ok := helpers.ValidationStruct(deal)
+	if !ok {
+		err = errors.New(helpers.Join(errs, ", "))
+		return deal, err
+	}
+
+	return deal, err
+}
+
+func (d *Deal) PatchName(name string) error {
+	if len(name) < 3 || len(name) > 50 {
+		return errors.New("название должно быть от 3 до 50 символов")
+	}
+
+	d.Name = name
+
+	return nil
+}
+
+func (d *Deal) PatchStatus(status int) error {
+	if status == d.Status {
+		return errors.New("статус не изменился")
+	}
+
+	switch status {
+	case DealStatusOpen:
+		d.FinishedAt = nil
+	case DealStatusWon, DealStatusLost:
+		d.FinishedAt = helpers.Ptr(time.Now())
+	default:
+		return errors.New("неизвестный статус сделки")
+	}
+
+	d.Status = status
+
+	return nil
+}
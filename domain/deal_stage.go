@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+var ErrDealStageNotFound = errors.New("этап не найден")
+
+type DealStage struct {
+	UUID        uuid.UUID
+	CompanyUUID uuid.UUID `validate:"uuid"  ru:"компания (uuid)"`
+	Name        string    `validate:"lte=100,gte=1"  ru:"название"`
+	Color       string    `validate:"lte=20"  ru:"цвет"`
+	Probability int       `validate:"gte=0,lte=100"  ru:"вероятность"`
+	// Transitions - stages a deal can be moved to from this one
+	Transitions []uuid.UUID
+	Sort        int
+	CreatedBy   string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewDealStage(companyUUID uuid.UUID, name, color string, probability int, transitions []uuid.UUID, createdBy string) (stage DealStage, err error) {
+	stage = DealStage{
+		UUID:        uuid.New(),
+		CompanyUUID: companyUUID,
+		Name:        name,
+		Color:       color,
+		Probability: probability,
+		Transitions: lo.Uniq(transitions),
+		CreatedBy:   createdBy,
+		CreatedAt:   time.Now(),
+	}
+
+	errs, ok := helpers.ValidationStruct(stage)
+	if !ok {
+		err = errors.New(helpers.Join(errs, ", "))
+		return stage, err
+	}
+
+	return stage, err
+}
+
+func (s DealStage) Weighted(amount float64) float64 {
+	return amount * float64(s.Probability) / 100
+}
+
+// NewDealStageGraph builds a StatusGraph keyed by stage uuid. Transitions to unknown stages are dropped.
+func NewDealStageGraph(stages []DealStage) *StatusGraph {
+	known := lo.Map(stages, func(item DealStage, _ int) uuid.UUID {
+		return item.UUID
+	})
+
+	rw := make(map[string][]string)
+	for _, stage := range stages {
+		rw[stage.UUID.String()] = []string{}
+
+		for _, t := range stage.Transitions {
+			if t == stage.UUID || lo.IndexOf(known, t) == -1 {
+				continue
+			}
+
+			rw[stage.UUID.String()] = append(rw[stage.UUID.String()], t.String())
+		}
+	}
+
+	sg, _ := NewStatusGraphFromMap(rw)
+
+	return sg
+}
+
+// PatchStage moves the deal to the stage. A graph without transitions allows any move,
+// a deal without a stage (or in a removed one) can enter the pipeline at any stage.
+func (d *Deal) PatchStage(stage DealStage, sg *StatusGraph) error {
+	if stage.CompanyUUID != d.CompanyUUID {
+		return ErrDealStageNotFound
+	}
+
+	if d.StageUUID != nil && *d.StageUUID == stage.UUID {
+		return errors.New("этап не изменился")
+	}
+
+	if d.StageUUID != nil && sg != nil && hasRoutes(sg) {
+		current := d.StageUUID.String()
+
+		if _, ok := sg.Graph[current]; ok {
+			sg.Current = current
+
+			allowMove, _ := CheckPathByValue(sg, current, stage.UUID.String())
+			if !allowMove {
+				return fmt.Errorf("сделку нельзя перевести в этап %s", stage.Name)
+			}
+		}
+	}
+
+	d.StageUUID = &stage.UUID
+
+	return nil
+}
+
+func hasRoutes(sg *StatusGraph) bool {
+	for _, routes := range sg.Graph {
+		if len(routes) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+type DealBoardColumn struct {
+	// Stage - nil for deals without a stage
+	Stage    *DealStage
+	Count    int64
+	Amount   float64
+	Weighted float64
+	Deals    []Deal
+}
+
+type DealBoard struct {
+	Columns  []DealBoardColumn
+	Count    int64
+	Amount   float64
+	Weighted float64
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDealPatchStatus(t *testing.T) {
+	deal, err := NewDeal("Поставка", "", uuid.New(), uuid.New(), "test@test.ru", []string{"a", "a", ""}, 0, nil)
+	if err != nil {
+		t.Fatalf("NewDeal() error = %v", err)
+	}
+
+	if len(deal.Tags) != 1 {
+		t.Errorf("NewDeal() tags = %v, want [a]", deal.Tags)
+	}
+
+	if err := deal.PatchStatus(DealStatusOpen); err == nil {
+		t.Errorf("PatchStatus() to the same status should fail")
+	}
+
+	if err := deal.PatchStatus(DealStatusWon); err != nil || deal.FinishedAt == nil {
+		t.Errorf("PatchStatus() won: err = %v, finished_at = %v", err, deal.FinishedAt)
+	}
+
+	if err := deal.PatchStatus(DealStatusOpen); err != nil || deal.FinishedAt != nil {
+		t.Errorf("PatchStatus() reopen: err = %v, finished_at = %v", err, deal.FinishedAt)
+	}
+
+	if err := deal.PatchStatus(10); err == nil {
+		t.Errorf("PatchStatus() unknown status should fail")
+	}
+}
+
+func TestDealPatchStage(t *testing.T) {
+	companyUUID := uuid.New()
+
+	lead, _ := NewDealStage(companyUUID, "Лид", "", 10, nil, "test@test.ru")
+	offer, _ := NewDealStage(companyUUID, "КП", "", 50, nil, "test@test.ru")
+	contract, _ := NewDealStage(companyUUID, "Договор", "", 90, nil, "test@test.ru")
+
+	lead.Transitions = []uuid.UUID{offer.UUID}
+	offer.Transitions = []uuid.UUID{contract.UUID, lead.UUID}
+
+	sg := NewDealStageGraph([]DealStage{lead, offer, contract})
+
+	deal := Deal{CompanyUUID: companyUUID}
+
+	if err := deal.PatchStage(contract, sg); err != nil {
+		t.Fatalf("PatchStage() entering the pipeline: %v", err)
+	}
+
+	if err := deal.PatchStage(lead, sg); err == nil {
+		t.Errorf("PatchStage() contract -> lead should fail")
+	}
+
+	deal.StageUUID = &lead.UUID
+	if err := deal.PatchStage(contract, sg); err != nil {
+		t.Errorf("PatchStage() lead -> contract via offer: %v", err)
+	}
+
+	if err := deal.PatchStage(DealStage{UUID: uuid.New(), CompanyUUID: uuid.New()}, sg); err != ErrDealStageNotFound {
+		t.Errorf("PatchStage() foreign stage: %v", err)
+	}
+
+	if got := offer.Weighted(1000); got != 500 {
+		t.Errorf("Weighted() = %v, want 500", got)
+	}
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// event names, the keys the bus subscribers are attached by
+const (
+	EventTaskCreated       = "task.created"
+	EventTaskUpdated       = "task.updated"
+	EventTaskStatusChanged = "task.status_changed"
+	EventTaskDeleted       = "task.deleted"
+	EventTaskOpened        = "task.opened"
+	EventTaskDeadline      = "task.deadline"
+
+	EventCommentAdded   = "comment.added"
+	EventCommentUpdated = "comment.updated"
+	EventCommentDeleted = "comment.deleted"
+	EventCommentLiked   = "comment.liked"
+
+	EventFileUploaded = "file.uploaded"
+
+	EventReminderCreated       = "reminder.created"
+	EventReminderUpdated       = "reminder.updated"
+	EventReminderStatusChanged = "reminder.status_changed"
+	EventReminderDeleted       = "reminder.deleted"
+	EventReminderDue           = "reminder.due"
+)
+
+// Event - a fact published on the bus once it is stored.
+type Event interface {
+	EventName() string
+	Meta() EventMeta
+}
+
+// EventMeta - what every event carries, all the events are about a task or something of it.
+type EventMeta struct {
+	UUID       uuid.UUID `json:"uuid"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// Actor - email of the user who caused the event, empty for the system
+	Actor    string    `json:"actor,omitempty"`
+	TaskUUID uuid.UUID `json:"task_uuid"`
+	// People - the emails concerned by the event, usually without the actor
+	People []string `json:"people"`
+}
+
+func NewEventMeta(actor string, taskUUID uuid.UUID, people []string) EventMeta {
+	return EventMeta{
+		UUID:       uuid.New(),
+		OccurredAt: time.Now(),
+		Actor:      actor,
+		TaskUUID:   taskUUID,
+		People:     people,
+	}
+}
+
+func (m EventMeta) Meta() EventMeta {
+	return m
+}
+
+// TaskCreated - Assignees are the implementer and the responsible.
+type TaskCreated struct {
+	EventMeta
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	Name        string    `json:"name"`
+	Assignees   []string  `json:"assignees,omitempty"`
+}
+
+// TaskUpdated - Before and After hold the changed fields only.
+type TaskUpdated struct {
+	EventMeta
+	Fields []string               `json:"fields"`
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+}
+
+type TaskStatusChanged struct {
+	EventMeta
+	Before  int    `json:"before"`
+	After   int    `json:"after"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type TaskDeleted struct {
+	EventMeta
+	Name string `json:"name"`
+}
+
+type TaskOpened struct {
+	EventMeta
+}
+
+// TaskDeadline - the task has reached the stage of its deadline, the people are the stage recipients.
+type TaskDeadline struct {
+	EventMeta
+	Stage    int       `json:"stage"`
+	ID       int       `json:"id"`
+	Name     string    `json:"name"`
+	FinishTo time.Time `json:"finish_to"`
+}
+
+// CommentAdded - Mentioned are the people added to the comment.
+type CommentAdded struct {
+	EventMeta
+	CommentUUID uuid.UUID `json:"comment_uuid"`
+	Mentioned   []string  `json:"mentioned,omitempty"`
+}
+
+type CommentUpdated struct {
+	EventMeta
+	CommentUUID uuid.UUID `json:"comment_uuid"`
+}
+
+type CommentDeleted struct {
+	EventMeta
+	CommentUUID uuid.UUID `json:"comment_uuid"`
+}
+
+type CommentLiked struct {
+	EventMeta
+	CommentUUID uuid.UUID `json:"comment_uuid"`
+	Liked       bool      `json:"liked"`
+}
+
+type FileUploaded struct {
+	EventMeta
+	FileUUID uuid.UUID `json:"file_uuid"`
+	Name     string    `json:"name"`
+}
+
+type ReminderCreated struct {
+	EventMeta
+	ReminderUUID uuid.UUID `json:"reminder_uuid"`
+}
+
+type ReminderUpdated struct {
+	EventMeta
+	ReminderUUID uuid.UUID `json:"reminder_uuid"`
+}
+
+type ReminderStatusChanged struct {
+	EventMeta
+	ReminderUUID uuid.UUID `json:"reminder_uuid"`
+	Before       int       `json:"before"`
+	After        int       `json:"after"`
+}
+
+type ReminderDeleted struct {
+	EventMeta
+	ReminderUUID uuid.UUID `json:"reminder_uuid"`
+}
+
+// ReminderDue - the reminder occurrence has been marked as fired and is to be delivered.
+type ReminderDue struct {
+	EventMeta
+	Reminder Reminder `json:"reminder"`
+}
+
+func (TaskCreated) EventName() string           { return EventTaskCreated }
+func (TaskUpdated) EventName() string           { return EventTaskUpdated }
+func (TaskStatusChanged) EventName() string     { return EventTaskStatusChanged }
+func (TaskDeleted) EventName() string           { return EventTaskDeleted }
+func (TaskOpened) EventName() string            { return EventTaskOpened }
+func (TaskDeadline) EventName() string          { return EventTaskDeadline }
+func (CommentAdded) EventName() string          { return EventCommentAdded }
+func (CommentUpdated) EventName() string        { return EventCommentUpdated }
+func (CommentDeleted) EventName() string        { return EventCommentDeleted }
+func (CommentLiked) EventName() string          { return EventCommentLiked }
+func (FileUploaded) EventName() string          { return EventFileUploaded }
+func (ReminderCreated) EventName() string       { return EventReminderCreated }
+func (ReminderUpdated) EventName() string       { return EventReminderUpdated }
+func (ReminderStatusChanged) EventName() string { return EventReminderStatusChanged }
+func (ReminderDeleted) EventName() string       { return EventReminderDeleted }
+func (ReminderDue) EventName() string           { return EventReminderDue }
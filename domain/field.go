@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+var (
+	fieldLinkRegexp  = regexp.MustCompile(`^\[.*]\((http:\/\/www\.|https:\/\/www\.|http:\/\/|https:\/\/|\/|\/\/)?[A-z0-9_-]*?[:]?[A-z0-9_-]*?[@]?[A-z0-9]+([\-\.]{1}[a-z0-9]+)*\.[a-z]{2,5}(:[0-9]{1,5})?(\/.*)?\)$`)
+	fieldEmailRegexp = regexp.MustCompile(`^[a-z0-9._%+\-]+@[a-z0-9.\-]+\.[a-z]{2,4}$`)
+)
+
+// FieldValue checks a raw (json decoded) value of a custom field and converts it to the stored one,
+// nil - the data type is not stored in the fields (data links are kept apart).
+func FieldValue(name, hash string, dataType FieldDataType, value interface{}) (interface{}, error) {
+	switch dataType {
+	case Integer, Phone:
+		if v, ok := value.(int); ok {
+			return v, nil
+		}
+
+		if v, ok := value.(float64); ok {
+			return int(v), nil
+		}
+
+		if dataType == Phone {
+			return nil, fmt.Errorf("field %s (%s) should be integer (phone)", name, hash)
+		}
+
+		return nil, fmt.Errorf("field %s (%s) should be integer", name, hash)
+	case Float:
+		if v, ok := value.(float64); ok {
+			return v, nil
+		}
+
+		return nil, fmt.Errorf("field %s (%s) should be float", name, hash)
+	case String:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+
+		return nil, fmt.Errorf("field %s (%s) should be string", name, hash)
+	case Text:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+
+		return nil, fmt.Errorf("field %s (%s) should be text", name, hash)
+	case Bool:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+
+		return nil, fmt.Errorf("field %s (%s) should be bool", name, hash)
+	case Switch:
+		v, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("field %s (%s) should be switch (0|1|2)", name, hash)
+		}
+
+		if v != 0 && v != 1 && v != 2 {
+			return nil, fmt.Errorf("field %s (%s) must be switch (0|1|2)", name, hash)
+		}
+
+		return int(v), nil
+	case Array, People:
+		rt := reflect.TypeOf(value)
+		if rt.Kind() != reflect.Slice && rt.Kind() != reflect.Array {
+			return nil, fmt.Errorf("field %s (%s) should be array", name, hash)
+		}
+
+		items, _ := value.([]interface{})
+
+		arrWithStrings := []string{}
+		for _, i := range items {
+			item := fmt.Sprintf("%v", i)
+
+			if dataType == People && !fieldEmailRegexp.MatchString(item) {
+				return nil, fmt.Errorf("field %s (%s) - %s should be email", name, hash, item)
+			}
+
+			arrWithStrings = append(arrWithStrings, item)
+		}
+
+		return arrWithStrings, nil
+	case Link:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("field %s (%s) should be string", name, hash)
+		}
+
+		// url: [text](url)
+		if !fieldLinkRegexp.MatchString(v) {
+			return nil, fmt.Errorf("field %s (%s) should be link [text](url)", name, hash)
+		}
+
+		return v, nil
+	case Email:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("field %s (%s) should be string", name, hash)
+		}
+
+		if !fieldEmailRegexp.MatchString(v) {
+			return nil, fmt.Errorf("field %s (%s) should be email", name, hash)
+		}
+
+		return v, nil
+	case Time, DateTime:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("field %s (%s) should be string", name, hash)
+		}
+
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			return nil, err
+		}
+
+		if dataType == Time {
+			return v[11:], nil
+		}
+
+		return v, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataType FieldDataType
+		value    interface{}
+		want     interface{}
+		wantErr  bool
+	}{
+		{"integer", Integer, float64(5), 5, false},
+		{"integer string", Integer, "5", nil, true},
+		{"switch", Switch, float64(2), 2, false},
+		{"switch out of range", Switch, float64(3), nil, true},
+		{"array", Array, []interface{}{"a", float64(1)}, []string{"a", "1"}, false},
+		{"people", People, []interface{}{"a@mail.ru"}, []string{"a@mail.ru"}, false},
+		{"people not email", People, []interface{}{"a"}, nil, true},
+		{"link", Link, "[site](https://example.com)", "[site](https://example.com)", false},
+		{"email", Email, "a@", nil, true},
+		{"time", Time, "2024-01-01T09:30:00Z", "09:30:00Z", false},
+		{"datetime", DateTime, "yesterday", nil, true},
+		{"data is not stored", Data, "x", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FieldValue("field", "hash", tt.dataType, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FieldValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FieldValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	FilterEq         = "="
+	FilterNe         = "!="
+	FilterLt         = "<"
+	FilterLte        = "<="
+	FilterGt         = ">"
+	FilterGte        = ">="
+	FilterBetween    = "between"
+	FilterIn         = "in"
+	FilterNotIn      = "not in"
+	FilterContains   = "contains"
+	FilterStartsWith = "starts with"
+	FilterIsNull     = "is null"
+	FilterIsNotNull  = "is not null"
+
+	// FilterNotBetween is not accepted from clients, "!=" with a relative date turns into it.
+	FilterNotBetween = "not between"
+)
+
+const (
+	FilterKindNumber = "number"
+	FilterKindText   = "text"
+	FilterKindBool   = "bool"
+	FilterKindDate   = "date"
+	FilterKindArray  = "array"
+)
+
+var filterOperators = map[string][]string{
+	FilterKindNumber: {FilterEq, FilterNe, FilterLt, FilterLte, FilterGt, FilterGte, FilterBetween, FilterIn, FilterNotIn, FilterIsNull, FilterIsNotNull},
+	FilterKindText:   {FilterEq, FilterNe, FilterIn, FilterNotIn, FilterContains, FilterStartsWith, FilterIsNull, FilterIsNotNull},
+	FilterKindBool:   {FilterEq, FilterNe, FilterIsNull, FilterIsNotNull},
+	FilterKindDate:   {FilterEq, FilterNe, FilterLt, FilterLte, FilterGt, FilterGte, FilterBetween, FilterIsNull, FilterIsNotNull},
+	FilterKindArray:  {FilterContains, FilterIsNull, FilterIsNotNull},
+}
+
+func (t FieldDataType) FilterKind() string {
+	switch t {
+	case Integer, Float:
+		return FilterKindNumber
+	case Bool, Switch:
+		return FilterKindBool
+	case Time, DateTime:
+		return FilterKindDate
+	case Array, DataArray, People:
+		return FilterKindArray
+	}
+
+	return FilterKindText
+}
+
+// FilterCondition is an operator checked against the field type, with the values converted for the query.
+type FilterCondition struct {
+	Operator string
+	Kind     string
+	Values   []interface{}
+}
+
+// NewFilterCondition checks the operator against the field type. An empty operator means equality.
+// Dates accept relative values (see ParseRelativeDate), they are resolved against now. A relative date
+// or a day is a [from, to) range, a date equals any time of its day and between of dates is half-open too.
+func NewFilterCondition(operator string, dataType FieldDataType, value interface{}, now time.Time) (c FilterCondition, err error) {
+	operator = strings.ToLower(strings.TrimSpace(operator))
+	if operator == "" {
+		operator = FilterEq
+	}
+
+	kind := dataType.FilterKind()
+	if lo.IndexOf(filterOperators[kind], operator) == -1 {
+		return c, fmt.Errorf("оператор «%s» не применим к полю типа %s", operator, kind)
+	}
+
+	c = FilterCondition{Operator: operator, Kind: kind}
+
+	switch operator {
+	case FilterIsNull, FilterIsNotNull:
+		return c, nil
+
+	case FilterBetween:
+		items, ok := value.([]interface{})
+		if !ok || len(items) != 2 {
+			return c, fmt.Errorf("оператор «%s» ожидает два значения", operator)
+		}
+
+		from, err := filterBound(kind, items[0], now, false)
+		if err != nil {
+			return c, err
+		}
+
+		to, err := filterBound(kind, items[1], now, true)
+		if err != nil {
+			return c, err
+		}
+
+		c.Values = []interface{}{from, to}
+
+	case FilterIn, FilterNotIn:
+		items, ok := value.([]interface{})
+		if !ok || len(items) == 0 {
+			return c, fmt.Errorf("оператор «%s» ожидает список значений", operator)
+		}
+
+		for _, item := range items {
+			v, err := filterValue(kind, item)
+			if err != nil {
+				return c, err
+			}
+
+			c.Values = append(c.Values, v)
+		}
+
+	case FilterContains:
+		if kind == FilterKindArray {
+			items, ok := value.([]interface{})
+			if !ok {
+				items = []interface{}{value}
+			}
+
+			c.Values = items
+			return c, nil
+		}
+
+		c.Values = []interface{}{fmt.Sprintf("%v", value)}
+
+	default:
+		if kind == FilterKindDate {
+			if from, to, ok := filterDateRange(value, now); ok {
+				return c.inRange(from, to), nil
+			}
+		}
+
+		v, err := filterValue(kind, value)
+		if err != nil {
+			return c, err
+		}
+
+		if t, ok := v.(time.Time); ok && (operator == FilterEq || operator == FilterNe) {
+			day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+			return c.inRange(day, day.AddDate(0, 0, 1)), nil
+		}
+
+		c.Values = []interface{}{v}
+	}
+
+	return c, nil
+}
+
+// inRange compares against the [from, to) range of a relative date or a day.
+func (c FilterCondition) inRange(from, to time.Time) FilterCondition {
+	switch c.Operator {
+	case FilterEq:
+		c.Operator = FilterBetween
+		c.Values = []interface{}{from, to}
+	case FilterNe:
+		c.Operator = FilterNotBetween
+		c.Values = []interface{}{from, to}
+	case FilterLt, FilterGte:
+		c.Values = []interface{}{from}
+	case FilterLte, FilterGt:
+		c.Values = []interface{}{to}
+	}
+
+	return c
+}
+
+func filterBound(kind string, value interface{}, now time.Time, upper bool) (interface{}, error) {
+	if kind == FilterKindDate {
+		if from, to, ok := filterDateRange(value, now); ok {
+			return lo.Ternary(upper, to, from), nil
+		}
+	}
+
+	return filterValue(kind, value)
+}
+
+// filterDateRange - a relative date or a day without time is a [from, to) range.
+func filterDateRange(value interface{}, now time.Time) (from, to time.Time, ok bool) {
+	s, ok := value.(string)
+	if !ok {
+		return from, to, false
+	}
+
+	if from, to, ok = ParseRelativeDate(s, now); ok {
+		return from, to, true
+	}
+
+	day, err := time.Parse("2006-01-02", strings.TrimSpace(s))
+	if err != nil {
+		return from, to, false
+	}
+
+	return day, day.AddDate(0, 0, 1), true
+}
+
+func filterValue(kind string, value interface{}) (interface{}, error) {
+	switch kind {
+	case FilterKindNumber:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("ожидается число: %s", v)
+			}
+
+			return f, nil
+		}
+
+		return nil, fmt.Errorf("ожидается число: %v", value)
+
+	case FilterKindBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("ожидается true или false: %s", v)
+			}
+
+			return b, nil
+		}
+
+		return nil, fmt.Errorf("ожидается true или false: %v", value)
+
+	case FilterKindDate:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("ожидается дата: %v", value)
+		}
+
+		for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+
+		return nil, fmt.Errorf("ожидается дата: %s", s)
+	}
+
+	return fmt.Sprintf("%v", value), nil
+}
+
+var relativeDateRe = regexp.MustCompile(`^(last|next) (\d{1,3}) (day|week|month)s?$`)
+
+// ParseRelativeDate turns today, yesterday, tomorrow, this week, this month,
+// last|next N days|weeks|months into a [from, to) range.
+func ParseRelativeDate(s string, now time.Time) (from, to time.Time, ok bool) {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch s {
+	case "today":
+		return day, day.AddDate(0, 0, 1), true
+	case "yesterday":
+		return day.AddDate(0, 0, -1), day, true
+	case "tomorrow":
+		return day.AddDate(0, 0, 1), day.AddDate(0, 0, 2), true
+	case "this week":
+		monday := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+		return monday, monday.AddDate(0, 0, 7), true
+	case "this month":
+		first := day.AddDate(0, 0, 1-day.Day())
+		return first, first.AddDate(0, 1, 0), true
+	}
+
+	m := relativeDateRe.FindStringSubmatch(s)
+	if m == nil {
+		return from, to, false
+	}
+
+	n, _ := strconv.Atoi(m[2])
+
+	var months, days int
+	switch m[3] {
+	case "day":
+		days = n
+	case "week":
+		days = 7 * n
+	case "month":
+		months = n
+	}
+
+	if m[1] == "last" {
+		return now.AddDate(0, -months, -days), now, true
+	}
+
+	return now, now.AddDate(0, months, days), true
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewFilterCondition(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		operator string
+		dataType FieldDataType
+		value    interface{}
+		wantOp   string
+		wantLen  int
+		wantErr  bool
+	}{
+		{"plain value is equality", "", Integer, "10", FilterEq, 1, false},
+		{"number between", "between", Float, []interface{}{1.0, "2.5"}, FilterBetween, 2, false},
+		{"number in", "in", Integer, []interface{}{1.0, 2.0, 3.0}, FilterIn, 3, false},
+		{"not a number", ">", Integer, "abc", "", 0, true},
+		{"contains on number", "contains", Integer, "1", "", 0, true},
+		{"text contains", "contains", String, "abc", FilterContains, 1, false},
+		{"text comparison", "<", Text, "abc", "", 0, true},
+		{"bool", "=", Bool, "true", FilterEq, 1, false},
+		{"array contains a single value", "contains", People, "a@a.ru", FilterContains, 1, false},
+		{"array equality", "=", Array, "a", "", 0, true},
+		{"is null", "is null", DateTime, nil, FilterIsNull, 0, false},
+		{"date", ">=", DateTime, "2026-10-01", FilterGte, 1, false},
+		{"relative date equality", "=", DateTime, "last 7 days", FilterBetween, 2, false},
+		{"relative date inequality", "!=", Time, "today", FilterNotBetween, 2, false},
+		{"relative date bound", "<", DateTime, "this month", FilterLt, 1, false},
+		{"date equality is the day", "=", DateTime, "2026-10-01", FilterBetween, 2, false},
+		{"time equality is the day", "!=", DateTime, "2026-10-01T10:00:00Z", FilterNotBetween, 2, false},
+		{"date between", "between", Time, []interface{}{"2026-10-01", "last 2 days"}, FilterBetween, 2, false},
+		{"between needs two values", "between", Integer, []interface{}{1.0}, "", 0, true},
+		{"unknown operator", "like", String, "a", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewFilterCondition(tt.operator, tt.dataType, tt.value, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFilterCondition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if c.Operator != tt.wantOp || len(c.Values) != tt.wantLen {
+				t.Errorf("NewFilterCondition() = %s %v, want %s with %d values", c.Operator, c.Values, tt.wantOp, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestNewFilterConditionDays(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)
+	first := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		operator string
+		value    interface{}
+		from, to time.Time
+	}{
+		{"equality", "=", "2026-10-01", first, first.AddDate(0, 0, 1)},
+		{"equality of a time", "=", "2026-10-01T10:00:00Z", first, first.AddDate(0, 0, 1)},
+		{"between includes the last day", "between", []interface{}{"2026-10-01", "2026-10-17"}, first, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"between of times", "between", []interface{}{"2026-10-01T00:00:00Z", "2026-10-18T15:30:00Z"}, first, now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewFilterCondition(tt.operator, DateTime, tt.value, now)
+			if err != nil {
+				t.Fatalf("NewFilterCondition() error = %v", err)
+			}
+
+			if len(c.Values) != 2 || !c.Values[0].(time.Time).Equal(tt.from) || !c.Values[1].(time.Time).Equal(tt.to) {
+				t.Errorf("NewFilterCondition() = %v, want [%v, %v)", c.Values, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+func TestParseRelativeDate(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC) // sunday
+	day := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		from, to time.Time
+	}{
+		{"today", day, day.AddDate(0, 0, 1)},
+		{"Yesterday", day.AddDate(0, 0, -1), day},
+		{"this week", time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"this month", time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"last 7 days", now.AddDate(0, 0, -7), now},
+		{"next  2 weeks", now, now.AddDate(0, 0, 14)},
+		{"last 1 month", now.AddDate(0, -1, 0), now},
+	}
+
+	for _, tt := range tests {
+		from, to, ok := ParseRelativeDate(tt.value, now)
+		if !ok || !from.Equal(tt.from) || !to.Equal(tt.to) {
+			t.Errorf("ParseRelativeDate(%q) = %v, %v, %v, want %v, %v", tt.value, from, to, ok, tt.from, tt.to)
+		}
+	}
+
+	if _, _, ok := ParseRelativeDate("2026-10-18", now); ok {
+		t.Errorf("ParseRelativeDate() should not parse absolute dates")
+	}
+}
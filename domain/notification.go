@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// notification kinds, what a person is notified of; updated - any other change of a task
+const (
+	NotificationAssigned      = "assigned"
+	NotificationMentioned     = "mentioned"
+	NotificationStatusChanged = "status_changed"
+	NotificationCommented     = "commented"
+	NotificationReminderDue   = "reminder_due"
+	NotificationDeadline      = "deadline"
+	NotificationUpdated       = "updated"
+)
+
+// notification channels
+const (
+	NotificationInApp = "in_app"
+	NotificationEmail = "email"
+	NotificationSMS   = "sms"
+	NotificationPush  = "push"
+)
+
+const quietLayout = "15:04"
+
+var NotificationKinds = []string{
+	NotificationAssigned,
+	NotificationMentioned,
+	NotificationStatusChanged,
+	NotificationCommented,
+	NotificationReminderDue,
+	NotificationDeadline,
+	NotificationUpdated,
+}
+
+var NotificationChannels = []string{
+	NotificationInApp,
+	NotificationEmail,
+	NotificationSMS,
+	NotificationPush,
+}
+
+// DefaultNotificationChannels - in-app and push for everything, reminders and deadlines by email as well,
+// reminders by SMS too, the way it was before the preferences.
+func DefaultNotificationChannels() map[string][]string {
+	channels := map[string][]string{}
+	for _, kind := range NotificationKinds {
+		channels[kind] = []string{NotificationInApp, NotificationPush}
+	}
+
+	channels[NotificationReminderDue] = []string{NotificationInApp, NotificationPush, NotificationEmail, NotificationSMS}
+	channels[NotificationDeadline] = []string{NotificationInApp, NotificationPush, NotificationEmail}
+
+	return channels
+}
+
+// NotificationPreferences - part of the profile preferences, quiet hours are in the profile timezone.
+type NotificationPreferences struct {
+	// Channels - the channels of a kind, a kind left out keeps the default ones
+	Channels map[string][]string `json:"channels,omitempty"`
+	// QuietFrom, QuietTo - "HH:MM", only in-app notifications are stored in between, QuietTo may be the next day.
+	// Reminders and deadlines are sent in the quiet hours too, see quietExempt.
+	QuietFrom string `json:"quiet_from,omitempty"`
+	QuietTo   string `json:"quiet_to,omitempty"`
+	// MutedProjects - nothing of the tasks of these projects is notified
+	MutedProjects []uuid.UUID `json:"muted_projects,omitempty"`
+}
+
+func (p NotificationPreferences) Validate() error {
+	for kind, channels := range p.Channels {
+		if !lo.Contains(NotificationKinds, kind) {
+			return fmt.Errorf("неизвестный тип уведомлений: %s", kind)
+		}
+
+		if unknown, _ := lo.Difference(channels, NotificationChannels); len(unknown) > 0 {
+			return fmt.Errorf("неизвестный канал уведомлений: %s", unknown[0])
+		}
+	}
+
+	if (p.QuietFrom == "") != (p.QuietTo == "") {
+		return errors.New("тихие часы: нужны начало и конец")
+	}
+
+	for _, s := range []string{p.QuietFrom, p.QuietTo} {
+		if _, ok := quietMinute(s); s != "" && !ok {
+			return fmt.Errorf("тихие часы: неверное время %s, нужно ЧЧ:ММ", s)
+		}
+	}
+
+	return nil
+}
+
+// WithDefaults - every kind with its channels, the defaults for the kinds left out.
+func (p NotificationPreferences) WithDefaults() NotificationPreferences {
+	channels := DefaultNotificationChannels()
+	for kind, c := range p.Channels {
+		channels[kind] = c
+	}
+
+	p.Channels = channels
+	p.MutedProjects = lo.Ternary(p.MutedProjects == nil, []uuid.UUID{}, p.MutedProjects)
+
+	return p
+}
+
+func (p NotificationPreferences) channels(kind string) []string {
+	if c, ok := p.Channels[kind]; ok {
+		return c
+	}
+
+	return DefaultNotificationChannels()[kind]
+}
+
+// Quiet - the local time is within the quiet hours.
+func (p NotificationPreferences) Quiet(local time.Time) bool {
+	from, okFrom := quietMinute(p.QuietFrom)
+	to, okTo := quietMinute(p.QuietTo)
+	if !okFrom || !okTo || from == to {
+		return false
+	}
+
+	now := local.Hour()*60 + local.Minute()
+	if from < to {
+		return now >= from && now < to
+	}
+
+	return now >= from || now < to
+}
+
+func quietMinute(s string) (int, bool) {
+	t, err := time.Parse(quietLayout, s)
+	if err != nil {
+		return 0, false
+	}
+
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// quietExempt - the kinds sent in every turned on channel during the quiet hours too,
+// a reminder or a deadline held back until the morning would come too late; nothing is queued.
+var quietExempt = []string{NotificationReminderDue, NotificationDeadline}
+
+// Notify - the person is to be notified of the kind in the channel now: the project is not muted,
+// the channel is on for the kind and, but for in-app and the exempt kinds, it is not the quiet hours.
+func (p ProfilePreferences) Notify(kind, channel string, projectUUID uuid.UUID, now time.Time) bool {
+	n := lo.FromPtr(p.Notifications)
+
+	if lo.Contains(n.MutedProjects, projectUUID) {
+		return false
+	}
+
+	if !lo.Contains(n.channels(kind), channel) {
+		return false
+	}
+
+	return channel == NotificationInApp || lo.Contains(quietExempt, kind) || !n.Quiet(now.In(p.Location()))
+}
+
+// Location - of the profile timezone, UTC when there is none.
+func (p ProfilePreferences) Location() *time.Location {
+	if p.Timezone == nil {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(*p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// NotificationKind - what the event is for the person: an assignment or a mention of them,
+// a status change, a comment, a reminder, a deadline or any other change.
+func NotificationKind(e Event, email string) string {
+	switch ev := e.(type) {
+	case TaskCreated:
+		if lo.Contains(ev.Assignees, email) {
+			return NotificationAssigned
+		}
+	case TaskUpdated:
+		for _, field := range []string{"implement_by", "responsible_by"} {
+			if v, ok := ev.After[field].(string); ok && v == email {
+				return NotificationAssigned
+			}
+		}
+	case TaskStatusChanged:
+		return NotificationStatusChanged
+	case CommentAdded:
+		if lo.Contains(ev.Mentioned, email) {
+			return NotificationMentioned
+		}
+		return NotificationCommented
+	case CommentUpdated, CommentDeleted, CommentLiked:
+		return NotificationCommented
+	case ReminderDue:
+		return NotificationReminderDue
+	case TaskDeadline:
+		return NotificationDeadline
+	}
+
+	return NotificationUpdated
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestNotificationKind(t *testing.T) {
+	meta := NewEventMeta("boss@mail.ru", uuid.New(), []string{"impl@mail.ru", "other@mail.ru"})
+
+	tests := []struct {
+		name  string
+		e     Event
+		email string
+		want  string
+	}{
+		{"created for the assignee", TaskCreated{EventMeta: meta, Assignees: []string{"impl@mail.ru"}}, "impl@mail.ru", NotificationAssigned},
+		{"created for the others", TaskCreated{EventMeta: meta, Assignees: []string{"impl@mail.ru"}}, "other@mail.ru", NotificationUpdated},
+		{"implementer changed", TaskUpdated{EventMeta: meta, After: map[string]interface{}{"implement_by": "impl@mail.ru"}}, "impl@mail.ru", NotificationAssigned},
+		{"name changed", TaskUpdated{EventMeta: meta, After: map[string]interface{}{"name": "impl@mail.ru"}}, "impl@mail.ru", NotificationUpdated},
+		{"status", TaskStatusChanged{EventMeta: meta}, "impl@mail.ru", NotificationStatusChanged},
+		{"mentioned", CommentAdded{EventMeta: meta, Mentioned: []string{"impl@mail.ru"}}, "impl@mail.ru", NotificationMentioned},
+		{"commented", CommentAdded{EventMeta: meta, Mentioned: []string{"impl@mail.ru"}}, "other@mail.ru", NotificationCommented},
+		{"liked", CommentLiked{EventMeta: meta}, "impl@mail.ru", NotificationCommented},
+		{"reminder", ReminderDue{EventMeta: meta}, "impl@mail.ru", NotificationReminderDue},
+		{"deadline", TaskDeadline{EventMeta: meta}, "impl@mail.ru", NotificationDeadline},
+		{"file", FileUploaded{EventMeta: meta}, "impl@mail.ru", NotificationUpdated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NotificationKind(tt.e, tt.email); got != tt.want {
+				t.Errorf("NotificationKind() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfilePreferencesNotify(t *testing.T) {
+	muted := uuid.New()
+	project := uuid.New()
+	night := time.Date(2026, 10, 18, 20, 30, 0, 0, time.UTC) // 23:30 in Moscow
+	day := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	prefs := ProfilePreferences{
+		Timezone: lo.ToPtr("Europe/Moscow"),
+		Notifications: &NotificationPreferences{
+			Channels:      map[string][]string{NotificationCommented: {NotificationEmail}},
+			QuietFrom:     "22:00",
+			QuietTo:       "08:00",
+			MutedProjects: []uuid.UUID{muted},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		prefs   ProfilePreferences
+		kind    string
+		channel string
+		project uuid.UUID
+		now     time.Time
+		want    bool
+	}{
+		{"defaults in-app", ProfilePreferences{}, NotificationUpdated, NotificationInApp, project, day, true},
+		{"defaults no email", ProfilePreferences{}, NotificationUpdated, NotificationEmail, project, day, false},
+		{"defaults reminder sms", ProfilePreferences{}, NotificationReminderDue, NotificationSMS, project, day, true},
+		{"channel turned on", prefs, NotificationCommented, NotificationEmail, project, day, true},
+		{"channel turned off", prefs, NotificationCommented, NotificationInApp, project, day, false},
+		{"default kept", prefs, NotificationDeadline, NotificationEmail, project, day, true},
+		{"quiet hours", prefs, NotificationCommented, NotificationEmail, project, night, false},
+		{"in-app in quiet hours", prefs, NotificationUpdated, NotificationInApp, project, night, true},
+		{"deadline in quiet hours", prefs, NotificationDeadline, NotificationEmail, project, night, true},
+		{"reminder in quiet hours", prefs, NotificationReminderDue, NotificationSMS, project, night, true},
+		{"muted project", prefs, NotificationDeadline, NotificationInApp, muted, day, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.prefs.Notify(tt.kind, tt.channel, tt.project, tt.now); got != tt.want {
+				t.Errorf("Notify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationPreferencesValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefs   NotificationPreferences
+		wantErr bool
+	}{
+		{"empty", NotificationPreferences{}, false},
+		{"valid", NotificationPreferences{Channels: map[string][]string{NotificationAssigned: {NotificationSMS}}, QuietFrom: "23:00", QuietTo: "07:30"}, false},
+		{"unknown kind", NotificationPreferences{Channels: map[string][]string{"liked": {}}}, true},
+		{"unknown channel", NotificationPreferences{Channels: map[string][]string{NotificationAssigned: {"pigeon"}}}, true},
+		{"quiet from only", NotificationPreferences{QuietFrom: "23:00"}, true},
+		{"bad time", NotificationPreferences{QuietFrom: "25:00", QuietTo: "07:00"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.prefs.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
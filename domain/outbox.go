@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// outbox operations, an update setting deleted_at is a delete
+const (
+	OutboxInsert = "insert"
+	OutboxUpdate = "update"
+	OutboxDelete = "delete"
+)
+
+const (
+	outboxBackoffMin = time.Second
+	outboxBackoffMax = 10 * time.Minute
+)
+
+// OutboxMessage - a change of an aggregate row captured in the transaction of the change.
+type OutboxMessage struct {
+	ID            int64
+	UUID          uuid.UUID
+	Aggregate     string
+	AggregateUUID uuid.UUID
+	Operation     string
+	Payload       json.RawMessage
+	CreatedAt     time.Time
+
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+// Key - messages of the same key are published in the order they were captured.
+func (m OutboxMessage) Key() string {
+	return m.Aggregate + ":" + m.AggregateUUID.String()
+}
+
+// Fail schedules the next attempt with an exponential backoff, true - the attempts are over.
+func (m *OutboxMessage) Fail(err error, now time.Time, maxAttempts int) bool {
+	m.Attempts++
+	m.LastError = err.Error()
+	m.NextAttemptAt = now.Add(OutboxBackoff(m.Attempts))
+
+	return m.Attempts >= maxAttempts
+}
+
+// OutboxBackoff - 1s, 2s, 4s ... up to 10 minutes.
+func OutboxBackoff(attempts int) time.Duration {
+	d := outboxBackoffMin
+	for i := 1; i < attempts && d < outboxBackoffMax; i++ {
+		d *= 2
+	}
+
+	return min(d, outboxBackoffMax)
+}
+
+// OutboxReady picks the messages to publish now from the pending ones sorted by id:
+// a message waiting for its retry holds back the later messages of its key. The relay selects them so in SQL.
+func OutboxReady(pending []OutboxMessage, now time.Time) []OutboxMessage {
+	ready := []OutboxMessage{}
+	held := map[string]bool{}
+
+	for _, m := range pending {
+		if held[m.Key()] {
+			continue
+		}
+
+		if m.NextAttemptAt.After(now) {
+			held[m.Key()] = true
+			continue
+		}
+
+		ready = append(ready, m)
+	}
+
+	return ready
+}
+
+// OutboxBatch - what a relay pass has done with the ready messages, the rest stays pending as is.
+type OutboxBatch struct {
+	Published []int64
+	Retried   []OutboxMessage
+	Dead      []OutboxMessage
+}
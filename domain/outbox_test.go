@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 5, want: 16 * time.Second},
+		{attempts: 10, want: 512 * time.Second},
+		{attempts: 11, want: 10 * time.Minute},
+		{attempts: 100, want: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := OutboxBackoff(tt.attempts); got != tt.want {
+			t.Errorf("OutboxBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+
+	now := time.Now()
+	m := OutboxMessage{}
+
+	if m.Fail(errors.New("broken"), now, 2) || m.Attempts != 1 || !m.NextAttemptAt.Equal(now.Add(time.Second)) {
+		t.Errorf("Fail() = %+v", m)
+	}
+
+	if !m.Fail(errors.New("broken"), now, 2) || m.LastError != "broken" {
+		t.Errorf("Fail() should be over after 2 attempts, got %+v", m)
+	}
+}
+
+func TestOutboxReady(t *testing.T) {
+	now := time.Now()
+	a, b := uuid.New(), uuid.New()
+
+	pending := []OutboxMessage{
+		{ID: 1, Aggregate: "task", AggregateUUID: a, NextAttemptAt: now.Add(time.Minute)},
+		{ID: 2, Aggregate: "task", AggregateUUID: b, NextAttemptAt: now},
+		{ID: 3, Aggregate: "task", AggregateUUID: a, NextAttemptAt: now},
+		{ID: 4, Aggregate: "comment", AggregateUUID: a, NextAttemptAt: now},
+		{ID: 5, Aggregate: "task", AggregateUUID: b, NextAttemptAt: now.Add(-time.Minute)},
+	}
+
+	got := OutboxReady(pending, now)
+	want := []int64{2, 4, 5}
+
+	if len(got) != len(want) {
+		t.Fatalf("OutboxReady() = %v, want ids %v", got, want)
+	}
+
+	for i := range want {
+		if got[i].ID != want[i] {
+			t.Errorf("OutboxReady()[%d] = %d, want %d", i, got[i].ID, want[i])
+		}
+	}
+}
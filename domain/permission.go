@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type Permission struct {
@@ -39,6 +41,126 @@ type PermissionRules struct {
 	TaskCreate           bool `json:"task_create"`
 	TaskDelete           bool `json:"task_delete"`
 	TaskPatch            bool `json:"task_patch"`
+	AgentCreate          bool `json:"agent_create"`
+	AgentDelete          bool `json:"agent_delete"`
+	AgentPatch           bool `json:"agent_patch"`
+	DealCreate           bool `json:"deal_create"`
+	DealDelete           bool `json:"deal_delete"`
+	DealPatch            bool `json:"deal_patch"`
+	TagPatch             bool `json:"tag_patch"`
+	CatalogPatch         bool `json:"catalog_patch"`
+	CatalogDataCreate    bool `json:"catalog_data_create"`
+}
+
+// Permission actions, named after the PermissionRules json keys.
+const (
+	PermissionFederationPatch      = "federation_patch"
+	PermissionFederationInviteUser = "federation_invite_user"
+	PermissionFederationDeleteUser = "federation_delete_user"
+	PermissionCompanyCreate        = "company_create"
+	PermissionCompanyDelete        = "company_delete"
+	PermissionCompanyPatch         = "company_patch"
+	PermissionCompanyAddUser       = "company_add_user"
+	PermissionCompanyDeleteUser    = "company_delete_user"
+	PermissionProjectCreate        = "project_create"
+	PermissionProjectDelete        = "project_delete"
+	PermissionProjectPatch         = "project_patch"
+	PermissionProjectAddUser       = "project_add_user"
+	PermissionProjectDeleteUser    = "project_delete_user"
+	PermissionTaskCreate           = "task_create"
+	PermissionTaskDelete           = "task_delete"
+	PermissionTaskPatch            = "task_patch"
+	PermissionAgentCreate          = "agent_create"
+	PermissionAgentDelete          = "agent_delete"
+	PermissionAgentPatch           = "agent_patch"
+	PermissionDealCreate           = "deal_create"
+	PermissionDealDelete           = "deal_delete"
+	PermissionDealPatch            = "deal_patch"
+	PermissionTagPatch             = "tag_patch"
+	PermissionCatalogPatch         = "catalog_patch"
+	PermissionCatalogDataCreate    = "catalog_data_create"
+)
+
+// PermissionFederationAdmin - members of a group with this permission are superusers, as the federation owner.
+const PermissionFederationAdmin = "federation:admin"
+
+// DefaultPermissionRules apply to federation members without stored rules, the members from before
+// the rules were enforced have their former rights stored by a migration.
+var DefaultPermissionRules = PermissionRules{
+	TaskCreate:        true,
+	TaskPatch:         true,
+	AgentCreate:       true,
+	AgentPatch:        true,
+	DealCreate:        true,
+	DealPatch:         true,
+	TagPatch:          true,
+	CatalogDataCreate: true,
+}
+
+func (j *PermissionRules) flags() map[string]*bool {
+	return map[string]*bool{
+		PermissionFederationPatch:      &j.FederationPatch,
+		PermissionFederationInviteUser: &j.FederationInviteUser,
+		PermissionFederationDeleteUser: &j.FederationDeleteUser,
+		PermissionCompanyCreate:        &j.CompanyCreate,
+		PermissionCompanyDelete:        &j.CompanyDelete,
+		PermissionCompanyPatch:         &j.CompanyPatch,
+		PermissionCompanyAddUser:       &j.CompanyAddUser,
+		PermissionCompanyDeleteUser:    &j.CompanyDeleteUser,
+		PermissionProjectCreate:        &j.ProjectCreate,
+		PermissionProjectDelete:        &j.ProjectDelete,
+		PermissionProjectPatch:         &j.ProjectPatch,
+		PermissionProjectAddUser:       &j.ProjectAddUser,
+		PermissionProjectDeleteUser:    &j.ProjectDeleteUser,
+		PermissionTaskCreate:           &j.TaskCreate,
+		PermissionTaskDelete:           &j.TaskDelete,
+		PermissionTaskPatch:            &j.TaskPatch,
+		PermissionAgentCreate:          &j.AgentCreate,
+		PermissionAgentDelete:          &j.AgentDelete,
+		PermissionAgentPatch:           &j.AgentPatch,
+		PermissionDealCreate:           &j.DealCreate,
+		PermissionDealDelete:           &j.DealDelete,
+		PermissionDealPatch:            &j.DealPatch,
+		PermissionTagPatch:             &j.TagPatch,
+		PermissionCatalogPatch:         &j.CatalogPatch,
+		PermissionCatalogDataCreate:    &j.CatalogDataCreate,
+	}
+}
+
+func (j PermissionRules) Allows(action string) bool {
+	flag, ok := j.flags()[action]
+
+	return ok && *flag
+}
+
+func (j *PermissionRules) Set(action string, allow bool) error {
+	flag, ok := j.flags()[action]
+	if !ok {
+		return fmt.Errorf("неизвестное право: %s", action)
+	}
+
+	*flag = allow
+
+	return nil
+}
+
+// Covers reports whether every action allowed by other is allowed by j as well.
+func (j PermissionRules) Covers(other PermissionRules) bool {
+	for action, flag := range other.flags() {
+		if *flag && !j.Allows(action) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PermissionActions returns every known action in a stable order.
+func PermissionActions() []string {
+	actions := lo.Keys((&PermissionRules{}).flags())
+	sort.Strings(actions)
+
+	return actions
 }
 
 func (j *PermissionRules) Scan(value interface{}) error {
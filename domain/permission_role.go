@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+const (
+	PermissionScopeFederation = "federation"
+	PermissionScopeCompany    = "company"
+	PermissionScopeProject    = "project"
+)
+
+const (
+	RoleOwner   = "owner"
+	RoleAdmin   = "admin"
+	RoleManager = "manager"
+	RoleMember  = "member"
+	RoleGuest   = "guest"
+)
+
+type PermissionRole struct {
+	Name  string
+	Rules PermissionRules
+}
+
+// PermissionRoles - role templates from the widest to the narrowest.
+var PermissionRoles = []PermissionRole{
+	{
+		Name: RoleOwner,
+		Rules: PermissionRules{
+			FederationPatch: true, FederationInviteUser: true, FederationDeleteUser: true,
+			CompanyCreate: true, CompanyDelete: true, CompanyPatch: true, CompanyAddUser: true, CompanyDeleteUser: true,
+			ProjectCreate: true, ProjectDelete: true, ProjectPatch: true, ProjectAddUser: true, ProjectDeleteUser: true,
+			TaskCreate: true, TaskDelete: true, TaskPatch: true,
+			AgentCreate: true, AgentDelete: true, AgentPatch: true,
+			DealCreate: true, DealDelete: true, DealPatch: true,
+			TagPatch: true, CatalogPatch: true, CatalogDataCreate: true,
+		},
+	},
+	{
+		Name: RoleAdmin,
+		Rules: PermissionRules{
+			FederationInviteUser: true, FederationDeleteUser: true,
+			CompanyCreate: true, CompanyDelete: true, CompanyPatch: true, CompanyAddUser: true, CompanyDeleteUser: true,
+			ProjectCreate: true, ProjectDelete: true, ProjectPatch: true, ProjectAddUser: true, ProjectDeleteUser: true,
+			TaskCreate: true, TaskDelete: true, TaskPatch: true,
+			AgentCreate: true, AgentDelete: true, AgentPatch: true,
+			DealCreate: true, DealDelete: true, DealPatch: true,
+			TagPatch: true, CatalogPatch: true, CatalogDataCreate: true,
+		},
+	},
+	{
+		Name: RoleManager,
+		Rules: PermissionRules{
+			CompanyPatch:  true,
+			ProjectCreate: true, ProjectPatch: true, ProjectAddUser: true, ProjectDeleteUser: true,
+			TaskCreate: true, TaskDelete: true, TaskPatch: true,
+			AgentCreate: true, AgentDelete: true, AgentPatch: true,
+			DealCreate: true, DealDelete: true, DealPatch: true,
+			TagPatch: true, CatalogPatch: true, CatalogDataCreate: true,
+		},
+	},
+	{
+		Name:  RoleMember,
+		Rules: DefaultPermissionRules,
+	},
+	{
+		Name:  RoleGuest,
+		Rules: PermissionRules{},
+	},
+}
+
+func FindPermissionRole(name string) (PermissionRole, bool) {
+	return lo.Find(PermissionRoles, func(r PermissionRole) bool {
+		return r.Name == name
+	})
+}
+
+// PermissionRoleAssignment gives a user a role in a federation, a company or a project.
+// Role replaces the inherited rules, Allow and Deny extend or narrow them.
+type PermissionRoleAssignment struct {
+	UUID           uuid.UUID
+	UserUUID       uuid.UUID `validate:"uuid"  ru:"пользователь (uuid)"`
+	FederationUUID uuid.UUID `validate:"uuid"  ru:"федерация (uuid)"`
+	Scope          string    `validate:"oneof=federation company project"  ru:"уровень"`
+	EntityUUID     uuid.UUID `validate:"uuid"  ru:"сущность (uuid)"`
+	Role           string    `validate:"lte=20"  ru:"роль"`
+	Allow          []string
+	Deny           []string
+
+	CreatedBy string `validate:"lte=100,gte=3"  ru:"автор (email)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewPermissionRoleAssignment(userUUID uuid.UUID, scope PermissionScope, role string, allow, deny []string, createdBy string) (*PermissionRoleAssignment, error) {
+	if role != "" {
+		if _, ok := FindPermissionRole(role); !ok {
+			return nil, fmt.Errorf("неизвестная роль: %s", role)
+		}
+	}
+
+	allow = lo.Uniq(allow)
+	deny = lo.Uniq(deny)
+
+	for _, action := range lo.Union(allow, deny) {
+		if lo.IndexOf(PermissionActions(), action) == -1 {
+			return nil, fmt.Errorf("неизвестное право: %s", action)
+		}
+	}
+
+	if both := lo.Intersect(allow, deny); len(both) > 0 {
+		return nil, fmt.Errorf("право одновременно разрешено и запрещено: %s", both[0])
+	}
+
+	if role == "" && len(allow) == 0 && len(deny) == 0 {
+		return nil, errors.New("нужно указать роль или права")
+	}
+
+	dm := &PermissionRoleAssignment{
+		UUID:           uuid.New(),
+		UserUUID:       userUUID,
+		FederationUUID: scope.FederationUUID,
+		Scope:          scope.Type(),
+		EntityUUID:     scope.EntityUUID(),
+		Role:           role,
+		Allow:          allow,
+		Deny:           deny,
+		CreatedBy:      createdBy,
+	}
+
+	errs, ok := helpers.ValidationStruct(dm)
+	if !ok {
+		return dm, errors.New(helpers.Join(errs, ", "))
+	}
+
+	return dm, nil
+}
+
+func (a PermissionRoleAssignment) Apply(rules PermissionRules) PermissionRules {
+	if role, ok := FindPermissionRole(a.Role); ok {
+		rules = role.Rules
+	}
+
+	for _, action := range a.Allow {
+		_ = rules.Set(action, true)
+	}
+
+	for _, action := range a.Deny {
+		_ = rules.Set(action, false)
+	}
+
+	return rules
+}
+
+// PermissionScope - the entity chain from a federation down to a project.
+type PermissionScope struct {
+	FederationUUID uuid.UUID
+	CompanyUUID    *uuid.UUID
+	ProjectUUID    *uuid.UUID
+}
+
+func (s PermissionScope) Type() string {
+	switch {
+	case s.ProjectUUID != nil:
+		return PermissionScopeProject
+	case s.CompanyUUID != nil:
+		return PermissionScopeCompany
+	}
+
+	return PermissionScopeFederation
+}
+
+func (s PermissionScope) EntityUUID() uuid.UUID {
+	switch {
+	case s.ProjectUUID != nil:
+		return *s.ProjectUUID
+	case s.CompanyUUID != nil:
+		return *s.CompanyUUID
+	}
+
+	return s.FederationUUID
+}
+
+// EffectiveRules applies the assignments of the federation, then the company, then the project.
+func (s PermissionScope) EffectiveRules(base PermissionRules, assignments []PermissionRoleAssignment) PermissionRules {
+	chain := []struct {
+		scope string
+		uid   *uuid.UUID
+	}{
+		{PermissionScopeFederation, &s.FederationUUID},
+		{PermissionScopeCompany, s.CompanyUUID},
+		{PermissionScopeProject, s.ProjectUUID},
+	}
+
+	rules := base
+	for _, link := range chain {
+		if link.uid == nil {
+			continue
+		}
+
+		for _, a := range assignments {
+			if a.Scope == link.scope && a.EntityUUID == *link.uid {
+				rules = a.Apply(rules)
+			}
+		}
+	}
+
+	return rules
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPermissionScopeEffectiveRules(t *testing.T) {
+	federationUUID, companyUUID, projectUUID := uuid.New(), uuid.New(), uuid.New()
+	userUUID := uuid.New()
+
+	federation := PermissionScope{FederationUUID: federationUUID}
+	company := PermissionScope{FederationUUID: federationUUID, CompanyUUID: &companyUUID}
+	project := PermissionScope{FederationUUID: federationUUID, CompanyUUID: &companyUUID, ProjectUUID: &projectUUID}
+
+	manager, err := NewPermissionRoleAssignment(userUUID, federation, RoleManager, nil, nil, "test@test.ru")
+	if err != nil {
+		t.Fatalf("NewPermissionRoleAssignment() error = %v", err)
+	}
+
+	narrow, _ := NewPermissionRoleAssignment(userUUID, company, "", nil, []string{PermissionTaskDelete}, "test@test.ru")
+	extend, _ := NewPermissionRoleAssignment(userUUID, project, "", []string{PermissionProjectDelete}, nil, "test@test.ru")
+
+	roles := []PermissionRoleAssignment{*extend, *narrow, *manager}
+
+	if rules := federation.EffectiveRules(DefaultPermissionRules, roles); !rules.TaskDelete || rules.ProjectDelete {
+		t.Errorf("federation rules = %+v", rules)
+	}
+
+	if rules := company.EffectiveRules(DefaultPermissionRules, roles); rules.TaskDelete || !rules.TaskPatch {
+		t.Errorf("company rules = %+v", rules)
+	}
+
+	if rules := project.EffectiveRules(DefaultPermissionRules, roles); rules.TaskDelete || !rules.ProjectDelete {
+		t.Errorf("project rules = %+v", rules)
+	}
+
+	// a different project of the same company inherits only the company rules
+	other := uuid.New()
+	sibling := PermissionScope{FederationUUID: federationUUID, CompanyUUID: &companyUUID, ProjectUUID: &other}
+	if rules := sibling.EffectiveRules(DefaultPermissionRules, roles); rules.ProjectDelete {
+		t.Errorf("sibling project rules = %+v", rules)
+	}
+}
+
+func TestNewPermissionRoleAssignment(t *testing.T) {
+	scope := PermissionScope{FederationUUID: uuid.New()}
+
+	if _, err := NewPermissionRoleAssignment(uuid.New(), scope, "root", nil, nil, "test@test.ru"); err == nil {
+		t.Errorf("unknown role should fail")
+	}
+
+	if _, err := NewPermissionRoleAssignment(uuid.New(), scope, "", []string{"task_fly"}, nil, "test@test.ru"); err == nil {
+		t.Errorf("unknown action should fail")
+	}
+
+	if _, err := NewPermissionRoleAssignment(uuid.New(), scope, "", []string{PermissionTaskPatch}, []string{PermissionTaskPatch}, "test@test.ru"); err == nil {
+		t.Errorf("allowed and denied action should fail")
+	}
+
+	if _, err := NewPermissionRoleAssignment(uuid.New(), scope, "", nil, nil, "test@test.ru"); err == nil {
+		t.Errorf("empty assignment should fail")
+	}
+
+	guest, _ := FindPermissionRole(RoleGuest)
+	owner, _ := FindPermissionRole(RoleOwner)
+	if !owner.Rules.Covers(DefaultPermissionRules) || guest.Rules.Covers(DefaultPermissionRules) {
+		t.Errorf("Covers() owner/guest mismatch")
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPermissionRulesAllows(t *testing.T) {
+	rules := PermissionRules{TaskDelete: true, ProjectPatch: true}
+
+	if !rules.Allows(PermissionTaskDelete) || !rules.Allows(PermissionProjectPatch) {
+		t.Errorf("Allows() granted rules are denied")
+	}
+
+	if rules.Allows(PermissionCompanyAddUser) || rules.Allows("unknown") {
+		t.Errorf("Allows() denied rules are granted")
+	}
+
+	// every action is named after the json key of its flag
+	all := map[string]bool{}
+	raw, _ := json.Marshal(PermissionRules{})
+	_ = json.Unmarshal(raw, &all)
+
+	for action := range all {
+		raw, _ := json.Marshal(map[string]bool{action: true})
+
+		var r PermissionRules
+		_ = json.Unmarshal(raw, &r)
+
+		if !r.Allows(action) {
+			t.Errorf("Allows(%q) = false, want true", action)
+		}
+	}
+}
@@ -47,6 +47,12 @@ type ProjectOptions struct {
 	RequireDoneComment        *bool   `json:"require_done_comment,omitempty"`
 	StatusEnable              *bool   `json:"status_enable,omitempty"`
 	Color                     *string `json:"color,omitempty"`
+	// Private - tasks are visible only to the project members and the task participants
+	Private *bool `json:"private,omitempty"`
+	// DeadlineLeadHours - how long before FinishTo the task people are warned, 24 when empty, 0 turns it off
+	DeadlineLeadHours *int `json:"deadline_lead_hours,omitempty"`
+	// DeadlineEscalateHours - how long after FinishTo the project responsible is told, never when empty
+	DeadlineEscalateHours *int `json:"deadline_escalate_hours,omitempty"`
 }
 
 type ProjectParams struct {
@@ -67,6 +73,7 @@ func (j *ProjectOptions) Scan(value interface{}) error {
 		RequireCancelationComment: helpers.Ptr(false),
 		RequireDoneComment:        helpers.Ptr(false),
 		StatusEnable:              helpers.Ptr(false),
+		Private:                   helpers.Ptr(false),
 	}
 	err := json.Unmarshal(bytes, &result)
 	*j = result
@@ -0,0 +1,22 @@
+package domain
+
+import "testing"
+
+func TestProjectOptionsScanPrivate(t *testing.T) {
+	var opt ProjectOptions
+	if err := opt.Scan([]byte(`{"color":"#ffffff"}`)); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if opt.Private == nil || *opt.Private {
+		t.Errorf("Scan() private = %v, want false by default", opt.Private)
+	}
+
+	if err := opt.Scan([]byte(`{"private":true}`)); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if opt.Private == nil || !*opt.Private {
+		t.Errorf("Scan() private = %v, want true", opt.Private)
+	}
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// push topics, a client subscribes to its notifications, to tasks and to projects
+const (
+	PushTopicNotifications = "notifications"
+	PushTopicTask          = "task"
+	PushTopicProject       = "project"
+)
+
+// push message types besides the event names
+const (
+	PushNotificationsCount = "notifications.count"
+	PushSubscribed         = "subscribed"
+	PushUnsubscribed       = "unsubscribed"
+	PushError              = "error"
+)
+
+var ErrPushTopic = errors.New("неизвестная тема подписки")
+
+// PushMessage - what a websocket client receives, Topic is "kind:key" as task:<uuid> or notifications:<email>.
+type PushMessage struct {
+	Type  string          `json:"type"`
+	Topic string          `json:"topic,omitempty"`
+	Data  json.RawMessage `json:"data,omitempty"`
+}
+
+func NewPushMessage(kind, topic string, data interface{}) (PushMessage, error) {
+	m := PushMessage{
+		Type:  kind,
+		Topic: topic,
+	}
+
+	if data == nil {
+		return m, nil
+	}
+
+	b, err := json.Marshal(data)
+	m.Data = b
+
+	return m, err
+}
+
+func PushTaskTopic(uid uuid.UUID) string {
+	return PushTopicTask + ":" + uid.String()
+}
+
+func PushProjectTopic(uid uuid.UUID) string {
+	return PushTopicProject + ":" + uid.String()
+}
+
+func PushNotificationsTopic(email string) string {
+	return PushTopicNotifications + ":" + email
+}
+
+// PushRequest - what a websocket client sends: subscribe or unsubscribe to a topic,
+// the notifications are of the user only so they need no uuid.
+type PushRequest struct {
+	Action string    `json:"action"`
+	Topic  string    `json:"topic"`
+	UUID   uuid.UUID `json:"uuid"`
+}
+
+func (r PushRequest) Validate() error {
+	if r.Action != "subscribe" && r.Action != "unsubscribe" {
+		return errors.New("неизвестное действие: " + r.Action)
+	}
+
+	switch r.Topic {
+	case PushTopicNotifications:
+		return nil
+	case PushTopicTask, PushTopicProject:
+		if r.UUID == uuid.Nil {
+			return errors.New("не передан uuid")
+		}
+		return nil
+	}
+
+	return ErrPushTopic
+}
+
+// Key - the topic of the request for the user.
+func (r PushRequest) Key(email string) string {
+	switch r.Topic {
+	case PushTopicNotifications:
+		return PushNotificationsTopic(email)
+	case PushTopicTask:
+		return PushTaskTopic(r.UUID)
+	default:
+		return PushProjectTopic(r.UUID)
+	}
+}
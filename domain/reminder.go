@@ -1,6 +1,12 @@
 package domain
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,7 +24,268 @@ type Reminder struct {
 	Type          string     `validate:"gte=1,lte=50"  ru:"тип"`
 	UserUUID      *uuid.UUID `validate:"uuid"  ru:"пользователь (uuid)"`
 	Status        int        `validate:"gte=0,lte=10"  ru:"статус"`
+	FiredAt       *time.Time
+
+	// Recurrence - nil for one-time reminders
+	Recurrence *ReminderRecurrence
+	// Occurrence - number of already fired occurrences
+	Occurrence int
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+)
+
+var ErrRecurrenceWithoutDate = errors.New("для повторяющегося дела нужна дата")
+
+// ReminderRecurrence is a reduced RRULE: FREQ, INTERVAL, BYDAY, BYMONTHDAY, UNTIL and COUNT.
+type ReminderRecurrence struct {
+	Freq     string `json:"freq"`
+	Interval int    `json:"interval,omitempty"`
+	// Weekdays - time.Weekday values (0 - sunday), only for weekly
+	Weekdays []int `json:"weekdays,omitempty"`
+	// MonthDay - 1..31, the last day of a month is used for short months, only for monthly
+	MonthDay int        `json:"month_day,omitempty"`
+	Until    *time.Time `json:"until,omitempty"`
+	// Count - total number of occurrences, 0 - unlimited
+	Count int `json:"count,omitempty"`
+}
+
+func (j *ReminderRecurrence) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := ReminderRecurrence{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j ReminderRecurrence) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+func (j *ReminderRecurrence) Validate() error {
+	if j.Interval < 0 || j.Interval > 365 {
+		return errors.New("интервал повторения от 1 до 365")
+	}
+
+	if j.Count < 0 || j.Count > 1000 {
+		return errors.New("количество повторений от 1 до 1000")
+	}
+
+	switch j.Freq {
+	case RecurrenceDaily:
+	case RecurrenceWeekly:
+		for _, wd := range j.Weekdays {
+			if wd < 0 || wd > 6 {
+				return errors.New("день недели от 0 до 6")
+			}
+		}
+	case RecurrenceMonthly:
+		if j.MonthDay < 0 || j.MonthDay > 31 {
+			return errors.New("день месяца от 1 до 31")
+		}
+	default:
+		return fmt.Errorf("неизвестная периодичность: %s", j.Freq)
+	}
+
+	return nil
+}
+
+// Anchor fixes the day of a monthly recurrence to the day of start unless it is set: the day of
+// an occurrence clamped to a short month is not the day of the next one (Jan 31, Feb 28, Mar 31).
+func (j *ReminderRecurrence) Anchor(start time.Time) {
+	if j.Freq == RecurrenceMonthly && j.MonthDay == 0 {
+		j.MonthDay = start.Day()
+	}
+}
+
+// Equal - the same series, both anchored: the interval defaults to 1, the weekdays are a set
+// and Until is compared as an instant, whatever its location.
+func (j *ReminderRecurrence) Equal(o *ReminderRecurrence) bool {
+	if j == nil || o == nil {
+		return j == o
+	}
+
+	if (j.Until == nil) != (o.Until == nil) || (j.Until != nil && !j.Until.Equal(*o.Until)) {
+		return false
+	}
+
+	return j.Freq == o.Freq && j.interval() == o.interval() && j.MonthDay == o.MonthDay && j.Count == o.Count &&
+		slices.Equal(weekdaySet(j.Weekdays), weekdaySet(o.Weekdays))
+}
+
+func weekdaySet(days []int) []int {
+	set := slices.Clone(days)
+	sort.Ints(set)
+
+	return slices.Compact(set)
+}
+
+func (j *ReminderRecurrence) interval() int {
+	if j.Interval < 1 {
+		return 1
+	}
+
+	return j.Interval
+}
+
+// Next returns the occurrence following cur, occurrence is the zero based index of cur.
+func (j *ReminderRecurrence) Next(cur time.Time, occurrence int) (time.Time, bool) {
+	if j.Count > 0 && occurrence+1 >= j.Count {
+		return cur, false
+	}
+
+	var next time.Time
+
+	switch j.Freq {
+	case RecurrenceDaily:
+		next = cur.AddDate(0, 0, j.interval())
+	case RecurrenceWeekly:
+		next = j.nextWeekly(cur)
+	case RecurrenceMonthly:
+		next = j.nextMonthly(cur)
+	default:
+		return cur, false
+	}
+
+	if j.Until != nil && next.After(*j.Until) {
+		return cur, false
+	}
+
+	return next, true
+}
+
+// weeks start on monday.
+func mondayIndex(wd time.Weekday) int {
+	return (int(wd) + 6) % 7
+}
+
+func (j *ReminderRecurrence) nextWeekly(cur time.Time) time.Time {
+	if len(j.Weekdays) == 0 {
+		return cur.AddDate(0, 0, 7*j.interval())
+	}
+
+	days := make([]int, 0, len(j.Weekdays))
+	for _, wd := range j.Weekdays {
+		days = append(days, mondayIndex(time.Weekday(wd)))
+	}
+	sort.Ints(days)
+
+	curIdx := mondayIndex(cur.Weekday())
+	for _, d := range days {
+		if d > curIdx {
+			return cur.AddDate(0, 0, d-curIdx)
+		}
+	}
+
+	return cur.AddDate(0, 0, 7*j.interval()-curIdx+days[0])
+}
+
+func (j *ReminderRecurrence) nextMonthly(cur time.Time) time.Time {
+	return j.monthsAfter(cur, j.interval())
+}
+
+// monthsAfter - the day of the recurrence in the month so many months after cur.
+func (j *ReminderRecurrence) monthsAfter(cur time.Time, months int) time.Time {
+	day := j.MonthDay
+	if day == 0 {
+		day = cur.Day()
+	}
+
+	first := time.Date(cur.Year(), cur.Month()+time.Month(months), 1, cur.Hour(), cur.Minute(), cur.Second(), 0, cur.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	if day > last {
+		day = last
+	}
+
+	return first.AddDate(0, 0, day-1)
+}
+
+// Advance moves a recurring reminder to its next occurrence.
+func (r *Reminder) Advance() bool {
+	if r.Recurrence == nil || r.DateFrom == nil {
+		return false
+	}
+
+	next, ok := r.Recurrence.Next(*r.DateFrom, r.Occurrence)
+	if !ok {
+		return false
+	}
+
+	if r.DateTo != nil {
+		dateTo := next.Add(r.DateTo.Sub(*r.DateFrom))
+		r.DateTo = &dateTo
+	}
+
+	r.DateFrom = &next
+	r.Occurrence++
+	r.FiredAt = nil
+
+	return true
+}
+
+// Due - the reminder is to be fired now: it is dated, not fired yet and neither done nor canceled.
+// GetDue of the reminders repository selects them so in SQL.
+func (r Reminder) Due(now time.Time) bool {
+	return r.DateFrom != nil && !r.DateFrom.After(now) && r.FiredAt == nil &&
+		r.Status != StatusDone && r.Status != StatusCancel
+}
+
+// Fire moves a recurring reminder to its first occurrence after now, those missed in between are skipped
+// rather than fired one per scan, or marks a one-time one or the last occurrence as fired; true - it was moved.
+// A series that ends while skipping is left at its last occurrence and marked fired as well.
+func (r *Reminder) Fire(now time.Time) bool {
+	moved := 0
+	for moved < recurrenceCatchUp && r.Advance() {
+		moved++
+
+		if r.DateFrom.After(now) {
+			return true
+		}
+	}
+
+	if moved == recurrenceCatchUp {
+		return true
+	}
+
+	r.FiredAt = &now
+
+	return moved > 0
+}
+
+// Occurrences expands the reminder into occurrences starting in [from, to].
+func (r Reminder) Occurrences(from, to time.Time, limit int) []Reminder {
+	res := []Reminder{}
+
+	if r.DateFrom == nil {
+		return res
+	}
+
+	cur := r
+	if r.Recurrence != nil {
+		rec := *r.Recurrence
+		rec.Anchor(*r.DateFrom)
+		cur.Recurrence = &rec
+	}
+
+	for len(res) < limit && !cur.DateFrom.After(to) {
+		if !cur.DateFrom.Before(from) {
+			res = append(res, cur)
+		}
+
+		if !cur.Advance() {
+			break
+		}
+	}
+
+	return res
+}
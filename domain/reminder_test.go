@@ -0,0 +1,260 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReminderRecurrenceNext(t *testing.T) {
+	// wednesday
+	start := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		rec        ReminderRecurrence
+		occurrence int
+		want       time.Time
+		wantOk     bool
+	}{
+		{
+			name:   "daily",
+			rec:    ReminderRecurrence{Freq: RecurrenceDaily},
+			want:   time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "every 3 days",
+			rec:    ReminderRecurrence{Freq: RecurrenceDaily, Interval: 3},
+			want:   time.Date(2024, 2, 3, 10, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "weekly same week",
+			rec:    ReminderRecurrence{Freq: RecurrenceWeekly, Weekdays: []int{1, 5}},
+			want:   time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "weekly next week",
+			rec:    ReminderRecurrence{Freq: RecurrenceWeekly, Weekdays: []int{1, 3}},
+			want:   time.Date(2024, 2, 5, 10, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "biweekly on sunday",
+			rec:    ReminderRecurrence{Freq: RecurrenceWeekly, Interval: 2, Weekdays: []int{0, 2}},
+			want:   time.Date(2024, 2, 4, 10, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "monthly short month",
+			rec:    ReminderRecurrence{Freq: RecurrenceMonthly},
+			want:   time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "monthly on day",
+			rec:    ReminderRecurrence{Freq: RecurrenceMonthly, Interval: 2, MonthDay: 15},
+			want:   time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "until",
+			rec:    ReminderRecurrence{Freq: RecurrenceDaily, Interval: 2, Until: &until},
+			wantOk: false,
+		},
+		{
+			name:       "count",
+			rec:        ReminderRecurrence{Freq: RecurrenceDaily, Count: 3},
+			occurrence: 2,
+			wantOk:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.rec.Next(start, tt.occurrence)
+			if ok != tt.wantOk {
+				t.Errorf("Next() ok = %v, want %v", ok, tt.wantOk)
+				return
+			}
+
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReminderOccurrences(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	r := Reminder{
+		DateFrom:   &start,
+		DateTo:     &end,
+		Recurrence: &ReminderRecurrence{Freq: RecurrenceDaily, Count: 5},
+	}
+
+	got := r.Occurrences(start.AddDate(0, 0, 2), start.AddDate(0, 1, 0), 100)
+	if len(got) != 3 {
+		t.Fatalf("Occurrences() len = %v, want 3", len(got))
+	}
+
+	if got[0].DateTo.Sub(*got[0].DateFrom) != time.Hour {
+		t.Errorf("Occurrences() duration = %v, want 1h", got[0].DateTo.Sub(*got[0].DateFrom))
+	}
+
+	if got[2].Occurrence != 4 {
+		t.Errorf("Occurrences() last occurrence = %v, want 4", got[2].Occurrence)
+	}
+}
+
+func TestReminderDue(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	tests := []struct {
+		name string
+		r    Reminder
+		want bool
+	}{
+		{"no date", Reminder{}, false},
+		{"due", Reminder{DateFrom: &past}, true},
+		{"now", Reminder{DateFrom: &now}, true},
+		{"later", Reminder{DateFrom: &future}, false},
+		{"fired", Reminder{DateFrom: &past, FiredAt: &past}, false},
+		{"done", Reminder{DateFrom: &past, Status: StatusDone}, false},
+		{"canceled", Reminder{DateFrom: &past, Status: StatusCancel}, false},
+		{"in work", Reminder{DateFrom: &past, Status: StatusInWork}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Due(now); got != tt.want {
+				t.Errorf("Due() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// the scheduler scans every minute and fires what is due
+func TestReminderFire(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	once := Reminder{DateFrom: &start}
+	daily := Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceDaily, Count: 3}}
+
+	fired := map[string][]time.Time{}
+	for now := start.Add(-time.Hour); now.Before(start.AddDate(0, 0, 5)); now = now.Add(time.Minute) {
+		for name, r := range map[string]*Reminder{"once": &once, "daily": &daily} {
+			if r.Due(now) {
+				fired[name] = append(fired[name], *r.DateFrom)
+				r.Fire(now)
+			}
+		}
+	}
+
+	if len(fired["once"]) != 1 || !fired["once"][0].Equal(start) || once.FiredAt == nil {
+		t.Errorf("once fired at %v, want once at %v", fired["once"], start)
+	}
+
+	want := []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)}
+	if len(fired["daily"]) != len(want) {
+		t.Fatalf("daily fired at %v, want %v", fired["daily"], want)
+	}
+
+	for i := range want {
+		if !fired["daily"][i].Equal(want[i]) {
+			t.Errorf("daily fired at %v, want %v", fired["daily"][i], want[i])
+		}
+	}
+
+	if daily.FiredAt == nil || daily.Occurrence != 2 {
+		t.Errorf("daily after the last occurrence: fired %v, occurrence %d", daily.FiredAt, daily.Occurrence)
+	}
+}
+
+func TestReminderMonthlyAnchor(t *testing.T) {
+	start := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	r := Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceMonthly}}
+
+	got := r.Occurrences(start, start.AddDate(0, 4, 0), 100)
+
+	want := []time.Time{
+		start,
+		time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 30, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 5, 31, 9, 0, 0, 0, time.UTC),
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Occurrences() len = %v, want %v", len(got), len(want))
+	}
+
+	for i := range want {
+		if !got[i].DateFrom.Equal(want[i]) {
+			t.Errorf("Occurrences()[%d] = %v, want %v", i, got[i].DateFrom, want[i])
+		}
+	}
+}
+
+func TestReminderRecurrenceEqual(t *testing.T) {
+	until := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	moscow := until.In(time.FixedZone("MSK", 3*60*60))
+
+	stored := &ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 31, Until: &until, Count: 5}
+
+	tests := []struct {
+		name string
+		rec  *ReminderRecurrence
+		want bool
+	}{
+		{"same", &ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 31, Until: &until, Count: 5}, true},
+		{"until in another location", &ReminderRecurrence{Freq: RecurrenceMonthly, Interval: 1, MonthDay: 31, Until: &moscow, Count: 5}, true},
+		{"other day", &ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 30, Until: &until, Count: 5}, false},
+		{"no until", &ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 31, Count: 5}, false},
+		{"other count", &ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 31, Until: &until, Count: 6}, false},
+		{"none", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stored.Equal(tt.rec); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	weekly := &ReminderRecurrence{Freq: RecurrenceWeekly, Weekdays: []int{1, 3}}
+	if !weekly.Equal(&ReminderRecurrence{Freq: RecurrenceWeekly, Weekdays: []int{3, 1, 1}}) {
+		t.Errorf("Equal() should ignore the order of the weekdays")
+	}
+
+	var none *ReminderRecurrence
+	if !none.Equal(nil) {
+		t.Errorf("Equal() of no recurrences should be true")
+	}
+}
+
+func TestReminderFireBehind(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := start.AddDate(0, 1, 0).Add(time.Hour)
+
+	daily := Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceDaily}}
+	if !daily.Fire(now) || !daily.DateFrom.Equal(start.AddDate(0, 1, 1)) || daily.Occurrence != 32 || daily.FiredAt != nil {
+		t.Errorf("daily a month behind: at %v, occurrence %d, fired %v", daily.DateFrom, daily.Occurrence, daily.FiredAt)
+	}
+
+	if daily.Due(now) {
+		t.Errorf("daily a month behind should not be due again")
+	}
+
+	counted := Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceDaily, Count: 5}}
+	if !counted.Fire(now) || !counted.DateFrom.Equal(start.AddDate(0, 0, 4)) || counted.Occurrence != 4 || counted.FiredAt == nil {
+		t.Errorf("ended series: at %v, occurrence %d, fired %v", counted.DateFrom, counted.Occurrence, counted.FiredAt)
+	}
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+const (
+	SearchTypeTask    = "task"
+	SearchTypeComment = "comment"
+	SearchTypeCatalog = "catalog"
+)
+
+var SearchTypes = []string{SearchTypeTask, SearchTypeComment, SearchTypeCatalog}
+
+// SearchQuery - a full-text query over the federation limited to what the user may see.
+type SearchQuery struct {
+	FederationUUID uuid.UUID `validate:"uuid"  ru:"федерация (uuid)"`
+	Query          string    `validate:"gte=2,lte=200"  ru:"запрос"`
+	Types          []string
+	Limit          int `validate:"gte=1,lte=100"  ru:"лимит"`
+	Offset         int `validate:"gte=0"  ru:"смещение"`
+
+	UserUUID  uuid.UUID
+	UserEmail string
+	// CompanyUUIDs - the companies of the user, the hits of other companies are dropped
+	CompanyUUIDs []uuid.UUID
+	// Superuser sees the tasks of private projects
+	Superuser bool
+}
+
+func NewSearchQuery(federationUUID uuid.UUID, query string, types []string, limit, offset int) (*SearchQuery, error) {
+	types = lo.Uniq(types)
+	if len(types) == 0 {
+		types = SearchTypes
+	}
+
+	for _, t := range types {
+		if lo.IndexOf(SearchTypes, t) == -1 {
+			return nil, fmt.Errorf("неизвестный тип поиска: %s", t)
+		}
+	}
+
+	q := &SearchQuery{
+		FederationUUID: federationUUID,
+		Query:          strings.TrimSpace(query),
+		Types:          types,
+		Limit:          limit,
+		Offset:         offset,
+	}
+
+	errs, ok := helpers.ValidationStruct(q)
+	if !ok {
+		return q, errors.New(helpers.Join(errs, ", "))
+	}
+
+	return q, nil
+}
+
+func (q SearchQuery) Has(searchType string) bool {
+	return lo.IndexOf(q.Types, searchType) != -1
+}
+
+// SearchHit - a ranked match, Headline marks the matched words with <mark>.
+type SearchHit struct {
+	Type        string
+	UUID        uuid.UUID
+	TaskUUID    *uuid.UUID
+	CatalogUUID *uuid.UUID
+	ProjectUUID *uuid.UUID
+	CompanyUUID uuid.UUID
+	Title       string
+	Headline    string
+	Rank        float64
+	CreatedAt   time.Time
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewSearchQuery(t *testing.T) {
+	federationUUID := uuid.New()
+
+	tests := []struct {
+		name      string
+		query     string
+		types     []string
+		limit     int
+		wantTypes int
+		wantErr   bool
+	}{
+		{"all types by default", "отчёт", nil, 25, 3, false},
+		{"duplicate types", "report", []string{"task", "task", "comment"}, 25, 2, false},
+		{"unknown type", "report", []string{"deal"}, 25, 0, true},
+		{"short query", " a ", nil, 25, 0, true},
+		{"limit too big", "report", nil, 1000, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewSearchQuery(federationUUID, tt.query, tt.types, tt.limit, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSearchQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if len(q.Types) != tt.wantTypes {
+				t.Errorf("NewSearchQuery() types = %v, want %d", q.Types, tt.wantTypes)
+			}
+		})
+	}
+}
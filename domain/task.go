@@ -74,10 +74,15 @@ type Task struct {
 
 	CommentsTotal int
 
+	Checklist ChecklistProgress
+
 	CacheExpires *time.Time
 
 	TaskEntities map[uuid.UUID][]string
 
+	// Agents - linked counterparties
+	Agents []uuid.UUID
+
 	Stops []Stop
 
 	FinishTo   *time.Time
@@ -88,6 +93,9 @@ type Task struct {
 	ChildrensTotal int
 	ChildrensUUID  []uuid.UUID
 
+	// RecurrenceUUID - the series the task was spawned by
+	RecurrenceUUID *uuid.UUID
+
 	Activities      []Activity
 	ActivitiesTotal int64
 
@@ -212,6 +220,11 @@ func NewTaskByUUID(uid uuid.UUID) (task *Task) {
 	return task
 }
 
+func (t *Task) PatchAgents(agents []uuid.UUID) {
+	t.SafeDirty("agents", t.Agents)
+	t.Agents = lo.Uniq(agents)
+}
+
 func (t *Task) PatchName(name string) error {
 	if len(name) < 3 || len(name) > 100 {
 		return errors.New("название должно быть от 3 до 100 символов")
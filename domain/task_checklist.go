@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+// Checklist changes as logged in the task activities.
+const (
+	ChecklistItemCreated   = "created"
+	ChecklistItemUpdated   = "updated"
+	ChecklistItemChecked   = "checked"
+	ChecklistItemUnchecked = "unchecked"
+	ChecklistItemDeleted   = "deleted"
+	ChecklistItemConverted = "converted"
+	ChecklistReordered     = "reordered"
+)
+
+// TaskChecklistItem - an ordered point of a task checklist, lighter than a subtask.
+type TaskChecklistItem struct {
+	UUID     uuid.UUID
+	TaskUUID uuid.UUID `validate:"uuid"  ru:"задача (uuid)"`
+	Position int
+
+	Text       string `validate:"min=1,max=500"  ru:"текст"`
+	Done       bool
+	AssignedTo string `validate:"omitempty,email"  ru:"исполнитель (email)"`
+	DueAt      *time.Time
+
+	CreatedBy string `validate:"lte=100,gte=3"  ru:"автор (email)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewTaskChecklistItem(task Task, crt Creator, text, assignedTo string, dueAt *time.Time) (*TaskChecklistItem, error) {
+	dm := &TaskChecklistItem{
+		UUID:     uuid.New(),
+		TaskUUID: task.UUID,
+
+		Text:       strings.TrimSpace(text),
+		AssignedTo: assignedTo,
+		DueAt:      dueAt,
+
+		CreatedBy: crt.Email,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	return dm, dm.Validate()
+}
+
+func (i TaskChecklistItem) Validate() error {
+	errs, ok := helpers.ValidationStruct(i)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// SubtaskName - the item text fit to the task name limits.
+func (i TaskChecklistItem) SubtaskName() (string, error) {
+	name := []rune(i.Text)
+	if len(name) < 3 {
+		return "", errors.New("текст пункта слишком короткий для названия задачи")
+	}
+
+	return string(name[:min(len(name), 100)]), nil
+}
+
+// ReorderChecklist sets the positions by the order of uuids, it has to list every item once.
+func ReorderChecklist(items []TaskChecklistItem, uuids []uuid.UUID) ([]TaskChecklistItem, error) {
+	if len(uuids) != len(items) || len(lo.Uniq(uuids)) != len(uuids) {
+		return nil, errors.New("порядок должен содержать каждый пункт один раз")
+	}
+
+	byUUID := lo.KeyBy(items, func(i TaskChecklistItem) uuid.UUID {
+		return i.UUID
+	})
+
+	res := make([]TaskChecklistItem, 0, len(items))
+
+	for position, uid := range uuids {
+		item, ok := byUUID[uid]
+		if !ok {
+			return nil, errors.New("пункт не найден в чек-листе")
+		}
+
+		item.Position = position
+		res = append(res, item)
+	}
+
+	return res, nil
+}
+
+// ChecklistProgress - done of total items.
+type ChecklistProgress struct {
+	Done  int
+	Total int
+}
+
+func NewChecklistProgress(items []TaskChecklistItem) ChecklistProgress {
+	return ChecklistProgress{
+		Done: lo.CountBy(items, func(i TaskChecklistItem) bool {
+			return i.Done
+		}),
+		Total: len(items),
+	}
+}
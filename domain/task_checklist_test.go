@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewTaskChecklistItem(t *testing.T) {
+	task := Task{UUID: uuid.New()}
+	crt := Creator{UUID: uuid.New(), Email: "user@mail.ru"}
+
+	tests := []struct {
+		name       string
+		text       string
+		assignedTo string
+		wantErr    bool
+	}{
+		{"ok", "купить кабель", "", false},
+		{"assigned", "купить кабель", "impl@mail.ru", false},
+		{"empty", "   ", "", true},
+		{"too long", strings.Repeat("а", 501), "", true},
+		{"bad assignee", "купить кабель", "impl", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTaskChecklistItem(task, crt, tt.text, tt.assignedTo, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTaskChecklistItem() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReorderChecklist(t *testing.T) {
+	a, b, c := TaskChecklistItem{UUID: uuid.New()}, TaskChecklistItem{UUID: uuid.New(), Position: 1}, TaskChecklistItem{UUID: uuid.New(), Position: 2}
+	items := []TaskChecklistItem{a, b, c}
+
+	got, err := ReorderChecklist(items, []uuid.UUID{c.UUID, a.UUID, b.UUID})
+	if err != nil {
+		t.Fatalf("ReorderChecklist() error = %v", err)
+	}
+
+	if got[0].UUID != c.UUID || got[0].Position != 0 || got[2].UUID != b.UUID || got[2].Position != 2 {
+		t.Errorf("ReorderChecklist() = %+v", got)
+	}
+
+	for name, uuids := range map[string][]uuid.UUID{
+		"missing":   {c.UUID, a.UUID},
+		"duplicate": {c.UUID, a.UUID, a.UUID},
+		"foreign":   {c.UUID, a.UUID, uuid.New()},
+	} {
+		if _, err := ReorderChecklist(items, uuids); err == nil {
+			t.Errorf("ReorderChecklist(%s) expected error", name)
+		}
+	}
+}
+
+func TestNewChecklistProgress(t *testing.T) {
+	got := NewChecklistProgress([]TaskChecklistItem{{Done: true}, {}, {Done: true}})
+
+	if got.Done != 2 || got.Total != 3 {
+		t.Errorf("NewChecklistProgress() = %+v", got)
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// Deadline stages of a task, a stage is notified once and FinishTo changes reset it.
+const (
+	DeadlineNone        = 0
+	DeadlineApproaching = 1
+	DeadlineOverdue     = 2
+	DeadlineEscalated   = 3
+)
+
+const DefaultDeadlineLeadHours = 24
+
+func (o ProjectOptions) DeadlineLead() time.Duration {
+	return time.Duration(lo.FromPtrOr(o.DeadlineLeadHours, DefaultDeadlineLeadHours)) * time.Hour
+}
+
+// DeadlineStage - the stage the deadline reached at now.
+func DeadlineStage(finishTo time.Time, opt ProjectOptions, now time.Time) int {
+	if opt.DeadlineEscalateHours != nil && !now.Before(finishTo.Add(time.Duration(*opt.DeadlineEscalateHours)*time.Hour)) {
+		return DeadlineEscalated
+	}
+
+	if !now.Before(finishTo) {
+		return DeadlineOverdue
+	}
+
+	if lead := opt.DeadlineLead(); lead > 0 && !now.Before(finishTo.Add(-lead)) {
+		return DeadlineApproaching
+	}
+
+	return DeadlineNone
+}
+
+// DeadlinePeople - the implementer, the responsible and the manager of the task.
+func (t Task) DeadlinePeople() []string {
+	return lo.Uniq(lo.Compact([]string{t.ImplementBy, t.ResponsibleBy, t.ManagedBy}))
+}
+
+// DeadlineTask - a task due for a deadline notification with the options of its project.
+type DeadlineTask struct {
+	Task
+	ProjectOptions       ProjectOptions
+	ProjectResponsibleBy string
+	Stage                int
+}
+
+// Recipients - the task people for the approaching and overdue stages, the project responsible for the escalation.
+func (t DeadlineTask) Recipients(stage int) []string {
+	if stage == DeadlineEscalated {
+		return lo.Compact([]string{t.ProjectResponsibleBy})
+	}
+
+	return t.DeadlinePeople()
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestDeadlineStage(t *testing.T) {
+	finishTo := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opt  ProjectOptions
+		now  time.Time
+		want int
+	}{
+		{"far", ProjectOptions{}, finishTo.Add(-48 * time.Hour), DeadlineNone},
+		{"approaching", ProjectOptions{}, finishTo.Add(-time.Hour), DeadlineApproaching},
+		{"lead turned off", ProjectOptions{DeadlineLeadHours: lo.ToPtr(0)}, finishTo.Add(-time.Hour), DeadlineNone},
+		{"long lead", ProjectOptions{DeadlineLeadHours: lo.ToPtr(72)}, finishTo.Add(-48 * time.Hour), DeadlineApproaching},
+		{"overdue", ProjectOptions{}, finishTo, DeadlineOverdue},
+		{"never escalated", ProjectOptions{}, finishTo.Add(30 * 24 * time.Hour), DeadlineOverdue},
+		{"not yet escalated", ProjectOptions{DeadlineEscalateHours: lo.ToPtr(8)}, finishTo.Add(time.Hour), DeadlineOverdue},
+		{"escalated", ProjectOptions{DeadlineEscalateHours: lo.ToPtr(8)}, finishTo.Add(8 * time.Hour), DeadlineEscalated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeadlineStage(finishTo, tt.opt, tt.now); got != tt.want {
+				t.Errorf("DeadlineStage() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeadlineTaskRecipients(t *testing.T) {
+	dm := DeadlineTask{
+		Task:                 Task{ImplementBy: "impl@mail.ru", ResponsibleBy: "impl@mail.ru", ManagedBy: "manager@mail.ru"},
+		ProjectResponsibleBy: "head@mail.ru",
+	}
+
+	if got := dm.Recipients(DeadlineOverdue); len(got) != 2 || got[0] != "impl@mail.ru" || got[1] != "manager@mail.ru" {
+		t.Errorf("Recipients(overdue) = %v", got)
+	}
+
+	if got := dm.Recipients(DeadlineEscalated); len(got) != 1 || got[0] != "head@mail.ru" {
+		t.Errorf("Recipients(escalated) = %v", got)
+	}
+}
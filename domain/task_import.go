@@ -0,0 +1,390 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+// task import targets, a column mapped onto anything else goes to the project custom field with that hash or name
+const (
+	TaskColumnName          = "name"
+	TaskColumnDescription   = "description"
+	TaskColumnResponsibleBy = "responsible_by"
+	TaskColumnImplementBy   = "implement_by"
+	TaskColumnManagedBy     = "managed_by"
+	TaskColumnCoWorkersBy   = "co_workers_by"
+	TaskColumnWatchBy       = "watch_by"
+	TaskColumnTags          = "tags"
+	TaskColumnPriority      = "priority"
+	TaskColumnFinishTo      = "finish_to"
+	TaskColumnIsEpic        = "is_epic"
+)
+
+const TaskImportMaxRows = 10000
+
+var (
+	ErrTaskImportNoHeader = errors.New("файл пуст или нет строки заголовков")
+	ErrTaskImportNoName   = errors.New("не найдена колонка с названием задачи")
+	ErrTaskImportTooLarge = fmt.Errorf("слишком много строк, максимум %d", TaskImportMaxRows)
+)
+
+var taskColumns = []string{
+	TaskColumnName, TaskColumnDescription, TaskColumnResponsibleBy, TaskColumnImplementBy, TaskColumnManagedBy,
+	TaskColumnCoWorkersBy, TaskColumnWatchBy, TaskColumnTags, TaskColumnPriority, TaskColumnFinishTo, TaskColumnIsEpic,
+}
+
+// taskColumnAliases - the headers of the task export and their usual variants
+var taskColumnAliases = map[string]string{
+	"название":      TaskColumnName,
+	"задача":        TaskColumnName,
+	"описание":      TaskColumnDescription,
+	"ответственный": TaskColumnResponsibleBy,
+	"исполнитель":   TaskColumnImplementBy,
+	"менеджер":      TaskColumnManagedBy,
+	"руководитель":  TaskColumnManagedBy,
+	"соисполнители": TaskColumnCoWorkersBy,
+	"наблюдатели":   TaskColumnWatchBy,
+	"теги":          TaskColumnTags,
+	"приоритет":     TaskColumnPriority,
+	"срок":          TaskColumnFinishTo,
+	"дедлайн":       TaskColumnFinishTo,
+	"эпик":          TaskColumnIsEpic,
+}
+
+var taskImportTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"02.01.2006 15:04:05",
+	"02.01.2006 15:04",
+	"02.01.2006",
+	"01-02-06",
+	"15:04:05",
+	"15:04",
+}
+
+var taskImportLinkRegexp = regexp.MustCompile(`^\[.*]\(.+\)$`)
+
+// TaskImportField - a project custom field a column can be mapped onto.
+type TaskImportField struct {
+	Hash     string
+	Name     string
+	DataType FieldDataType
+}
+
+// TaskImportTarget - the project the rows become tasks of and how their cells are resolved there.
+type TaskImportTarget struct {
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	CreatedBy      string
+
+	Fields []TaskImportField
+	// Tags - company tags by lower case name, an unknown tag stays a text tag
+	Tags map[string]uuid.UUID
+	// Members - emails of the company members in lower case, people are matched among them by email only
+	Members map[string]bool
+}
+
+type TaskImportError struct {
+	Row     int
+	Message string
+}
+
+// TaskImportResult - rows are counted the same way in dry-run and real import.
+type TaskImportResult struct {
+	Total   int
+	Created int
+	Errors  []TaskImportError
+	DryRun  bool
+}
+
+// NewTaskColumns maps header cells onto task columns or custom field hashes. Explicit mapping wins,
+// "-" or "" skips a column. An unmapped column named neither like a task column nor like a field is skipped,
+// so the export file can be imported back, an explicitly mapped one is an error.
+func NewTaskColumns(header []string, mapping map[string]string, fields []TaskImportField) (map[int]string, error) {
+	columns := make(map[int]string)
+
+	for i, h := range header {
+		h = strings.TrimSpace(h)
+
+		target, explicit := mapping[h]
+		if !explicit {
+			target = h
+		}
+
+		target = strings.TrimSpace(target)
+		if target == "" || target == "-" {
+			continue
+		}
+
+		lower := strings.ToLower(target)
+		if alias, ok := taskColumnAliases[lower]; ok {
+			lower = alias
+		}
+
+		if lo.Contains(taskColumns, lower) {
+			columns[i] = lower
+			continue
+		}
+
+		field, found := lo.Find(fields, func(f TaskImportField) bool {
+			return f.Hash == target || strings.EqualFold(f.Name, target)
+		})
+
+		switch {
+		case found && field.DataType != Data && field.DataType != DataArray:
+			columns[i] = field.Hash
+		case found && explicit:
+			return columns, fmt.Errorf("поле %q нельзя импортировать", field.Name)
+		case explicit:
+			return columns, fmt.Errorf("неизвестное поле %q для колонки %q", target, h)
+		}
+	}
+
+	if !lo.Contains(lo.Values(columns), TaskColumnName) {
+		return columns, ErrTaskImportNoName
+	}
+
+	return columns, nil
+}
+
+// NewTaskFromRow builds a root task of the target project, custom field values are coerced
+// to the types the task create request sends, so the fields are filtered the usual way.
+func NewTaskFromRow(target TaskImportTarget, row []string, columns map[int]string) (task Task, err error) {
+	var (
+		name, description                   string
+		responsibleBy, implementBy, manager string
+		coworkers, watchers, tags           []string
+		priority                            int
+		finishTo                            *time.Time
+		isEpic                              bool
+	)
+
+	fields := make(map[string]interface{})
+
+	for i, cell := range row {
+		col, ok := columns[i]
+		cell = strings.TrimSpace(cell)
+		if !ok || cell == "" {
+			continue
+		}
+
+		switch col {
+		case TaskColumnName:
+			name = cell
+		case TaskColumnDescription:
+			description = cell
+		case TaskColumnResponsibleBy:
+			responsibleBy, err = target.findUser(cell)
+		case TaskColumnImplementBy:
+			implementBy, err = target.findUser(cell)
+		case TaskColumnManagedBy:
+			manager, err = target.findUser(cell)
+		case TaskColumnCoWorkersBy:
+			coworkers, err = target.findUsers(cell)
+		case TaskColumnWatchBy:
+			watchers, err = target.findUsers(cell)
+		case TaskColumnTags:
+			tags = lo.Map(splitTaskImportList(cell), func(tag string, _ int) string {
+				if uid, ok := target.Tags[strings.ToLower(tag)]; ok {
+					return uid.String()
+				}
+
+				return tag
+			})
+		case TaskColumnPriority:
+			priority, err = strconv.Atoi(cell)
+			if err != nil {
+				err = fmt.Errorf("приоритет %q должен быть числом", cell)
+			}
+		case TaskColumnFinishTo:
+			var t time.Time
+			t, err = parseTaskImportTime(cell)
+			finishTo = &t
+		case TaskColumnIsEpic:
+			isEpic, err = parseTaskImportBool(cell)
+		default:
+			field, _ := lo.Find(target.Fields, func(f TaskImportField) bool { return f.Hash == col })
+			fields[col], err = target.coerceField(field, cell)
+			if err != nil {
+				err = fmt.Errorf("%s: %w", field.Name, err)
+			}
+		}
+
+		if err != nil {
+			return task, err
+		}
+	}
+
+	task, err = NewTask(
+		name,
+		target.FederationUUID,
+		target.CompanyUUID,
+		target.ProjectUUID,
+		target.CreatedBy,
+		fields,
+		tags,
+
+		description,
+		[]string{},
+		coworkers,
+		implementBy,
+		responsibleBy,
+
+		priority,
+
+		finishTo,
+		"",
+		manager,
+
+		map[uuid.UUID][]string{},
+	)
+	if err != nil {
+		return task, err
+	}
+
+	task.IsEpic = isEpic
+	task.WatchBy = lo.WithoutEmpty(lo.Uniq(watchers))
+	task.People = lo.WithoutEmpty(lo.Uniq(append(task.People, task.WatchBy...)))
+
+	return task, nil
+}
+
+func (t TaskImportTarget) findUser(cell string) (string, error) {
+	email := strings.ToLower(strings.TrimSpace(cell))
+
+	if !t.Members[email] {
+		return "", fmt.Errorf("пользователь %q не найден среди сотрудников компании", cell)
+	}
+
+	return email, nil
+}
+
+func (t TaskImportTarget) findUsers(cell string) ([]string, error) {
+	emails := []string{}
+
+	for _, val := range splitTaskImportList(cell) {
+		email, err := t.findUser(val)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, email)
+	}
+
+	return emails, nil
+}
+
+func (t TaskImportTarget) coerceField(field TaskImportField, cell string) (interface{}, error) {
+	switch field.DataType {
+	case Integer:
+		v, err := parseTaskImportNumber(cell)
+		if err != nil || v != math.Trunc(v) {
+			return nil, fmt.Errorf("%q должно быть целым числом", cell)
+		}
+
+		return int(v), nil
+	case Float:
+		v, err := parseTaskImportNumber(cell)
+		if err != nil {
+			return nil, fmt.Errorf("%q должно быть числом", cell)
+		}
+
+		return v, nil
+	case String, Text:
+		return cell, nil
+	case Bool:
+		return parseTaskImportBool(cell)
+	case Switch:
+		v, err := parseTaskImportNumber(cell)
+		if err != nil || (v != 0 && v != 1 && v != 2) {
+			return nil, fmt.Errorf("%q должно быть 0, 1 или 2", cell)
+		}
+
+		return v, nil
+	case Array:
+		return lo.ToAnySlice(splitTaskImportList(cell)), nil
+	case Phone:
+		v, err := strconv.Atoi(phoneDigits(cell))
+		if err != nil {
+			return nil, fmt.Errorf("неверный телефон %q", cell)
+		}
+
+		return v, nil
+	case Link:
+		if taskImportLinkRegexp.MatchString(cell) {
+			return cell, nil
+		}
+
+		return fmt.Sprintf("[%s](%s)", cell, cell), nil
+	case Email:
+		email := strings.ToLower(cell)
+		if helpers.ValidateEmail(email) != nil {
+			return nil, fmt.Errorf("неверная почта %q", cell)
+		}
+
+		return email, nil
+	case Time, DateTime:
+		v, err := parseTaskImportTime(cell)
+		if err != nil {
+			return nil, err
+		}
+
+		return v.Format(time.RFC3339), nil
+	case People:
+		emails, err := t.findUsers(cell)
+		if err != nil {
+			return nil, err
+		}
+
+		return lo.ToAnySlice(emails), nil
+	}
+
+	return nil, errors.New("поле нельзя импортировать")
+}
+
+func splitTaskImportList(cell string) []string {
+	return lo.WithoutEmpty(lo.Map(strings.FieldsFunc(cell, splitAgentContacts), func(v string, _ int) string {
+		return strings.TrimSpace(v)
+	}))
+}
+
+// parseTaskImportNumber accepts a decimal comma and spaces between digit groups.
+func parseTaskImportNumber(cell string) (float64, error) {
+	cell = strings.NewReplacer(" ", "", " ", "", ",", ".").Replace(cell)
+
+	return strconv.ParseFloat(cell, 64)
+}
+
+func parseTaskImportBool(cell string) (bool, error) {
+	switch strings.ToLower(cell) {
+	case "1", "true", "yes", "y", "да", "д", "+":
+		return true, nil
+	case "0", "false", "no", "n", "нет", "н", "-":
+		return false, nil
+	}
+
+	return false, fmt.Errorf("%q должно быть да или нет", cell)
+}
+
+// parseTaskImportTime - a cell without a timezone is in UTC.
+func parseTaskImportTime(cell string) (time.Time, error) {
+	for _, layout := range taskImportTimeLayouts {
+		if t, err := time.Parse(layout, cell); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("неверная дата %q", cell)
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var taskImportFields = []TaskImportField{
+	{Hash: "h1", Name: "Бюджет", DataType: Float},
+	{Hash: "h2", Name: "Согласовано", DataType: Bool},
+	{Hash: "h3", Name: "Эксперты", DataType: People},
+	{Hash: "h4", Name: "Каталог", DataType: Data},
+}
+
+func TestNewTaskColumns(t *testing.T) {
+	columns, err := NewTaskColumns([]string{"Название", "Статус", "бюджет", "Кто", "Каталог"}, map[string]string{
+		"Кто": TaskColumnImplementBy,
+	}, taskImportFields)
+	if err != nil {
+		t.Fatalf("NewTaskColumns() error = %v", err)
+	}
+
+	if len(columns) != 3 || columns[0] != TaskColumnName || columns[2] != "h1" || columns[3] != TaskColumnImplementBy {
+		t.Errorf("NewTaskColumns() = %v", columns)
+	}
+
+	if _, err := NewTaskColumns([]string{"Название", "Поле"}, map[string]string{"Поле": "нет такого"}, taskImportFields); err == nil {
+		t.Errorf("NewTaskColumns() unknown mapped field should fail")
+	}
+
+	if _, err := NewTaskColumns([]string{"Название", "Поле"}, map[string]string{"Поле": "h4"}, taskImportFields); err == nil {
+		t.Errorf("NewTaskColumns() catalog field should fail")
+	}
+
+	if _, err := NewTaskColumns([]string{"Описание"}, nil, taskImportFields); err != ErrTaskImportNoName {
+		t.Errorf("NewTaskColumns() without name: %v", err)
+	}
+}
+
+func TestNewTaskFromRow(t *testing.T) {
+	tag := uuid.New()
+	target := TaskImportTarget{
+		FederationUUID: uuid.New(),
+		CompanyUUID:    uuid.New(),
+		ProjectUUID:    uuid.New(),
+		CreatedBy:      "user@mail.ru",
+		Fields:         taskImportFields,
+		Tags:           map[string]uuid.UUID{"срочно": tag},
+		Members:        map[string]bool{"user@mail.ru": true, "boss@mail.ru": true},
+	}
+
+	columns := map[int]string{0: TaskColumnName, 1: TaskColumnResponsibleBy, 2: TaskColumnTags, 3: TaskColumnFinishTo, 4: "h1", 5: "h2", 6: "h3"}
+
+	task, err := NewTaskFromRow(target, []string{"Отчет", "Boss@mail.ru", "Срочно, квартал", "31.12.2026", "1 500,5", "да", "user@mail.ru; boss@mail.ru"}, columns)
+	if err != nil {
+		t.Fatalf("NewTaskFromRow() error = %v", err)
+	}
+
+	if task.ResponsibleBy != "boss@mail.ru" || len(task.Tags) != 2 || task.Tags[0] != tag.String() || task.Tags[1] != "квартал" {
+		t.Errorf("NewTaskFromRow() = %v %v", task.ResponsibleBy, task.Tags)
+	}
+
+	if task.FinishTo == nil || !task.FinishTo.Equal(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("NewTaskFromRow() finish_to = %v", task.FinishTo)
+	}
+
+	if task.RawFields["h1"] != 1500.5 || task.RawFields["h2"] != true || len(task.RawFields["h3"].([]interface{})) != 2 {
+		t.Errorf("NewTaskFromRow() fields = %v", task.RawFields)
+	}
+
+	tests := []struct {
+		name string
+		row  []string
+	}{
+		{"unknown user", []string{"Отчет", "nobody@mail.ru"}},
+		{"short name", []string{"От"}},
+		{"bad date", []string{"Отчет", "", "", "завтра"}},
+		{"bad number", []string{"Отчет", "", "", "", "много"}},
+		{"bad bool", []string{"Отчет", "", "", "", "", "может быть"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTaskFromRow(target, tt.row, columns); err == nil {
+				t.Errorf("NewTaskFromRow() should fail")
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	TaskLinkBlocks     = "blocks"
+	TaskLinkRelates    = "relates"
+	TaskLinkDuplicates = "duplicates"
+
+	// TaskLinkBlockedBy and TaskLinkDuplicatedBy are the same links seen from the other task, they are not stored
+	TaskLinkBlockedBy    = "blocked_by"
+	TaskLinkDuplicatedBy = "duplicated_by"
+)
+
+var TaskLinkTypes = []string{TaskLinkBlocks, TaskLinkBlockedBy, TaskLinkRelates, TaskLinkDuplicates, TaskLinkDuplicatedBy}
+
+var (
+	ErrTaskLinkSelf  = errors.New("задачу нельзя связать с самой собой")
+	ErrTaskLinkCycle = errors.New("связь образует цикл")
+)
+
+// TaskLink - FromUUID blocks, relates to or duplicates ToUUID.
+type TaskLink struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	FromUUID       uuid.UUID
+	ToUUID         uuid.UUID
+	Type           string
+
+	CreatedBy     string
+	CreatedByUUID uuid.UUID
+	CreatedAt     time.Time
+}
+
+// NewTaskLink links task to other, the inverse types are stored as the direct ones with the tasks swapped.
+func NewTaskLink(task, other Task, linkType string, crt Creator) (*TaskLink, error) {
+	if lo.IndexOf(TaskLinkTypes, linkType) == -1 {
+		return nil, fmt.Errorf("неизвестный тип связи: %s", linkType)
+	}
+
+	if task.UUID == other.UUID {
+		return nil, ErrTaskLinkSelf
+	}
+
+	if task.FederationUUID != other.FederationUUID {
+		return nil, errors.New("задачи из разных федераций")
+	}
+
+	from, to := task.UUID, other.UUID
+
+	switch linkType {
+	case TaskLinkBlockedBy:
+		from, to, linkType = to, from, TaskLinkBlocks
+	case TaskLinkDuplicatedBy:
+		from, to, linkType = to, from, TaskLinkDuplicates
+	}
+
+	return &TaskLink{
+		UUID:           uuid.New(),
+		FederationUUID: task.FederationUUID,
+		FromUUID:       from,
+		ToUUID:         to,
+		Type:           linkType,
+
+		CreatedBy:     crt.Email,
+		CreatedByUUID: crt.UUID,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// Directed links can form cycles, "relates" can not.
+func (l TaskLink) Directed() bool {
+	return l.Type != TaskLinkRelates
+}
+
+// Other is the linked task as seen from taskUUID.
+func (l TaskLink) Other(taskUUID uuid.UUID) uuid.UUID {
+	return lo.Ternary(l.FromUUID == taskUUID, l.ToUUID, l.FromUUID)
+}
+
+// TypeFor is the link type as seen from taskUUID.
+func (l TaskLink) TypeFor(taskUUID uuid.UUID) string {
+	if l.ToUUID != taskUUID {
+		return l.Type
+	}
+
+	switch l.Type {
+	case TaskLinkBlocks:
+		return TaskLinkBlockedBy
+	case TaskLinkDuplicates:
+		return TaskLinkDuplicatedBy
+	}
+
+	return l.Type
+}
+
+// CheckBlockers - a task can't go in work or be done while its blockers are open.
+func CheckBlockers(status int, blockers []Task) error {
+	if status != StatusInWork && status != StatusDone {
+		return nil
+	}
+
+	open := lo.Filter(blockers, func(t Task, _ int) bool {
+		return t.Status != StatusDone && t.Status != StatusCancel
+	})
+
+	if len(open) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("задача заблокирована: %s", strings.Join(lo.Map(open, func(t Task, _ int) string {
+		return fmt.Sprintf("#%d %s", t.ID, t.Name)
+	}), ", "))
+}
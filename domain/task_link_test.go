@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewTaskLink(t *testing.T) {
+	federationUUID := uuid.New()
+	task := Task{UUID: uuid.New(), FederationUUID: federationUUID}
+	other := Task{UUID: uuid.New(), FederationUUID: federationUUID}
+	crt := Creator{UUID: uuid.New(), Email: "user@mail.ru"}
+
+	tests := []struct {
+		name     string
+		linkType string
+		wantType string
+		swapped  bool
+	}{
+		{"blocks", TaskLinkBlocks, TaskLinkBlocks, false},
+		{"blocked by", TaskLinkBlockedBy, TaskLinkBlocks, true},
+		{"relates", TaskLinkRelates, TaskLinkRelates, false},
+		{"duplicated by", TaskLinkDuplicatedBy, TaskLinkDuplicates, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link, err := NewTaskLink(task, other, tt.linkType, crt)
+			if err != nil {
+				t.Fatalf("NewTaskLink() error = %v", err)
+			}
+
+			if link.Type != tt.wantType {
+				t.Errorf("NewTaskLink() type = %s, want %s", link.Type, tt.wantType)
+			}
+
+			if (link.FromUUID == other.UUID) != tt.swapped {
+				t.Errorf("NewTaskLink() from = %s, swapped %v", link.FromUUID, tt.swapped)
+			}
+
+			if link.TypeFor(task.UUID) != tt.linkType {
+				t.Errorf("TypeFor() = %s, want %s", link.TypeFor(task.UUID), tt.linkType)
+			}
+
+			if link.Other(task.UUID) != other.UUID {
+				t.Errorf("Other() = %s, want %s", link.Other(task.UUID), other.UUID)
+			}
+		})
+	}
+
+	if _, err := NewTaskLink(task, task, TaskLinkBlocks, crt); !errors.Is(err, ErrTaskLinkSelf) {
+		t.Errorf("NewTaskLink() self link error = %v", err)
+	}
+
+	if _, err := NewTaskLink(task, other, "causes", crt); err == nil {
+		t.Errorf("NewTaskLink() unknown type should fail")
+	}
+
+	if _, err := NewTaskLink(task, Task{UUID: uuid.New(), FederationUUID: uuid.New()}, TaskLinkBlocks, crt); err == nil {
+		t.Errorf("NewTaskLink() link across federations should fail")
+	}
+}
+
+func TestCheckBlockers(t *testing.T) {
+	done := Task{ID: 1, Name: "done", Status: StatusDone}
+	open := Task{ID: 2, Name: "open", Status: StatusNew}
+
+	tests := []struct {
+		name     string
+		status   int
+		blockers []Task
+		wantErr  bool
+	}{
+		{"no blockers", StatusInWork, nil, false},
+		{"closed blockers", StatusDone, []Task{done}, false},
+		{"open blocker", StatusInWork, []Task{done, open}, true},
+		{"open blocker on other status", StatusNew, []Task{open}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckBlockers(tt.status, tt.blockers)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckBlockers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
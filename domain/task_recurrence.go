@@ -0,0 +1,433 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+const RecurrenceCron = "cron"
+
+// recurrenceCatchUp - at most so many missed occurrences are stepped through at once, the rest on the next scan;
+// only a series limited by count steps through all of them, the others jump close to now first.
+const recurrenceCatchUp = 1000
+
+// TaskRecurrence - a series spawning a task on schedule, a copy of the source task or a template tree.
+type TaskRecurrence struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID `validate:"uuid"  ru:"федерация (uuid)"`
+	CompanyUUID    uuid.UUID `validate:"uuid"  ru:"компания (uuid)"`
+	ProjectUUID    uuid.UUID `validate:"uuid"  ru:"проект (uuid)"`
+
+	// exactly one of TaskUUID and TemplateUUID is set
+	TaskUUID     *uuid.UUID
+	TemplateUUID *uuid.UUID
+	Vars         TemplateVars
+
+	Schedule TaskSchedule
+	StartAt  time.Time
+
+	// NextAt - nil when the series is over
+	NextAt *time.Time
+	// Occurrence - zero based index of NextAt
+	Occurrence int
+	Paused     bool
+
+	// FinishOffset - minutes from the occurrence to finish_to of the spawned task, nil - no deadline
+	FinishOffset *int
+
+	CreatedBy string `validate:"lte=100,gte=3"  ru:"автор (email)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TaskSchedule - a reminder recurrence or, for the cron freq, a cron expression, both evaluated in Timezone.
+// Until and Count of the recurrence end the series.
+type TaskSchedule struct {
+	ReminderRecurrence
+	Cron string `json:"cron,omitempty"`
+	// Timezone - IANA name, empty - UTC
+	Timezone string `json:"timezone,omitempty"`
+}
+
+func (j *TaskSchedule) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := TaskSchedule{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j TaskSchedule) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+func (j *TaskSchedule) Validate() error {
+	if _, err := time.LoadLocation(j.Timezone); err != nil {
+		return fmt.Errorf("неизвестный часовой пояс: %s", j.Timezone)
+	}
+
+	if j.Freq != RecurrenceCron {
+		return j.ReminderRecurrence.Validate()
+	}
+
+	if j.Count < 0 || j.Count > 1000 {
+		return errors.New("количество повторений от 1 до 1000")
+	}
+
+	_, err := ParseCron(j.Cron)
+
+	return err
+}
+
+// compiledSchedule - the schedule with its location loaded and its cron expression parsed,
+// to step through many occurrences without doing it on every step.
+type compiledSchedule struct {
+	*TaskSchedule
+	loc  *time.Location
+	cron CronExpr
+}
+
+func (j *TaskSchedule) compile() (c compiledSchedule, err error) {
+	c = compiledSchedule{TaskSchedule: j}
+
+	c.loc, err = time.LoadLocation(j.Timezone)
+	if err != nil {
+		return c, err
+	}
+
+	if j.Freq == RecurrenceCron {
+		c.cron, err = ParseCron(j.Cron)
+	}
+
+	return c, err
+}
+
+// First - the occurrence the series starts with, start itself unless a cron expression does not match it.
+func (j *TaskSchedule) First(start time.Time) (time.Time, bool) {
+	c, err := j.compile()
+	if err != nil {
+		return start, false
+	}
+
+	return c.first(start)
+}
+
+// Next returns the occurrence following cur in the schedule timezone, occurrence is the zero based index of cur.
+func (j *TaskSchedule) Next(cur time.Time, occurrence int) (time.Time, bool) {
+	c, err := j.compile()
+	if err != nil {
+		return cur, false
+	}
+
+	return c.next(cur, occurrence)
+}
+
+func (c compiledSchedule) first(start time.Time) (time.Time, bool) {
+	start = start.In(c.loc)
+
+	if c.Freq == RecurrenceCron {
+		// the minute of start counts
+		var ok bool
+		start, ok = c.cron.Next(start.Add(-time.Minute))
+		if !ok {
+			return start, false
+		}
+	}
+
+	if c.Until != nil && start.After(*c.Until) {
+		return start, false
+	}
+
+	return start, true
+}
+
+func (c compiledSchedule) next(cur time.Time, occurrence int) (time.Time, bool) {
+	cur = cur.In(c.loc)
+
+	if c.Freq != RecurrenceCron {
+		return c.ReminderRecurrence.Next(cur, occurrence)
+	}
+
+	if c.Count > 0 && occurrence+1 >= c.Count {
+		return cur, false
+	}
+
+	next, ok := c.cron.Next(cur)
+	if !ok || (c.Until != nil && next.After(*c.Until)) {
+		return cur, false
+	}
+
+	return next, true
+}
+
+// jump - an occurrence close to now, not after it but for cron, and how many occurrences it is after cur.
+// A cron series goes straight to its first occurrence from now, the occurrences it skips are not counted.
+func (c compiledSchedule) jump(cur, now time.Time) (time.Time, int) {
+	cur = cur.In(c.loc)
+	now = now.In(c.loc)
+
+	days := int(now.Sub(cur).Hours() / 24)
+
+	switch c.Freq {
+	case RecurrenceCron:
+		next, ok := c.cron.Next(now.Add(-time.Minute))
+		if !ok || !next.After(cur) {
+			return cur, 0
+		}
+
+		return next, 1
+	case RecurrenceDaily:
+		// a step short of now, so that a DST switch in between does not overshoot it
+		if k := days/c.interval() - 1; k > 0 {
+			return cur.AddDate(0, 0, k*c.interval()), k
+		}
+	case RecurrenceWeekly:
+		// every week of the interval repeats the same weekdays
+		if k := days/(7*c.interval()) - 1; k > 0 {
+			return cur.AddDate(0, 0, 7*k*c.interval()), k * max(len(lo.Uniq(c.Weekdays)), 1)
+		}
+	case RecurrenceMonthly:
+		months := (now.Year()-cur.Year())*12 + int(now.Month()) - int(cur.Month())
+		if k := months/c.interval() - 1; k > 0 {
+			return c.monthsAfter(cur, k*c.interval()), k
+		}
+	}
+
+	return cur, 0
+}
+
+// TemplateVars - the placeholder values a template series is instantiated with.
+type TemplateVars map[string]string
+
+func (j *TemplateVars) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := TemplateVars{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j TemplateVars) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+// NewTaskRecurrence - a series repeating the task, the task itself is the occurrence at start.
+func NewTaskRecurrence(task Task, schedule TaskSchedule, start time.Time, createdBy string, now time.Time) (*TaskRecurrence, error) {
+	dm := &TaskRecurrence{
+		UUID:           uuid.New(),
+		FederationUUID: task.FederationUUID,
+		CompanyUUID:    task.CompanyUUID,
+		ProjectUUID:    task.ProjectUUID,
+		TaskUUID:       &task.UUID,
+		Vars:           TemplateVars{},
+
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if task.FinishTo != nil {
+		dm.FinishOffset = lo.ToPtr(int(task.FinishTo.Sub(start).Minutes()))
+	}
+
+	return dm, dm.Reschedule(schedule, start, now)
+}
+
+// NewTemplateRecurrence - a series instantiating the template in the project, the first time at start.
+func NewTemplateRecurrence(tmpl TaskTemplate, target TaskTemplateTarget, schedule TaskSchedule, start time.Time, now time.Time) (*TaskRecurrence, error) {
+	dm := &TaskRecurrence{
+		UUID:           uuid.New(),
+		FederationUUID: target.FederationUUID,
+		CompanyUUID:    target.CompanyUUID,
+		ProjectUUID:    target.ProjectUUID,
+		TemplateUUID:   &tmpl.UUID,
+		Vars:           lo.Ternary(target.Vars == nil, TemplateVars{}, TemplateVars(target.Vars)),
+
+		CreatedBy: target.CreatedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return dm, dm.Reschedule(schedule, start, now)
+}
+
+func (r TaskRecurrence) Validate() error {
+	errs, ok := helpers.ValidationStruct(r)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	if (r.TaskUUID == nil) == (r.TemplateUUID == nil) {
+		return errors.New("повторение создается из задачи или из шаблона")
+	}
+
+	return r.Schedule.Validate()
+}
+
+// Reschedule restarts the series with the schedule from start, the occurrences already passed are skipped.
+func (r *TaskRecurrence) Reschedule(schedule TaskSchedule, start time.Time, now time.Time) error {
+	r.Schedule = schedule
+	r.StartAt = start
+	r.Occurrence = 0
+	r.NextAt = nil
+
+	err := r.Validate()
+	if err != nil {
+		return err
+	}
+
+	c, err := r.Schedule.compile()
+	if err != nil {
+		return nil
+	}
+
+	first, ok := c.first(start)
+	if !ok {
+		return nil
+	}
+
+	r.Schedule.Anchor(first)
+	r.NextAt = &first
+
+	// the source task is the occurrence at start
+	if r.TaskUUID != nil && !first.After(start) {
+		r.step(c)
+	}
+
+	r.skipPast(c, now)
+
+	return nil
+}
+
+func (r *TaskRecurrence) step(c compiledSchedule) {
+	next, ok := c.next(*r.NextAt, r.Occurrence)
+	if !ok {
+		r.NextAt = nil
+		return
+	}
+
+	r.NextAt = &next
+	r.Occurrence++
+}
+
+// SkipPast moves the series to the first occurrence not before now, e.g. on resume the paused period is not made up.
+func (r *TaskRecurrence) SkipPast(now time.Time) {
+	c, err := r.Schedule.compile()
+	if err != nil {
+		r.NextAt = nil
+		return
+	}
+
+	r.skipPast(c, now)
+}
+
+// skipPast jumps close to now unless the series is limited by count, which keeps it counting every occurrence.
+func (r *TaskRecurrence) skipPast(c compiledSchedule, now time.Time) {
+	if r.NextAt == nil || !r.NextAt.Before(now) {
+		return
+	}
+
+	if r.Schedule.Count == 0 {
+		next, skipped := c.jump(*r.NextAt, now)
+		if c.Until != nil && next.After(*c.Until) {
+			r.NextAt = nil
+			return
+		}
+
+		r.NextAt = &next
+		r.Occurrence += skipped
+	}
+
+	for i := 0; i < recurrenceCatchUp && r.NextAt != nil && r.NextAt.Before(now); i++ {
+		r.step(c)
+	}
+}
+
+// Advance takes the due occurrence and moves the series past now, missed occurrences give a single task.
+func (r *TaskRecurrence) Advance(now time.Time) (occurrence time.Time, ok bool) {
+	if r.NextAt == nil || r.NextAt.After(now) {
+		return occurrence, false
+	}
+
+	c, err := r.Schedule.compile()
+	if err != nil {
+		return occurrence, false
+	}
+
+	occurrence = *r.NextAt
+
+	r.step(c)
+	r.skipPast(c, now)
+
+	return occurrence, true
+}
+
+// Spawn copies the source task for the occurrence as its sibling, finish_to keeps its distance to the occurrence.
+func (r TaskRecurrence) Spawn(source Task, occurrence time.Time) (Task, error) {
+	var finishTo *time.Time
+	if r.FinishOffset != nil {
+		finishTo = lo.ToPtr(occurrence.Add(time.Duration(*r.FinishOffset) * time.Minute))
+	}
+
+	task, err := NewTask(
+		source.Name,
+		source.FederationUUID,
+		source.CompanyUUID,
+		source.ProjectUUID,
+		r.CreatedBy,
+		source.Fields,
+		source.Tags,
+
+		source.Description,
+		append([]string{}, source.Path[:len(source.Path)-1]...),
+		source.CoWorkersBy,
+		source.ImplementBy,
+		source.ResponsibleBy,
+
+		source.Priority,
+
+		finishTo,
+		source.Icon,
+		source.ManagedBy,
+
+		source.TaskEntities,
+	)
+	if err != nil {
+		return task, err
+	}
+
+	task.IsEpic = source.IsEpic
+	task.WatchBy = source.WatchBy
+	task.People = lo.WithoutEmpty(lo.Uniq(append(task.People, task.WatchBy...)))
+	task.RecurrenceUUID = &r.UUID
+
+	return task, nil
+}
+
+// Target - where the template of the series is instantiated.
+func (r TaskRecurrence) Target() TaskTemplateTarget {
+	return TaskTemplateTarget{
+		FederationUUID: r.FederationUUID,
+		CompanyUUID:    r.CompanyUUID,
+		ProjectUUID:    r.ProjectUUID,
+		Path:           []string{},
+		CreatedBy:      r.CreatedBy,
+		Vars:           r.Vars,
+		RecurrenceUUID: &r.UUID,
+	}
+}
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCronExprNext(t *testing.T) {
+	// wednesday
+	from := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "every minute", expr: "* * * * *", want: time.Date(2024, 1, 31, 10, 1, 0, 0, time.UTC)},
+		{name: "every 15 minutes", expr: "*/15 * * * *", want: time.Date(2024, 1, 31, 10, 15, 0, 0, time.UTC)},
+		{name: "workdays at 9", expr: "0 9 * * 1-5", want: time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", expr: "30 8 * * 7", want: time.Date(2024, 2, 4, 8, 30, 0, 0, time.UTC)},
+		{name: "leap day", expr: "0 0 29 2 *", want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month or monday", expr: "0 12 1 * 1", want: time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{name: "list", expr: "0 8,20 * * *", want: time.Date(2024, 1, 31, 20, 0, 0, 0, time.UTC)},
+		{name: "four fields", expr: "0 9 * *", wantErr: true},
+		{name: "out of range", expr: "0 24 * * *", wantErr: true},
+		{name: "zero step", expr: "*/0 * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := ParseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCron() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			got, ok := expr.Next(from)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, %v, want %v", got, ok, tt.want)
+			}
+		})
+	}
+
+	expr, _ := ParseCron("0 0 30 2 *")
+	if _, ok := expr.Next(from); ok {
+		t.Errorf("Next() of 30 feb should not match")
+	}
+}
+
+func TestTaskScheduleTimezone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no tzdata")
+	}
+
+	// 9:00 in New York stays 9:00 over the DST switch of 10 march
+	cur := time.Date(2024, 3, 9, 9, 0, 0, 0, loc).UTC()
+	schedule := TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceDaily}, Timezone: "America/New_York"}
+
+	next, ok := schedule.Next(cur, 0)
+	if !ok || next.In(loc).Hour() != 9 || next.Sub(cur) != 23*time.Hour {
+		t.Errorf("Next() = %v, %v", next.In(loc), ok)
+	}
+
+	schedule = TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceCron}, Cron: "0 9 * * *", Timezone: "America/New_York"}
+
+	next, ok = schedule.Next(cur, 0)
+	if !ok || next.In(loc).Hour() != 9 || next.In(loc).Day() != 10 {
+		t.Errorf("cron Next() = %v, %v", next.In(loc), ok)
+	}
+
+	if err := (&TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceDaily}, Timezone: "Mars/Olympus"}).Validate(); err == nil {
+		t.Errorf("Validate() should fail for an unknown timezone")
+	}
+}
+
+func TestTaskRecurrenceAdvance(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	finishTo := start.Add(48 * time.Hour)
+
+	task := Task{
+		UUID:           uuid.New(),
+		Name:           "Отчет",
+		FederationUUID: uuid.New(),
+		CompanyUUID:    uuid.New(),
+		ProjectUUID:    uuid.New(),
+		CreatedBy:      "user@mail.ru",
+		FinishTo:       &finishTo,
+	}
+	task.Path = []string{task.UUID.String()}
+
+	schedule := TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceWeekly, Count: 3}}
+
+	dm, err := NewTaskRecurrence(task, schedule, start, "user@mail.ru", start)
+	if err != nil {
+		t.Fatalf("NewTaskRecurrence() error = %v", err)
+	}
+
+	// the task is the first occurrence
+	if dm.NextAt == nil || !dm.NextAt.Equal(start.AddDate(0, 0, 7)) || dm.Occurrence != 1 {
+		t.Fatalf("NextAt = %v, occurrence %d", dm.NextAt, dm.Occurrence)
+	}
+
+	if _, ok := dm.Advance(start.AddDate(0, 0, 6)); ok {
+		t.Errorf("Advance() before the occurrence")
+	}
+
+	occurrence, ok := dm.Advance(start.AddDate(0, 0, 7))
+	if !ok || !occurrence.Equal(start.AddDate(0, 0, 7)) || dm.NextAt == nil || !dm.NextAt.Equal(start.AddDate(0, 0, 14)) {
+		t.Fatalf("Advance() = %v, %v, next %v", occurrence, ok, dm.NextAt)
+	}
+
+	spawned, err := dm.Spawn(task, occurrence)
+	if err != nil {
+		t.Fatalf("Spawn() error = %v", err)
+	}
+
+	if spawned.UUID == task.UUID || len(spawned.Path) != 1 || spawned.Name != task.Name || *spawned.RecurrenceUUID != dm.UUID {
+		t.Errorf("Spawn() = %+v", spawned)
+	}
+
+	if !spawned.FinishTo.Equal(occurrence.Add(48 * time.Hour)) {
+		t.Errorf("Spawn() finish_to = %v", spawned.FinishTo)
+	}
+
+	// the third occurrence is the last one
+	if _, ok := dm.Advance(start.AddDate(0, 0, 14)); !ok || dm.NextAt != nil {
+		t.Errorf("Advance() = %v, next %v", ok, dm.NextAt)
+	}
+}
+
+func TestTaskRecurrenceSkipPast(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := start.AddDate(0, 0, 10).Add(time.Hour)
+
+	tmpl := TaskTemplate{UUID: uuid.New()}
+	target := TaskTemplateTarget{FederationUUID: uuid.New(), CompanyUUID: uuid.New(), ProjectUUID: uuid.New(), CreatedBy: "user@mail.ru"}
+	schedule := TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceDaily}}
+
+	dm, err := NewTemplateRecurrence(tmpl, target, schedule, start, start)
+	if err != nil {
+		t.Fatalf("NewTemplateRecurrence() error = %v", err)
+	}
+
+	// a template series starts with start itself
+	if !dm.NextAt.Equal(start) {
+		t.Fatalf("NextAt = %v", dm.NextAt)
+	}
+
+	// ten missed days give one task
+	occurrence, ok := dm.Advance(now)
+	if !ok || !occurrence.Equal(start) || !dm.NextAt.Equal(start.AddDate(0, 0, 11)) {
+		t.Errorf("Advance() = %v, %v, next %v", occurrence, ok, dm.NextAt)
+	}
+
+	if _, err := NewTemplateRecurrence(tmpl, target, TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceCron}, Cron: "0 9"}, start, start); err == nil {
+		t.Errorf("NewTemplateRecurrence() should fail for a bad cron")
+	}
+}
+
+func TestTaskRecurrenceMonthlyAnchor(t *testing.T) {
+	start := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	tmpl := TaskTemplate{UUID: uuid.New()}
+	target := TaskTemplateTarget{FederationUUID: uuid.New(), CompanyUUID: uuid.New(), ProjectUUID: uuid.New(), CreatedBy: "user@mail.ru"}
+	schedule := TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceMonthly}}
+
+	dm, err := NewTemplateRecurrence(tmpl, target, schedule, start, start)
+	if err != nil {
+		t.Fatalf("NewTemplateRecurrence() error = %v", err)
+	}
+
+	for _, want := range []time.Time{start, time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC), time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC)} {
+		occurrence, ok := dm.Advance(want)
+		if !ok || !occurrence.Equal(want) {
+			t.Fatalf("Advance() = %v, %v, want %v", occurrence, ok, want)
+		}
+	}
+}
+
+func TestTaskRecurrenceJump(t *testing.T) {
+	start := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+
+	tmpl := TaskTemplate{UUID: uuid.New()}
+	target := TaskTemplateTarget{FederationUUID: uuid.New(), CompanyUUID: uuid.New(), ProjectUUID: uuid.New(), CreatedBy: "user@mail.ru"}
+
+	tests := []struct {
+		name     string
+		schedule TaskSchedule
+	}{
+		{"daily", TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceDaily, Interval: 3}, Timezone: "Europe/Moscow"}},
+		{"weekly", TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceWeekly, Interval: 2}}},
+		{"weekdays", TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceWeekly, Weekdays: []int{1, 3, 5}}}},
+		{"monthly", TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceMonthly}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm, err := NewTemplateRecurrence(tmpl, target, tt.schedule, start, now)
+			if err != nil {
+				t.Fatalf("NewTemplateRecurrence() error = %v", err)
+			}
+
+			// every occurrence one by one
+			c, _ := dm.Schedule.compile()
+			want, occurrence := start, 0
+			for want.Before(now) {
+				want, _ = c.next(want, occurrence)
+				occurrence++
+			}
+
+			if dm.NextAt == nil || !dm.NextAt.Equal(want) || dm.Occurrence != occurrence {
+				t.Errorf("NextAt = %v (%d), want %v (%d)", dm.NextAt, dm.Occurrence, want, occurrence)
+			}
+		})
+	}
+
+	// a cron series paused for years goes straight to the first minute not before now
+	dm, err := NewTemplateRecurrence(tmpl, target, TaskSchedule{ReminderRecurrence: ReminderRecurrence{Freq: RecurrenceCron}, Cron: "* * * * *"}, start, now)
+	if err != nil || dm.NextAt == nil || !dm.NextAt.Equal(now) {
+		t.Errorf("cron NextAt = %v, %v", dm.NextAt, err)
+	}
+}
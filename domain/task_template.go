@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+const (
+	TaskTemplateMaxDepth = 5
+	TaskTemplateMaxTasks = 200
+)
+
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
+
+// TaskTemplate - a task tree to create again and again, of a project or, when ProjectUUID is nil, of the whole company.
+type TaskTemplate struct {
+	UUID        uuid.UUID
+	CompanyUUID uuid.UUID `validate:"uuid"  ru:"компания (uuid)"`
+	ProjectUUID *uuid.UUID
+	Name        string `validate:"min=3,max=100"  ru:"название"`
+	Root        TaskTemplateNode
+
+	CreatedBy string `validate:"lte=100,gte=3"  ru:"автор (email)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TaskTemplateNode - a task of the template, the strings may hold {{placeholders}}.
+type TaskTemplateNode struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	IsEpic      bool                   `json:"is_epic,omitempty"`
+	Priority    int                    `json:"priority,omitempty"`
+	Icon        string                 `json:"icon,omitempty"`
+	Fields      map[string]interface{} `json:"fields,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+
+	ResponsibleBy string   `json:"responsible_by,omitempty"`
+	ImplementBy   string   `json:"implement_by,omitempty"`
+	ManagedBy     string   `json:"managed_by,omitempty"`
+	CoWorkersBy   []string `json:"coworkers_by,omitempty"`
+	WatchBy       []string `json:"watch_by,omitempty"`
+
+	// FinishInDays - finish_to of the task is the instantiation time plus the days
+	FinishInDays *int `json:"finish_in_days,omitempty"`
+
+	Children []TaskTemplateNode `json:"children,omitempty"`
+}
+
+func (j *TaskTemplateNode) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	return json.Unmarshal(bytes, j)
+}
+
+func (j TaskTemplateNode) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+func NewTaskTemplate(companyUUID uuid.UUID, projectUUID *uuid.UUID, name string, root TaskTemplateNode, createdBy string) (*TaskTemplate, error) {
+	dm := &TaskTemplate{
+		UUID:        uuid.New(),
+		CompanyUUID: companyUUID,
+		ProjectUUID: projectUUID,
+		Name:        strings.TrimSpace(name),
+		Root:        root,
+
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	return dm, dm.Validate()
+}
+
+func (t TaskTemplate) Validate() error {
+	errs, ok := helpers.ValidationStruct(t)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	total := 0
+
+	var walk func(n TaskTemplateNode, depth int) error
+	walk = func(n TaskTemplateNode, depth int) error {
+		total++
+
+		if depth > TaskTemplateMaxDepth {
+			return fmt.Errorf("вложенность шаблона больше %d", TaskTemplateMaxDepth)
+		}
+
+		if total > TaskTemplateMaxTasks {
+			return fmt.Errorf("в шаблоне больше %d задач", TaskTemplateMaxTasks)
+		}
+
+		if strings.TrimSpace(n.Name) == "" {
+			return errors.New("у задачи шаблона нет названия")
+		}
+
+		if n.FinishInDays != nil && *n.FinishInDays < 0 {
+			return errors.New("срок задачи шаблона не может быть в прошлом")
+		}
+
+		for _, c := range n.Children {
+			if err := walk(c, depth+1); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return walk(t.Root, 1)
+}
+
+// Placeholders - the sorted names of the {{placeholders}} used in the template.
+func (t TaskTemplate) Placeholders() []string {
+	names := []string{}
+
+	var walk func(n TaskTemplateNode)
+	walk = func(n TaskTemplateNode) {
+		texts := append([]string{n.Name, n.Description}, n.Tags...)
+		for _, v := range n.Fields {
+			if s, ok := v.(string); ok {
+				texts = append(texts, s)
+			}
+		}
+
+		for _, text := range texts {
+			for _, m := range templatePlaceholder.FindAllStringSubmatch(text, -1) {
+				names = append(names, m[1])
+			}
+		}
+
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+
+	walk(t.Root)
+
+	names = lo.Uniq(names)
+	sort.Strings(names)
+
+	return names
+}
+
+// TaskTemplateTarget - where and by whom the template is instantiated, Path is the parent task path if any.
+type TaskTemplateTarget struct {
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	Path           []string
+	CreatedBy      string
+	Vars           map[string]string
+	// RecurrenceUUID - the series the root task is spawned by
+	RecurrenceUUID *uuid.UUID
+}
+
+// Instantiate builds the task tree, parents come before their children and each path extends the parent one.
+func (t TaskTemplate) Instantiate(target TaskTemplateTarget, now time.Time) ([]Task, error) {
+	missing := lo.Filter(t.Placeholders(), func(name string, _ int) bool {
+		_, ok := target.Vars[name]
+		return !ok
+	})
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("не заполнены параметры шаблона: %s", strings.Join(missing, ", "))
+	}
+
+	sub := func(s string) string {
+		return templatePlaceholder.ReplaceAllStringFunc(s, func(m string) string {
+			return target.Vars[templatePlaceholder.FindStringSubmatch(m)[1]]
+		})
+	}
+
+	tasks := []Task{}
+
+	var build func(n TaskTemplateNode, path []string) error
+	build = func(n TaskTemplateNode, path []string) error {
+		fields := make(map[string]interface{}, len(n.Fields))
+		for k, v := range n.Fields {
+			if s, ok := v.(string); ok {
+				v = sub(s)
+			}
+			fields[k] = v
+		}
+
+		var finishTo *time.Time
+		if n.FinishInDays != nil {
+			finishTo = lo.ToPtr(now.AddDate(0, 0, *n.FinishInDays))
+		}
+
+		task, err := NewTask(
+			sub(n.Name),
+			target.FederationUUID,
+			target.CompanyUUID,
+			target.ProjectUUID,
+			target.CreatedBy,
+			fields,
+			lo.Map(n.Tags, func(tag string, _ int) string { return sub(tag) }),
+
+			sub(n.Description),
+			append([]string{}, path...),
+			n.CoWorkersBy,
+			n.ImplementBy,
+			n.ResponsibleBy,
+
+			n.Priority,
+
+			finishTo,
+			n.Icon,
+			n.ManagedBy,
+
+			map[uuid.UUID][]string{},
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", sub(n.Name), err)
+		}
+
+		task.IsEpic = n.IsEpic
+		task.WatchBy = lo.WithoutEmpty(lo.Uniq(n.WatchBy))
+		task.People = lo.WithoutEmpty(lo.Uniq(append(task.People, task.WatchBy...)))
+
+		if len(tasks) == 0 {
+			task.RecurrenceUUID = target.RecurrenceUUID
+		}
+
+		tasks = append(tasks, task)
+
+		for _, c := range n.Children {
+			if err := build(c, task.Path); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	err := build(t.Root, target.Path)
+
+	return tasks, err
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func testTemplate() TaskTemplate {
+	return TaskTemplate{
+		UUID:        uuid.New(),
+		CompanyUUID: uuid.New(),
+		Name:        "Новый клиент",
+		Root: TaskTemplateNode{
+			Name:        "Онбординг {{client}}",
+			IsEpic:      true,
+			ImplementBy: "manager@mail.ru",
+			Tags:        []string{"onboarding"},
+			Children: []TaskTemplateNode{
+				{Name: "Договор с {{ client }}", Fields: map[string]interface{}{"amount": 100, "contact": "{{contact}}"}, FinishInDays: lo.ToPtr(3)},
+				{Name: "Счет", WatchBy: []string{"buh@mail.ru"}, Children: []TaskTemplateNode{{Name: "Оплата"}}},
+			},
+		},
+		CreatedBy: "user@mail.ru",
+	}
+}
+
+func TestTaskTemplateValidate(t *testing.T) {
+	deep := TaskTemplateNode{Name: "level"}
+	for i := 0; i < TaskTemplateMaxDepth; i++ {
+		deep = TaskTemplateNode{Name: "level", Children: []TaskTemplateNode{deep}}
+	}
+
+	wide := TaskTemplateNode{Name: "root", Children: lo.Times(TaskTemplateMaxTasks, func(_ int) TaskTemplateNode {
+		return TaskTemplateNode{Name: "task"}
+	})}
+
+	tests := []struct {
+		name    string
+		root    TaskTemplateNode
+		wantErr bool
+	}{
+		{"ok", testTemplate().Root, false},
+		{"no name", TaskTemplateNode{Name: "root", Children: []TaskTemplateNode{{Name: " "}}}, true},
+		{"too deep", deep, true},
+		{"too wide", wide, true},
+		{"past", TaskTemplateNode{Name: "root", FinishInDays: lo.ToPtr(-1)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTaskTemplate(uuid.New(), nil, "Шаблон", tt.root, "user@mail.ru")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTaskTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTaskTemplatePlaceholders(t *testing.T) {
+	got := testTemplate().Placeholders()
+
+	if strings.Join(got, ",") != "client,contact" {
+		t.Errorf("Placeholders() = %v", got)
+	}
+}
+
+func TestTaskTemplateInstantiate(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	parent := uuid.NewString()
+
+	target := TaskTemplateTarget{
+		FederationUUID: uuid.New(),
+		CompanyUUID:    uuid.New(),
+		ProjectUUID:    uuid.New(),
+		Path:           []string{parent},
+		CreatedBy:      "user@mail.ru",
+		Vars:           map[string]string{"client": "ООО Ромашка", "contact": "Иван"},
+	}
+
+	tasks, err := testTemplate().Instantiate(target, now)
+	if err != nil {
+		t.Fatalf("Instantiate() error = %v", err)
+	}
+
+	if len(tasks) != 4 {
+		t.Fatalf("Instantiate() = %d tasks, want 4", len(tasks))
+	}
+
+	root, contract, bill, payment := tasks[0], tasks[1], tasks[2], tasks[3]
+
+	if root.Name != "Онбординг ООО Ромашка" || !root.IsEpic || contract.Name != "Договор с ООО Ромашка" {
+		t.Errorf("names = %q, %q", root.Name, contract.Name)
+	}
+
+	if contract.RawFields["contact"] != "Иван" || contract.RawFields["amount"] != 100 {
+		t.Errorf("fields = %v", contract.RawFields)
+	}
+
+	if contract.FinishTo == nil || !contract.FinishTo.Equal(now.AddDate(0, 0, 3)) {
+		t.Errorf("finish_to = %v", contract.FinishTo)
+	}
+
+	wantPath := strings.Join([]string{parent, root.UUID.String(), bill.UUID.String(), payment.UUID.String()}, ".")
+	if strings.Join(payment.Path, ".") != wantPath {
+		t.Errorf("path = %v, want %s", payment.Path, wantPath)
+	}
+
+	if strings.Join(root.Path, ".") != parent+"."+root.UUID.String() {
+		t.Errorf("root path = %v", root.Path)
+	}
+
+	if lo.IndexOf(bill.People, "buh@mail.ru") == -1 {
+		t.Errorf("people = %v", bill.People)
+	}
+
+	target.Vars = map[string]string{"client": "ООО Ромашка"}
+
+	if _, err := testTemplate().Instantiate(target, now); err == nil {
+		t.Error("Instantiate() expected the missing placeholder error")
+	}
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+// TaskView - a saved task list: the filter, the sort, the visible columns and the grouping.
+// A private view belongs to its creator, a shared one is listed for everyone in the project.
+type TaskView struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID `validate:"uuid"  ru:"федерация (uuid)"`
+	ProjectUUID    uuid.UUID `validate:"uuid"  ru:"проект (uuid)"`
+	UserUUID       uuid.UUID `validate:"uuid"  ru:"пользователь (uuid)"`
+	Name           string    `validate:"trim,min=1,max=100"  ru:"название"`
+	Shared         bool
+
+	TaskViewSettings
+
+	CreatedBy string `validate:"lte=100,gte=3"  ru:"автор (email)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type TaskViewSettings struct {
+	Filter TaskViewFilter
+	Order  *string `validate:"omitempty,max=100"  ru:"сортировка"`
+	By     *string `validate:"omitempty,oneof=asc desc"  ru:"направление сортировки"`
+	// Columns - the visible columns in their order, a subset of the project sort fields
+	Columns []string `validate:"max=50,dive,max=100"  ru:"колонки"`
+	GroupBy *string  `validate:"omitempty,max=100"  ru:"группировка"`
+}
+
+// TaskViewFilter keeps the params of GET /task, Fields is the raw `fields` param.
+type TaskViewFilter struct {
+	Name         *string   `json:"name,omitempty"`
+	IsMy         *bool     `json:"is_my,omitempty"`
+	Status       *int      `json:"status,omitempty"`
+	IsEpic       *bool     `json:"is_epic,omitempty"`
+	Participated *[]string `json:"participated,omitempty"`
+	Tags         *[]string `json:"tags,omitempty"`
+	Path         *string   `json:"path,omitempty"`
+	Fields       *string   `json:"fields,omitempty"`
+	Overdue      *bool     `json:"overdue,omitempty"`
+}
+
+func (j *TaskViewFilter) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := TaskViewFilter{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j TaskViewFilter) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+func NewTaskView(federationUUID, projectUUID, userUUID uuid.UUID, name string, shared bool, settings TaskViewSettings, createdBy string) (*TaskView, error) {
+	settings.Columns = lo.Uniq(settings.Columns)
+
+	dm := &TaskView{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		ProjectUUID:    projectUUID,
+		UserUUID:       userUUID,
+		Name:           name,
+		Shared:         shared,
+
+		TaskViewSettings: settings,
+
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	errs, ok := helpers.ValidationStruct(dm)
+	if !ok {
+		return dm, errors.New(helpers.Join(errs, ", "))
+	}
+
+	return dm, nil
+}
+
+// VisibleTo - the creator sees all of their views, the others see only the shared ones.
+func (v TaskView) VisibleTo(userUUID uuid.UUID) bool {
+	return v.Shared || v.UserUUID == userUUID
+}
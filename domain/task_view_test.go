@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+func TestNewTaskView(t *testing.T) {
+	federationUUID, projectUUID, userUUID := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name     string
+		viewName string
+		settings TaskViewSettings
+		wantErr  bool
+	}{
+		{"plain", "Мои задачи", TaskViewSettings{Filter: TaskViewFilter{IsMy: helpers.Ptr(true)}}, false},
+		{"sort", "По дате", TaskViewSettings{Order: helpers.Ptr("created_at"), By: helpers.Ptr("asc")}, false},
+		{"wrong direction", "По дате", TaskViewSettings{Order: helpers.Ptr("created_at"), By: helpers.Ptr("up")}, true},
+		{"empty name", "", TaskViewSettings{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTaskView(federationUUID, projectUUID, userUUID, tt.viewName, false, tt.settings, "user@mail.ru")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTaskView() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTaskViewVisibleTo(t *testing.T) {
+	owner, other := uuid.New(), uuid.New()
+
+	view := TaskView{UserUUID: owner}
+	if !view.VisibleTo(owner) || view.VisibleTo(other) {
+		t.Errorf("private view should be visible to its creator only")
+	}
+
+	view.Shared = true
+	if !view.VisibleTo(other) {
+		t.Errorf("shared view should be visible to everyone")
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+// WorklogMaxDuration - a worklog is at most a day, a forgotten timer is cut to it.
+const WorklogMaxDuration = 24 * 60
+
+// TaskWorklog - the time spent on a task, Duration is in minutes.
+type TaskWorklog struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID `validate:"uuid"  ru:"федерация (uuid)"`
+	ProjectUUID    uuid.UUID `validate:"uuid"  ru:"проект (uuid)"`
+	TaskUUID       uuid.UUID `validate:"uuid"  ru:"задача (uuid)"`
+	UserUUID       uuid.UUID `validate:"uuid"  ru:"пользователь (uuid)"`
+
+	StartedAt time.Time
+	Duration  int    `validate:"min=1,max=1440"  ru:"длительность (мин)"`
+	Comment   string `validate:"max=1000"  ru:"комментарий"`
+	Billable  bool
+
+	CreatedBy string `validate:"lte=100,gte=3"  ru:"автор (email)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewTaskWorklog(task Task, crt Creator, startedAt time.Time, duration int, comment string, billable bool) (*TaskWorklog, error) {
+	dm := &TaskWorklog{
+		UUID:           uuid.New(),
+		FederationUUID: task.FederationUUID,
+		ProjectUUID:    task.ProjectUUID,
+		TaskUUID:       task.UUID,
+		UserUUID:       crt.UUID,
+
+		StartedAt: startedAt,
+		Duration:  duration,
+		Comment:   strings.TrimSpace(comment),
+		Billable:  billable,
+
+		CreatedBy: crt.Email,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	return dm, dm.Validate()
+}
+
+func (w TaskWorklog) Validate() error {
+	errs, ok := helpers.ValidationStruct(w)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	if w.StartedAt.After(time.Now()) {
+		return errors.New("время начала в будущем")
+	}
+
+	return nil
+}
+
+// TaskTimer - the running timer of a user, a user has one timer at a time.
+type TaskTimer struct {
+	UserUUID       uuid.UUID
+	FederationUUID uuid.UUID
+	ProjectUUID    uuid.UUID
+	TaskUUID       uuid.UUID
+	StartedAt      time.Time
+}
+
+func NewTaskTimer(task Task, userUUID uuid.UUID) TaskTimer {
+	return TaskTimer{
+		UserUUID:       userUUID,
+		FederationUUID: task.FederationUUID,
+		ProjectUUID:    task.ProjectUUID,
+		TaskUUID:       task.UUID,
+		StartedAt:      time.Now(),
+	}
+}
+
+// Stop turns the timer into a billable worklog, the minutes are rounded up.
+func (t TaskTimer) Stop(crt Creator, now time.Time) (*TaskWorklog, error) {
+	minutes := int(math.Ceil(now.Sub(t.StartedAt).Minutes()))
+	minutes = max(1, min(minutes, WorklogMaxDuration))
+
+	return NewTaskWorklog(Task{
+		UUID:           t.TaskUUID,
+		FederationUUID: t.FederationUUID,
+		ProjectUUID:    t.ProjectUUID,
+	}, crt, t.StartedAt, minutes, "", true)
+}
+
+// WorklogTotal - the minutes spent and the billable part of them.
+type WorklogTotal struct {
+	Duration int
+	Billable int
+}
+
+func SumWorklogs(dms []TaskWorklog) (total WorklogTotal) {
+	for _, w := range dms {
+		total.Duration += w.Duration
+		if w.Billable {
+			total.Billable += w.Duration
+		}
+	}
+
+	return total
+}
+
+// TimesheetFilter - the worklogs of a user in the federation started in [From, To).
+type TimesheetFilter struct {
+	FederationUUID uuid.UUID `validate:"uuid"  ru:"федерация (uuid)"`
+	UserUUID       uuid.UUID `validate:"uuid"  ru:"пользователь (uuid)"`
+	From           time.Time
+	To             time.Time
+	Billable       *bool
+}
+
+func (f TimesheetFilter) Validate() error {
+	errs, ok := helpers.ValidationStruct(f)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	if !f.To.After(f.From) {
+		return errors.New("конец периода должен быть позже начала")
+	}
+
+	if f.To.Sub(f.From) > 366*24*time.Hour {
+		return errors.New("период не может быть больше года")
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewTaskWorklog(t *testing.T) {
+	task := Task{UUID: uuid.New(), FederationUUID: uuid.New(), ProjectUUID: uuid.New()}
+	crt := Creator{UUID: uuid.New(), Email: "user@mail.ru"}
+
+	tests := []struct {
+		name      string
+		startedAt time.Time
+		duration  int
+		wantErr   bool
+	}{
+		{"hour", time.Now().Add(-time.Hour), 60, false},
+		{"zero", time.Now().Add(-time.Hour), 0, true},
+		{"more than a day", time.Now().Add(-48 * time.Hour), WorklogMaxDuration + 1, true},
+		{"in the future", time.Now().Add(time.Hour), 60, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTaskWorklog(task, crt, tt.startedAt, tt.duration, "", true)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTaskWorklog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTaskTimerStop(t *testing.T) {
+	task := Task{UUID: uuid.New(), FederationUUID: uuid.New(), ProjectUUID: uuid.New()}
+	crt := Creator{UUID: uuid.New(), Email: "user@mail.ru"}
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		want    int
+	}{
+		{"seconds", 10 * time.Second, 1},
+		{"rounded up", 61 * time.Minute, 61},
+		{"forgotten", 30 * time.Hour, WorklogMaxDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timer := NewTaskTimer(task, crt.UUID)
+			timer.StartedAt = now.Add(-tt.elapsed).Add(time.Millisecond)
+
+			worklog, err := timer.Stop(crt, now)
+			if err != nil {
+				t.Fatalf("Stop() error = %v", err)
+			}
+
+			if worklog.Duration != tt.want || !worklog.Billable || worklog.TaskUUID != task.UUID {
+				t.Errorf("Stop() = %d minutes, want %d", worklog.Duration, tt.want)
+			}
+		})
+	}
+}
+
+func TestSumWorklogs(t *testing.T) {
+	total := SumWorklogs([]TaskWorklog{{Duration: 30, Billable: true}, {Duration: 15}, {Duration: 45, Billable: true}})
+
+	if total.Duration != 90 || total.Billable != 75 {
+		t.Errorf("SumWorklogs() = %+v", total)
+	}
+}
+
+func TestTimesheetFilterValidate(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		to      time.Time
+		wantErr bool
+	}{
+		{"month", from.AddDate(0, 1, 0), false},
+		{"empty", from, true},
+		{"two years", from.AddDate(2, 0, 0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := TimesheetFilter{FederationUUID: uuid.New(), UserUUID: uuid.New(), From: from, To: tt.to}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -35,7 +35,8 @@ type User struct {
 }
 
 type ProfilePreferences struct {
-	Timezone *string `json:"timezone,omitempty"`
+	Timezone      *string                  `json:"timezone,omitempty"`
+	Notifications *NotificationPreferences `json:"notifications,omitempty"`
 }
 
 type ProfilePhotoDTO struct {
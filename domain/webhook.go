@@ -0,0 +1,282 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+// delivery statuses, a failed delivery is out of attempts and is sent again only by hand
+const (
+	WebhookDeliveryPending = "pending"
+	WebhookDeliverySuccess = "success"
+	WebhookDeliveryFailed  = "failed"
+)
+
+// headers of a delivery request
+const (
+	WebhookHeaderEvent     = "X-Webhook-Event"
+	WebhookHeaderDelivery  = "X-Webhook-Delivery"
+	WebhookHeaderTimestamp = "X-Webhook-Timestamp"
+	WebhookHeaderSignature = "X-Webhook-Signature"
+)
+
+const (
+	webhookBackoffMin   = 30 * time.Second
+	webhookBackoffMax   = 6 * time.Hour
+	webhookResponseSize = 1000
+)
+
+// WebhookEvents - the events a webhook can be subscribed to, the changes of tasks, comments and reminders.
+var WebhookEvents = []string{
+	EventTaskCreated,
+	EventTaskUpdated,
+	EventTaskStatusChanged,
+	EventTaskDeleted,
+	EventCommentAdded,
+	EventCommentUpdated,
+	EventCommentDeleted,
+	EventCommentLiked,
+	EventReminderCreated,
+	EventReminderUpdated,
+	EventReminderStatusChanged,
+	EventReminderDeleted,
+	EventReminderDue,
+}
+
+// Webhook - a subscription of an outside service to the events of a federation or, with CompanyUUID, of a company.
+type Webhook struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID `validate:"uuid"  ru:"федерация (uuid)"`
+	CompanyUUID    *uuid.UUID
+	URL            string   `validate:"http_url,max=2000"  ru:"адрес"`
+	Events         []string `validate:"min=1,max=50"  ru:"события"`
+	Secret         string   `validate:"min=16,max=200"  ru:"секрет"`
+	Active         bool
+
+	// Failures - failed attempts in a row, the webhook is disabled when there are too many
+	Failures   int
+	DisabledAt *time.Time
+
+	CreatedBy string `validate:"lte=100,gte=3"  ru:"автор (email)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewWebhook - an empty secret is generated.
+func NewWebhook(federationUUID uuid.UUID, companyUUID *uuid.UUID, url string, events []string, secret string, createdBy string) (*Webhook, error) {
+	if secret == "" {
+		secret = NewWebhookSecret()
+	}
+
+	dm := &Webhook{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		URL:            strings.TrimSpace(url),
+		Events:         lo.Uniq(events),
+		Secret:         secret,
+		Active:         true,
+
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	return dm, dm.Validate()
+}
+
+func NewWebhookSecret() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+func (w Webhook) Validate() error {
+	errs, ok := helpers.ValidationStruct(w)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	if unknown, _ := lo.Difference(w.Events, WebhookEvents); len(unknown) > 0 {
+		return fmt.Errorf("неизвестные события: %s", helpers.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// Activate turns the webhook on again, the failures are forgotten.
+func (w *Webhook) Activate(active bool) {
+	if active && !w.Active {
+		w.Failures = 0
+		w.DisabledAt = nil
+	}
+
+	w.Active = active
+}
+
+// Matches - the webhook is active, subscribed to the event and its scope holds the task of the event.
+func (w Webhook) Matches(eventName string, federationUUID, companyUUID uuid.UUID) bool {
+	return w.Active &&
+		w.FederationUUID == federationUUID &&
+		(w.CompanyUUID == nil || *w.CompanyUUID == companyUUID) &&
+		lo.Contains(w.Events, eventName)
+}
+
+// WebhookSignature - "sha256=" and the hex HMAC of "timestamp.body" with the secret of the webhook.
+// The receiver computes it again and rejects old timestamps against replays.
+func WebhookSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookBackoff - 30s, 1m, 2m ... up to 6 hours.
+func WebhookBackoff(attempts int) time.Duration {
+	d := webhookBackoffMin
+	for i := 1; i < attempts && d < webhookBackoffMax; i++ {
+		d *= 2
+	}
+
+	return min(d, webhookBackoffMax)
+}
+
+// WebhookPayload - the body of a delivery request.
+type WebhookPayload struct {
+	UUID       uuid.UUID `json:"uuid"`
+	Event      string    `json:"event"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       Event     `json:"data"`
+}
+
+// WebhookDelivery - an event sent or to be sent to a webhook.
+type WebhookDelivery struct {
+	UUID        uuid.UUID
+	WebhookUUID uuid.UUID
+	Event       string
+	EventUUID   uuid.UUID
+	Payload     json.RawMessage
+	Status      string
+
+	Attempts int
+	// NextAttemptAt - nil once the delivery is over
+	NextAttemptAt *time.Time
+
+	ResponseCode int
+	ResponseBody string
+	LastError    string
+	// Duration - of the last attempt, ms
+	Duration int
+
+	CreatedAt   time.Time
+	DeliveredAt *time.Time
+}
+
+func NewWebhookDelivery(webhookUUID uuid.UUID, e Event) (*WebhookDelivery, error) {
+	m := e.Meta()
+
+	payload, err := json.Marshal(WebhookPayload{
+		UUID:       m.UUID,
+		Event:      e.EventName(),
+		OccurredAt: m.OccurredAt,
+		Data:       e,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &WebhookDelivery{
+		UUID:          uuid.New(),
+		WebhookUUID:   webhookUUID,
+		Event:         e.EventName(),
+		EventUUID:     m.UUID,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// Redeliver - a new delivery of the same payload, sent right away.
+func (d WebhookDelivery) Redeliver() *WebhookDelivery {
+	now := time.Now()
+
+	return &WebhookDelivery{
+		UUID:          uuid.New(),
+		WebhookUUID:   d.WebhookUUID,
+		Event:         d.Event,
+		EventUUID:     d.EventUUID,
+		Payload:       d.Payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+	}
+}
+
+// Attempted records an attempt: a 2xx response is a success, otherwise the next attempt is scheduled
+// with an exponential backoff until the attempts are over. True - the attempt has succeeded.
+func (d *WebhookDelivery) Attempted(code int, body string, err error, duration time.Duration, now time.Time, maxAttempts int) bool {
+	d.Attempts++
+	d.ResponseCode = code
+	d.ResponseBody = truncate(body, webhookResponseSize)
+	d.Duration = int(duration.Milliseconds())
+	d.LastError = ""
+
+	if err == nil && code >= 200 && code < 300 {
+		d.Status = WebhookDeliverySuccess
+		d.NextAttemptAt = nil
+		d.DeliveredAt = &now
+
+		return true
+	}
+
+	if err != nil {
+		d.LastError = err.Error()
+	} else {
+		d.LastError = fmt.Sprintf("ответ %d", code)
+	}
+
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptAt = nil
+
+		return false
+	}
+
+	next := now.Add(WebhookBackoff(d.Attempts))
+	d.NextAttemptAt = &next
+
+	return false
+}
+
+func truncate(s string, size int) string {
+	if len(s) <= size {
+		return s
+	}
+
+	s = s[:size]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
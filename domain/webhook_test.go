@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestWebhookSignature(t *testing.T) {
+	got := WebhookSignature("0123456789abcdef", 1700000000, []byte(`{"event":"task.created"}`))
+	want := "sha256=4f6829796077f51a11af48415aa8b8dea13d1c92c80e811fa14bf853abd73140"
+
+	if got != want {
+		t.Errorf("WebhookSignature() = %v, want %v", got, want)
+	}
+}
+
+func TestNewWebhook(t *testing.T) {
+	federation := uuid.New()
+
+	tests := []struct {
+		name    string
+		url     string
+		events  []string
+		secret  string
+		wantErr bool
+	}{
+		{name: "generated secret", url: "https://hooks.example.com/crm", events: []string{EventTaskCreated}},
+		{name: "own secret", url: "http://10.0.0.1:8080/hook", events: []string{EventCommentAdded, EventCommentAdded}, secret: "0123456789abcdef"},
+		{name: "not http", url: "ftp://hooks.example.com", events: []string{EventTaskCreated}, wantErr: true},
+		{name: "no events", url: "https://hooks.example.com", wantErr: true},
+		{name: "unknown event", url: "https://hooks.example.com", events: []string{EventTaskOpened}, wantErr: true},
+		{name: "short secret", url: "https://hooks.example.com", events: []string{EventTaskCreated}, secret: "secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm, err := NewWebhook(federation, nil, tt.url, tt.events, tt.secret, "user@mail.ru")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && (!dm.Active || len(dm.Secret) < 16 || len(dm.Events) != 1) {
+				t.Errorf("NewWebhook() = %+v", dm)
+			}
+		})
+	}
+}
+
+func TestWebhookMatches(t *testing.T) {
+	federation, company := uuid.New(), uuid.New()
+
+	hook := Webhook{FederationUUID: federation, CompanyUUID: &company, Events: []string{EventTaskCreated}, Active: true}
+
+	if !hook.Matches(EventTaskCreated, federation, company) {
+		t.Errorf("Matches() should match the company task")
+	}
+
+	if hook.Matches(EventTaskCreated, federation, uuid.New()) || hook.Matches(EventTaskDeleted, federation, company) {
+		t.Errorf("Matches() should not match another company or event")
+	}
+
+	hook.CompanyUUID = nil
+	if !hook.Matches(EventTaskCreated, federation, uuid.New()) {
+		t.Errorf("Matches() of a federation webhook should match every company")
+	}
+
+	hook.Active = false
+	if hook.Matches(EventTaskCreated, federation, company) {
+		t.Errorf("Matches() of a disabled webhook")
+	}
+}
+
+func TestWebhookDeliveryAttempted(t *testing.T) {
+	now := time.Now()
+	meta := NewEventMeta("user@mail.ru", uuid.New(), nil)
+
+	d, err := NewWebhookDelivery(uuid.New(), TaskDeleted{EventMeta: meta, Name: "task"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Status != WebhookDeliveryPending || d.EventUUID != meta.UUID {
+		t.Fatalf("NewWebhookDelivery() = %+v", d)
+	}
+
+	if d.Attempted(500, "oops", nil, time.Second, now, 2) {
+		t.Errorf("Attempted() 500 should fail")
+	}
+
+	if d.Status != WebhookDeliveryPending || d.NextAttemptAt == nil || !d.NextAttemptAt.Equal(now.Add(30*time.Second)) || d.LastError == "" {
+		t.Errorf("Attempted() = %+v", d)
+	}
+
+	if d.Attempted(0, "", errors.New("timeout"), time.Second, now, 2) || d.Status != WebhookDeliveryFailed || d.NextAttemptAt != nil {
+		t.Errorf("Attempted() should be over, got %+v", d)
+	}
+
+	again := d.Redeliver()
+	if !again.Attempted(204, "", nil, time.Second, now, 2) || again.Status != WebhookDeliverySuccess || again.DeliveredAt == nil || again.UUID == d.UUID {
+		t.Errorf("Attempted() of the redelivery = %+v", again)
+	}
+}
@@ -54,12 +54,33 @@ type ActivityTaskWasDeletedDTO struct {
 	Name string `json:"name"`
 }
 
+type ActivityDealDTO struct {
+	Name string `json:"name"`
+}
+
 type ActivityTaskFileWasDeletedDTO struct {
 	Name string `json:"name"`
 	Ext  string `json:"ext"`
 	Size int64  `json:"size"`
 }
 
+type ActivityTaskLinkDTO struct {
+	Type     string    `json:"type"`
+	TaskUUID uuid.UUID `json:"task_uuid"`
+	TaskID   int       `json:"task_id"`
+	TaskName string    `json:"task_name"`
+}
+
+// ActivityTaskChecklistDTO - TaskUUID and TaskID are the subtask the item was converted to.
+type ActivityTaskChecklistDTO struct {
+	Action   string     `json:"action"`
+	ItemUUID uuid.UUID  `json:"item_uuid"`
+	Text     string     `json:"text"`
+	Done     bool       `json:"done"`
+	TaskUUID *uuid.UUID `json:"task_uuid,omitempty"`
+	TaskID   *int       `json:"task_id,omitempty"`
+}
+
 func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 	var status map[string]interface{}
 
@@ -81,7 +102,7 @@ func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 		}
 	}
 
-	if dm.Type == int(domain.ActivityTaskField) {
+	if dm.Type == int(domain.ActivityTaskField) || dm.Type == int(domain.ActivityDealField) {
 		var p ActivityTaskFieldDTO
 		metaBytes, err := json.Marshal(dm.Meta)
 		if err != nil {
@@ -135,6 +156,24 @@ func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 		}
 	}
 
+	if dm.Type == int(domain.ActivityDealCreated) || dm.Type == int(domain.ActivityDealWasDeleted) {
+		var p ActivityDealDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
 	if dm.Type == int(domain.ActivityTaskFileWasDeleted) {
 		var p ActivityTaskFileWasDeletedDTO
 		metaBytes, err := json.Marshal(dm.Meta)
@@ -153,6 +192,42 @@ func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 		}
 	}
 
+	if dm.Type == int(domain.ActivityTaskLinked) || dm.Type == int(domain.ActivityTaskUnlinked) {
+		var p ActivityTaskLinkDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
+	if dm.Type == int(domain.ActivityTaskChecklist) {
+		var p ActivityTaskChecklistDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
 	return &ActivityDTO{
 		UUID:      dm.UUID,
 		CreatedBy: user,
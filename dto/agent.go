@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
 )
 
 type AgentDTO struct {
@@ -25,3 +27,96 @@ type AgentContactsDTO struct {
 	Type string `json:"type"`
 	Val  string `json:"val"`
 }
+
+func NewAgentDTO(dm domain.Agent) AgentDTO {
+	return AgentDTO{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+
+		Name: dm.Name,
+		Contacts: lo.Map(dm.Contacts, func(c domain.AgentContacts, _ int) AgentContactsDTO {
+			return AgentContactsDTO{
+				Type: c.Type,
+				Val:  c.Val,
+			}
+		}),
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+type AgentDuplicatesDTO struct {
+	Agents  []AgentDTO `json:"agents"`
+	Reasons []string   `json:"reasons"`
+}
+
+func NewAgentDuplicatesDTO(dm domain.AgentDuplicates) AgentDuplicatesDTO {
+	return AgentDuplicatesDTO{
+		Agents:  lo.Map(dm.Agents, func(a domain.Agent, _ int) AgentDTO { return NewAgentDTO(a) }),
+		Reasons: dm.Reasons,
+	}
+}
+
+type AgentTimelineItemDTO struct {
+	Type     string     `json:"type"`
+	UUID     uuid.UUID  `json:"uuid"`
+	TaskUUID *uuid.UUID `json:"task_uuid,omitempty"`
+
+	Title     string                 `json:"title"`
+	Text      string                 `json:"text"`
+	CreatedBy *UserDTO               `json:"created_by,omitempty"`
+	Meta      map[string]interface{} `json:"meta,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewAgentTimelineItemDTO(dm domain.AgentTimelineItem, dict IDict) AgentTimelineItemDTO {
+	createdBy, _ := dict.FindUser(dm.CreatedBy)
+
+	return AgentTimelineItemDTO{
+		Type:     dm.Type,
+		UUID:     dm.UUID,
+		TaskUUID: dm.TaskUUID,
+
+		Title:     dm.Title,
+		Text:      dm.Text,
+		CreatedBy: createdBy,
+		Meta:      dm.Meta,
+
+		CreatedAt: dm.CreatedAt,
+	}
+}
+
+type AgentImportDTO struct {
+	Total   int  `json:"total"`
+	Created int  `json:"created"`
+	Updated int  `json:"updated"`
+	Skipped int  `json:"skipped"`
+	DryRun  bool `json:"dry_run"`
+
+	Errors []AgentImportErrorDTO `json:"errors"`
+}
+
+type AgentImportErrorDTO struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+func NewAgentImportDTO(dm domain.AgentImportResult) AgentImportDTO {
+	return AgentImportDTO{
+		Total:   dm.Total,
+		Created: dm.Created,
+		Updated: dm.Updated,
+		Skipped: dm.Skipped,
+		DryRun:  dm.DryRun,
+
+		Errors: lo.Map(dm.Errors, func(e domain.AgentImportError, _ int) AgentImportErrorDTO {
+			return AgentImportErrorDTO{
+				Row:     e.Row,
+				Message: e.Message,
+			}
+		}),
+	}
+}
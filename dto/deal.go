@@ -0,0 +1,196 @@
+package dto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type DealDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedBy   *UserDTO  `json:"created_by,omitempty"`
+
+	FederationUUID uuid.UUID `json:"federation_uuid"`
+	CompanyUUID    uuid.UUID `json:"company_uuid"`
+
+	Tags     []string  `json:"tags"`
+	Status   StatusDTO `json:"status"`
+	Priority int       `json:"priority"`
+
+	StageUUID *uuid.UUID `json:"stage_uuid"`
+	Amount    float64    `json:"amount"`
+
+	Fields []TaskFieldDTO `json:"fields"`
+
+	FinishedAt *time.Time `json:"finished_at"`
+	ActivityAt time.Time  `json:"activity_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func DealStatusName(status int) string {
+	switch status {
+	case domain.DealStatusWon:
+		return "won"
+	case domain.DealStatusLost:
+		return "lost"
+	}
+
+	return "open"
+}
+
+func NewDealDTO(dm domain.Deal, dict IDict) DealDTO {
+	createdBy, _ := dict.FindUser(dm.CreatedBy)
+	companyFields, _ := dict.FindCompanyFields(dm.CompanyUUID)
+
+	fields := []TaskFieldDTO{}
+	for _, cf := range companyFields {
+		if v, ok := dm.Fields[cf.Hash]; ok {
+			fields = append(fields, TaskFieldDTO{
+				Hash:     cf.Hash,
+				Name:     cf.Name,
+				DataType: cf.DataType,
+				Value:    v,
+			})
+		}
+	}
+
+	return DealDTO{
+		UUID:        dm.UUID,
+		ID:          dm.ID,
+		Name:        dm.Name,
+		Description: dm.Description,
+		CreatedBy:   createdBy,
+
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+
+		Tags: dm.Tags,
+		Status: StatusDTO{
+			Code: dm.Status,
+			Name: DealStatusName(dm.Status),
+		},
+		Priority: dm.Priority,
+
+		StageUUID: dm.StageUUID,
+		Amount:    dm.Amount,
+
+		Fields: fields,
+
+		FinishedAt: dm.FinishedAt,
+		ActivityAt: dm.ActivityAt,
+		CreatedAt:  dm.CreatedAt,
+		UpdatedAt:  dm.UpdatedAt,
+	}
+}
+
+type DealStageDTO struct {
+	UUID        uuid.UUID   `json:"uuid"`
+	CompanyUUID uuid.UUID   `json:"company_uuid"`
+	Name        string      `json:"name"`
+	Color       string      `json:"color"`
+	Probability int         `json:"probability"`
+	Transitions []uuid.UUID `json:"transitions"`
+	Sort        int         `json:"sort"`
+}
+
+func NewDealStageDTO(dm domain.DealStage) DealStageDTO {
+	transitions := dm.Transitions
+	if transitions == nil {
+		transitions = []uuid.UUID{}
+	}
+
+	return DealStageDTO{
+		UUID:        dm.UUID,
+		CompanyUUID: dm.CompanyUUID,
+		Name:        dm.Name,
+		Color:       dm.Color,
+		Probability: dm.Probability,
+		Transitions: transitions,
+		Sort:        dm.Sort,
+	}
+}
+
+type DealBoardColumnDTO struct {
+	Stage    *DealStageDTO `json:"stage"`
+	Count    int64         `json:"count"`
+	Amount   float64       `json:"amount"`
+	Weighted float64       `json:"weighted"`
+	Items    []DealDTO     `json:"items"`
+}
+
+type DealBoardDTO struct {
+	Columns  []DealBoardColumnDTO `json:"columns"`
+	Count    int64                `json:"count"`
+	Amount   float64              `json:"amount"`
+	Weighted float64              `json:"weighted"`
+}
+
+func NewDealBoardDTO(dm domain.DealBoard, dict IDict) DealBoardDTO {
+	columns := make([]DealBoardColumnDTO, 0, len(dm.Columns))
+	for _, col := range dm.Columns {
+		var stage *DealStageDTO
+		if col.Stage != nil {
+			stageDTO := NewDealStageDTO(*col.Stage)
+			stage = &stageDTO
+		}
+
+		items := make([]DealDTO, 0, len(col.Deals))
+		for _, deal := range col.Deals {
+			items = append(items, NewDealDTO(deal, dict))
+		}
+
+		columns = append(columns, DealBoardColumnDTO{
+			Stage:    stage,
+			Count:    col.Count,
+			Amount:   col.Amount,
+			Weighted: col.Weighted,
+			Items:    items,
+		})
+	}
+
+	return DealBoardDTO{
+		Columns:  columns,
+		Count:    dm.Count,
+		Amount:   dm.Amount,
+		Weighted: dm.Weighted,
+	}
+}
+
+type DealSearchDTO struct {
+	FederationUUID uuid.UUID `json:"federation_uuid"`
+	CompanyUUID    uuid.UUID `json:"company_uuid"`
+
+	Name     *string   `json:"name"`
+	Status   *int      `json:"status"`
+	Tags     *[]string `json:"tags"`
+	MyEmail  *string   `json:"my_email"`
+	IsMy     *bool     `json:"is_my"`
+	Offset   *int      `json:"offset"`
+	Limit    *int      `json:"limit"`
+	Priority *int      `json:"priority"`
+
+	StageUUID *uuid.UUID `json:"stage_uuid"`
+
+	Fields []FilterDTO `json:"fields"`
+
+	Order *string `json:"order"`
+	By    *string `json:"by"`
+}
+
+func (d *DealSearchDTO) Validate() error {
+	if d.FederationUUID == uuid.Nil {
+		return errors.New("federation_uuid не может быть пустым")
+	}
+
+	if d.CompanyUUID == uuid.Nil {
+		return errors.New("company_uuid не может быть пустым")
+	}
+
+	return nil
+}
@@ -22,3 +22,21 @@ func NotFoundErr(msg string) NotFoundError {
 func NotFoundErrf(msg string, a ...interface{}) NotFoundError {
 	return NotFoundError{Err: fmt.Errorf(msg, a...)}
 }
+
+type ForbiddenError struct {
+	Err error
+}
+
+func (e ForbiddenError) Error() string {
+	return e.Err.Error()
+}
+
+func (e ForbiddenError) Unwrap() error { return e.Err }
+
+func ForbiddenErr(msg string) ForbiddenError {
+	return ForbiddenError{Err: errors.New(msg)}
+}
+
+func ForbiddenErrf(msg string, a ...interface{}) ForbiddenError {
+	return ForbiddenError{Err: fmt.Errorf(msg, a...)}
+}
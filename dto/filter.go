@@ -0,0 +1,209 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+)
+
+// FilterDTO - one condition of the `fields` query param.
+// Name is a custom field hash or a core column, "fields.<hash>" always means a custom field.
+type FilterDTO struct {
+	Name     string
+	Operator string
+	Value    interface{}
+
+	// Column and Condition are set by CheckFilters
+	Column    string
+	Condition *domain.FilterCondition
+}
+
+// NewFilterDTO parses {"name": value, "name": {"op": ">=", "value": 10}, ...}.
+// A plain value means equality, the legacy "@> [...]" string means contains.
+func NewFilterDTO(filter *string) (dtos []FilterDTO, err error) {
+	if filter == nil {
+		return dtos, err
+	}
+
+	var jsonMap map[string]interface{}
+	err = json.Unmarshal([]byte(*filter), &jsonMap)
+	if err != nil {
+		return dtos, err
+	}
+
+	for k, v := range jsonMap {
+		item := FilterDTO{
+			Name:  k,
+			Value: v,
+		}
+
+		switch value := v.(type) {
+		case map[string]interface{}:
+			op, ok := value["op"].(string)
+			if !ok {
+				return dtos, fmt.Errorf("не указан оператор фильтра: %s", k)
+			}
+
+			item.Operator = op
+			item.Value = value["value"]
+
+		case string:
+			if strings.HasPrefix(value, "@> [") && strings.HasSuffix(value, "]") {
+				var items []interface{}
+				err = json.Unmarshal([]byte(strings.TrimPrefix(value, "@> ")), &items)
+				if err != nil {
+					return dtos, err
+				}
+
+				item.Operator = domain.FilterContains
+				item.Value = items
+			}
+		}
+
+		dtos = append(dtos, item)
+	}
+
+	return dtos, err
+}
+
+// CheckFilters type-checks the filters against the core columns and the custom fields of the entity.
+func CheckFilters(filters []FilterDTO, columns, fields map[string]domain.FieldDataType, now time.Time) error {
+	for i := range filters {
+		f := &filters[i]
+
+		hash, custom := strings.CutPrefix(f.Name, "fields.")
+		dataType, found := columns[f.Name]
+
+		if custom || !found {
+			f.Name = hash
+			dataType, found = fields[hash]
+		} else {
+			f.Column = f.Name
+		}
+
+		if !found {
+			return fmt.Errorf("поле фильтра не найдено: %s", f.Name)
+		}
+
+		// a plain value of an array field keeps comparing with the stored json text, as before the operators
+		if f.Column == "" && f.Operator == "" && dataType.FilterKind() == domain.FilterKindArray {
+			continue
+		}
+
+		c, err := domain.NewFilterCondition(f.Operator, dataType, f.Value, now)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+
+		f.Condition = &c
+	}
+
+	return nil
+}
+
+// Where renders a checked filter. Column comes from the whitelist of CheckFilters, the rest are args.
+// A filter without a condition is the legacy equality with the text of the field.
+func (f FilterDTO) Where() (sql string, args []interface{}) {
+	c := f.Condition
+	if c == nil {
+		return "? = fields->>?", []interface{}{fmt.Sprintf("%v", f.Value), f.Name}
+	}
+
+	expr, exprArgs := f.expr()
+	args = append(args, exprArgs...)
+
+	switch c.Operator {
+	case domain.FilterIsNull, domain.FilterIsNotNull:
+		empty, twice := f.empty(expr)
+		if twice {
+			args = append(args, exprArgs...)
+		}
+
+		return lo.Ternary(c.Operator == domain.FilterIsNull, empty, "not "+empty), args
+
+	case domain.FilterNe:
+		return expr + " is distinct from ?", append(args, c.Values...)
+
+	case domain.FilterBetween, domain.FilterNotBetween:
+		if c.Kind != domain.FilterKindDate {
+			return expr + " " + c.Operator + " ? and ?", append(args, c.Values...)
+		}
+
+		// dates are half-open [from, to), as the relative ranges
+		args = append(append(append(args, c.Values[0]), exprArgs...), c.Values[1])
+		if c.Operator == domain.FilterBetween {
+			return "(" + expr + " >= ? and " + expr + " < ?)", args
+		}
+
+		return "(" + expr + " < ? or " + expr + " >= ?)", args
+
+	case domain.FilterIn, domain.FilterNotIn:
+		return expr + " " + c.Operator + " ?", append(args, c.Values)
+
+	case domain.FilterStartsWith:
+		return expr + " ilike ?", append(args, escapeLike(fmt.Sprintf("%v", c.Values[0]))+"%")
+
+	case domain.FilterContains:
+		if c.Kind != domain.FilterKindArray {
+			return expr + " ilike ?", append(args, "%"+escapeLike(fmt.Sprintf("%v", c.Values[0]))+"%")
+		}
+
+		if f.Column != "" {
+			return expr + " @> ?::text[]", append(args, pq.StringArray(lo.Map(c.Values, func(v interface{}, _ int) string {
+				return fmt.Sprintf("%v", v)
+			})))
+		}
+
+		js, _ := json.Marshal(c.Values)
+		return expr + " @> ?::jsonb", append(args, string(js))
+	}
+
+	return expr + " " + c.Operator + " ?", append(args, c.Values...)
+}
+
+func (f FilterDTO) expr() (string, []interface{}) {
+	if f.Column != "" {
+		return f.Column, nil
+	}
+
+	args := []interface{}{f.Name}
+
+	// a value of another format, stored before the field type changed, is null instead of failing the query
+	switch f.Condition.Kind {
+	case domain.FilterKindNumber:
+		return "safe_numeric(fields->>?)", args
+	case domain.FilterKindDate:
+		return "safe_timestamptz(fields->>?)", args
+	case domain.FilterKindBool:
+		return "safe_boolean(fields->>?)", args
+	case domain.FilterKindArray:
+		return "fields->?", args
+	}
+
+	return "fields->>?", args
+}
+
+// empty - empty strings and arrays count as no value, twice tells the expression is used two times.
+func (f FilterDTO) empty(expr string) (sql string, twice bool) {
+	switch f.Condition.Kind {
+	case domain.FilterKindText:
+		return "(" + expr + " is null or " + expr + " = '')", true
+	case domain.FilterKindArray:
+		if f.Column != "" {
+			return "(" + expr + " is null or cardinality(" + expr + ") = 0)", true
+		}
+
+		return "(" + expr + " is null or " + expr + " = '[]'::jsonb)", true
+	}
+
+	return expr + " is null", false
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
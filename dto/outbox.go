@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// OutboxMessageDTO - the value of a change published to kafka, payload is the row after the change.
+type OutboxMessageDTO struct {
+	UUID          uuid.UUID       `json:"uuid"`
+	Aggregate     string          `json:"aggregate"`
+	AggregateUUID uuid.UUID       `json:"aggregate_uuid"`
+	Operation     string          `json:"operation"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+func NewOutboxMessageDTO(dm domain.OutboxMessage) OutboxMessageDTO {
+	return OutboxMessageDTO{
+		UUID:          dm.UUID,
+		Aggregate:     dm.Aggregate,
+		AggregateUUID: dm.AggregateUUID,
+		Operation:     dm.Operation,
+		Payload:       dm.Payload,
+		CreatedAt:     dm.CreatedAt,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type PermissionDTO struct {
@@ -40,4 +41,71 @@ type PermissionRulesDTO struct {
 	TaskCreate bool `json:"task_create"`
 	TaskDelete bool `json:"task_delete"`
 	TaskPatch  bool `json:"task_patch"`
+
+	AgentCreate bool `json:"agent_create"`
+	AgentDelete bool `json:"agent_delete"`
+	AgentPatch  bool `json:"agent_patch"`
+
+	DealCreate bool `json:"deal_create"`
+	DealDelete bool `json:"deal_delete"`
+	DealPatch  bool `json:"deal_patch"`
+
+	TagPatch          bool `json:"tag_patch"`
+	CatalogPatch      bool `json:"catalog_patch"`
+	CatalogDataCreate bool `json:"catalog_data_create"`
+}
+
+type PermissionRoleDTO struct {
+	Name  string             `json:"name"`
+	Rules PermissionRulesDTO `json:"rules"`
+}
+
+func NewPermissionRoleDTO(dm domain.PermissionRole) PermissionRoleDTO {
+	return PermissionRoleDTO{
+		Name:  dm.Name,
+		Rules: PermissionRulesDTO(dm.Rules),
+	}
+}
+
+type PermissionRoleAssignmentDTO struct {
+	UUID           uuid.UUID `json:"uuid"`
+	UserUUID       uuid.UUID `json:"user_uuid"`
+	FederationUUID uuid.UUID `json:"federation_uuid"`
+	Scope          string    `json:"scope"`
+	EntityUUID     uuid.UUID `json:"entity_uuid"`
+
+	Role  string   `json:"role"`
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewPermissionRoleAssignmentDTO(dm domain.PermissionRoleAssignment) PermissionRoleAssignmentDTO {
+	return PermissionRoleAssignmentDTO{
+		UUID:           dm.UUID,
+		UserUUID:       dm.UserUUID,
+		FederationUUID: dm.FederationUUID,
+		Scope:          dm.Scope,
+		EntityUUID:     dm.EntityUUID,
+
+		Role:  dm.Role,
+		Allow: dm.Allow,
+		Deny:  dm.Deny,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+type EffectivePermissionsDTO struct {
+	UserUUID   uuid.UUID `json:"user_uuid"`
+	EntityUUID uuid.UUID `json:"entity_uuid"`
+	Scope      string    `json:"scope"`
+	Superuser  bool      `json:"superuser"`
+
+	Rules PermissionRulesDTO `json:"rules"`
 }
@@ -63,6 +63,13 @@ type ProjectOptionsDTO struct {
 	RequireDoneComment        *bool   `json:"require_done_comment"`
 	StatusEnable              *bool   `json:"status_enable"`
 	Color                     *string `json:"color"`
+	Private                   *bool   `json:"private"`
+	DeadlineLeadHours         *int    `json:"deadline_lead_hours"`
+	DeadlineEscalateHours     *int    `json:"deadline_escalate_hours"`
+}
+
+func (o *ProjectOptionsDTO) IsPrivate() bool {
+	return o != nil && o.Private != nil && *o.Private
 }
 
 type ProjectDTOs struct {
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type ReminderDTO struct {
@@ -18,7 +19,10 @@ type ReminderDTO struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
-	Status int `json:"status"`
+	Status  int        `json:"status"`
+	FiredAt *time.Time `json:"fired_at,omitempty"`
+
+	Recurrence *domain.ReminderRecurrence `json:"recurrence,omitempty"`
 
 	User      *UserDTO `json:"user,omitempty"`
 	CreatedBy *UserDTO `json:"created_by,omitempty"`
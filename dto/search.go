@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type SearchHitDTO struct {
+	Type        string     `json:"type"`
+	UUID        uuid.UUID  `json:"uuid"`
+	TaskUUID    *uuid.UUID `json:"task_uuid,omitempty"`
+	CatalogUUID *uuid.UUID `json:"catalog_uuid,omitempty"`
+	ProjectUUID *uuid.UUID `json:"project_uuid,omitempty"`
+	CompanyUUID uuid.UUID  `json:"company_uuid"`
+
+	Title    string  `json:"title"`
+	Headline string  `json:"headline"`
+	Rank     float64 `json:"rank"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewSearchHitDTO(dm domain.SearchHit) SearchHitDTO {
+	return SearchHitDTO{
+		Type:        dm.Type,
+		UUID:        dm.UUID,
+		TaskUUID:    dm.TaskUUID,
+		CatalogUUID: dm.CatalogUUID,
+		ProjectUUID: dm.ProjectUUID,
+		CompanyUUID: dm.CompanyUUID,
+
+		Title:    dm.Title,
+		Headline: dm.Headline,
+		Rank:     dm.Rank,
+
+		CreatedAt: dm.CreatedAt,
+	}
+}
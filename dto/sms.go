@@ -22,10 +22,18 @@ type SmsDTO struct {
 }
 
 type SmsFilterDTO struct {
-	CompanyUUID *uuid.UUID `json:"company_uuid"`
-	Offset      *int       `json:"offset"`
-	Limit       *int       `json:"limit"`
-	IsMy        *bool      `json:"is_my"`
-	Status      *int       `json:"status"`
-	MyEmail     *string    `json:"my_email"`
+	FederationUUID *uuid.UUID `json:"federation_uuid"`
+	CompanyUUID    *uuid.UUID `json:"company_uuid"`
+	Offset         *int       `json:"offset"`
+	Limit          *int       `json:"limit"`
+	IsMy           *bool      `json:"is_my"`
+	Status         *int       `json:"status"`
+	MyEmail        *string    `json:"my_email"`
+	// Phones - recipients normalized by domain.NormalizePhone
+	Phones []string `json:"phones"`
+	// CompanyUUIDs - sent from any of the companies
+	CompanyUUIDs []uuid.UUID `json:"company_uuids"`
+	// From, To - sent in [from, to)
+	From *time.Time `json:"from"`
+	To   *time.Time `json:"to"`
 }
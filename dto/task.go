@@ -1,9 +1,7 @@
 package dto
 
 import (
-	"encoding/json"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -79,6 +77,14 @@ type TaskDTO struct {
 	ChildrensTotal int         `json:"childrens_total"`
 	ChildrensUUID  []uuid.UUID `json:"childrens_uuid"`
 
+	Checklist ChecklistProgressDTO `json:"checklist"`
+
+	RecurrenceUUID *uuid.UUID `json:"recurrence_uuid,omitempty"`
+
+	Agents []uuid.UUID `json:"agents"`
+
+	Links []TaskLinkDTO `json:"links"`
+
 	// @todo: renaim
 	LinkedFieldsData map[uuid.UUID]interface{} `json:"linked_fields_data"`
 
@@ -133,6 +139,10 @@ type TaskDTOs struct {
 	DeletedAt  *time.Time `json:"deleted_at,omitempty" xlsx:"J" ru:"Удалено"`
 
 	ChildrensTotal int `json:"childrens_total"  xlsx:"J" ru:"Потомков"`
+
+	Checklist ChecklistProgressDTO `json:"checklist"`
+
+	RecurrenceUUID *uuid.UUID `json:"recurrence_uuid,omitempty"`
 }
 
 type TaskFieldDTO struct {
@@ -324,6 +334,12 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 		ChildrensTotal: dm.ChildrensTotal,
 		ChildrensUUID:  dm.ChildrensUUID,
 
+		Checklist: NewChecklistProgressDTO(dm.Checklist),
+
+		RecurrenceUUID: dm.RecurrenceUUID,
+
+		Agents: lo.Ternary(dm.Agents == nil, []uuid.UUID{}, dm.Agents),
+
 		LinkedFieldsData: linkedFieldsData,
 
 		Stops: dm.Stops,
@@ -391,6 +407,10 @@ func NewTaskDTOs(dm domain.Task, dict IDict) TaskDTOs {
 		FinishedAt:     dm.FinishedAt,
 		FinishTo:       dm.FinishTo,
 
+		Checklist: NewChecklistProgressDTO(dm.Checklist),
+
+		RecurrenceUUID: dm.RecurrenceUUID,
+
 		CreatedAt:  dm.CreatedAt,
 		ActivityAt: dm.ActivityAt,
 		UpdatedAt:  dm.UpdatedAt,
@@ -398,34 +418,6 @@ func NewTaskDTOs(dm domain.Task, dict IDict) TaskDTOs {
 	}
 }
 
-type FilterDTO struct {
-	Name     string
-	Operator string
-	Value    interface{}
-}
-
-func NewFilterDTO(filter *string) (dtos []FilterDTO, err error) {
-	if filter == nil {
-		return dtos, err
-	}
-
-	var jsonMap map[string]interface{}
-	err = json.Unmarshal([]byte(*filter), &jsonMap)
-	if err != nil {
-		return dtos, err
-	}
-
-	for k, v := range jsonMap {
-		dtos = append(dtos, FilterDTO{
-			Name:     k,
-			Operator: "=",
-			Value:    fmt.Sprintf("%v", v),
-		})
-	}
-
-	return dtos, err
-}
-
 type TaskSearchDTO struct {
 	MyEmail *string `json:"my_email"`
 
@@ -441,6 +433,14 @@ type TaskSearchDTO struct {
 	Tags           *[]string `json:"tags"`
 	Path           *string   `json:"path"`
 
+	// Overdue - open tasks past their finish_to, DueBefore and DueAfter bound finish_to as [after, before)
+	Overdue   *bool      `json:"overdue"`
+	DueBefore *time.Time `json:"due_before"`
+	DueAfter  *time.Time `json:"due_after"`
+
+	// VisibleTo limits a private project to the tasks the user participates in
+	VisibleTo *string `json:"visible_to"`
+
 	Fields []FilterDTO `json:"fields"`
 
 	Order *string `json:"order"`
@@ -456,5 +456,9 @@ func (d *TaskSearchDTO) Validate() error {
 		return errors.New("project_uuid не может быть пустым")
 	}
 
+	if d.DueBefore != nil && d.DueAfter != nil && !d.DueBefore.After(*d.DueAfter) {
+		return errors.New("due_before должен быть позже due_after")
+	}
+
 	return nil
 }
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+type TaskChecklistItemDTO struct {
+	UUID     uuid.UUID `json:"uuid"`
+	TaskUUID uuid.UUID `json:"task_uuid"`
+	Position int       `json:"position"`
+
+	Text       string     `json:"text"`
+	Done       bool       `json:"done"`
+	AssignedTo *UserDTO   `json:"assigned_to,omitempty"`
+	DueAt      *time.Time `json:"due_at,omitempty"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTaskChecklistItemDTO(dm domain.TaskChecklistItem, dict IDict) TaskChecklistItemDTO {
+	assignedTo, found := dict.FindUser(dm.AssignedTo)
+
+	return TaskChecklistItemDTO{
+		UUID:     dm.UUID,
+		TaskUUID: dm.TaskUUID,
+		Position: dm.Position,
+
+		Text:       dm.Text,
+		Done:       dm.Done,
+		AssignedTo: helpers.Empty(*assignedTo, found),
+		DueAt:      dm.DueAt,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+// ChecklistProgressDTO - done of total checklist items.
+type ChecklistProgressDTO struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+func NewChecklistProgressDTO(dm domain.ChecklistProgress) ChecklistProgressDTO {
+	return ChecklistProgressDTO{
+		Done:  dm.Done,
+		Total: dm.Total,
+	}
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type TaskImportDTO struct {
+	Total   int  `json:"total"`
+	Created int  `json:"created"`
+	DryRun  bool `json:"dry_run"`
+
+	Errors []TaskImportErrorDTO `json:"errors"`
+}
+
+type TaskImportErrorDTO struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+func NewTaskImportDTO(dm domain.TaskImportResult) TaskImportDTO {
+	return TaskImportDTO{
+		Total:   dm.Total,
+		Created: dm.Created,
+		DryRun:  dm.DryRun,
+
+		Errors: lo.Map(dm.Errors, func(e domain.TaskImportError, _ int) TaskImportErrorDTO {
+			return TaskImportErrorDTO{
+				Row:     e.Row,
+				Message: e.Message,
+			}
+		}),
+	}
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// TaskLinkDTO - Type is seen from the task the link is shown on: blocks, blocked_by, relates, duplicates or duplicated_by.
+type TaskLinkDTO struct {
+	UUID uuid.UUID `json:"uuid"`
+	Type string    `json:"type"`
+
+	TaskUUID    uuid.UUID `json:"task_uuid"`
+	TaskID      int       `json:"task_id"`
+	TaskName    string    `json:"task_name"`
+	TaskStatus  int       `json:"task_status"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewTaskLinkDTO(link domain.TaskLink, taskUUID uuid.UUID, other domain.Task) TaskLinkDTO {
+	return TaskLinkDTO{
+		UUID: link.UUID,
+		Type: link.TypeFor(taskUUID),
+
+		TaskUUID:    other.UUID,
+		TaskID:      other.ID,
+		TaskName:    other.Name,
+		TaskStatus:  other.Status,
+		ProjectUUID: other.ProjectUUID,
+
+		CreatedBy: link.CreatedBy,
+		CreatedAt: link.CreatedAt,
+	}
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type TaskRecurrenceDTO struct {
+	UUID         uuid.UUID           `json:"uuid"`
+	ProjectUUID  uuid.UUID           `json:"project_uuid"`
+	TaskUUID     *uuid.UUID          `json:"task_uuid,omitempty"`
+	TemplateUUID *uuid.UUID          `json:"template_uuid,omitempty"`
+	Vars         domain.TemplateVars `json:"vars"`
+
+	Schedule domain.TaskSchedule `json:"schedule"`
+	StartAt  time.Time           `json:"start_at"`
+
+	// NextAt - null when the series is over
+	NextAt     *time.Time `json:"next_at"`
+	Occurrence int        `json:"occurrence"`
+	Paused     bool       `json:"paused"`
+
+	// FinishOffset - minutes from the occurrence to finish_to of the task
+	FinishOffset *int `json:"finish_offset,omitempty"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTaskRecurrenceDTO(dm domain.TaskRecurrence) TaskRecurrenceDTO {
+	return TaskRecurrenceDTO{
+		UUID:         dm.UUID,
+		ProjectUUID:  dm.ProjectUUID,
+		TaskUUID:     dm.TaskUUID,
+		TemplateUUID: dm.TemplateUUID,
+		Vars:         dm.Vars,
+
+		Schedule: dm.Schedule,
+		StartAt:  dm.StartAt,
+
+		NextAt:     dm.NextAt,
+		Occurrence: dm.Occurrence,
+		Paused:     dm.Paused,
+
+		FinishOffset: dm.FinishOffset,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type TaskTemplateDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	CompanyUUID uuid.UUID  `json:"company_uuid"`
+	ProjectUUID *uuid.UUID `json:"project_uuid,omitempty"`
+	Name        string     `json:"name"`
+
+	Root domain.TaskTemplateNode `json:"root"`
+
+	// Placeholders - the vars to pass on instantiation
+	Placeholders []string `json:"placeholders"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTaskTemplateDTO(dm domain.TaskTemplate) TaskTemplateDTO {
+	return TaskTemplateDTO{
+		UUID:        dm.UUID,
+		CompanyUUID: dm.CompanyUUID,
+		ProjectUUID: dm.ProjectUUID,
+		Name:        dm.Name,
+
+		Root: dm.Root,
+
+		Placeholders: dm.Placeholders(),
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
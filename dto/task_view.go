@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type TaskViewDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	UserUUID    uuid.UUID `json:"user_uuid"`
+	Name        string    `json:"name"`
+	Shared      bool      `json:"shared"`
+
+	Filter  domain.TaskViewFilter `json:"filter"`
+	Order   *string               `json:"order,omitempty"`
+	By      *string               `json:"by,omitempty"`
+	Columns []string              `json:"columns"`
+	GroupBy *string               `json:"group_by,omitempty"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTaskViewDTO(dm domain.TaskView) TaskViewDTO {
+	columns := dm.Columns
+	if columns == nil {
+		columns = []string{}
+	}
+
+	return TaskViewDTO{
+		UUID:        dm.UUID,
+		ProjectUUID: dm.ProjectUUID,
+		UserUUID:    dm.UserUUID,
+		Name:        dm.Name,
+		Shared:      dm.Shared,
+
+		Filter:  dm.Filter,
+		Order:   dm.Order,
+		By:      dm.By,
+		Columns: columns,
+		GroupBy: dm.GroupBy,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+// ApplyView fills the params missing in the request from the stored view, the request params win.
+func (d *TaskSearchDTO) ApplyView(view domain.TaskView) error {
+	if view.ProjectUUID != d.ProjectUUID {
+		return errors.New("представление относится к другому проекту")
+	}
+
+	f := view.Filter
+
+	d.Name, _ = lo.Coalesce(d.Name, f.Name)
+	d.IsMy, _ = lo.Coalesce(d.IsMy, f.IsMy)
+	d.Status, _ = lo.Coalesce(d.Status, f.Status)
+	d.IsEpic, _ = lo.Coalesce(d.IsEpic, f.IsEpic)
+	d.Participated, _ = lo.Coalesce(d.Participated, f.Participated)
+	d.Tags, _ = lo.Coalesce(d.Tags, f.Tags)
+	d.Path, _ = lo.Coalesce(d.Path, f.Path)
+	d.Overdue, _ = lo.Coalesce(d.Overdue, f.Overdue)
+
+	if d.Order == nil {
+		d.Order = view.Order
+		d.By, _ = lo.Coalesce(d.By, view.By)
+	}
+
+	if len(d.Fields) == 0 {
+		fields, err := NewFilterDTO(f.Fields)
+		if err != nil {
+			return err
+		}
+
+		d.Fields = fields
+	}
+
+	return nil
+}
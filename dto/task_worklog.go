@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type TaskWorklogDTO struct {
+	UUID     uuid.UUID `json:"uuid"`
+	TaskUUID uuid.UUID `json:"task_uuid"`
+	UserUUID uuid.UUID `json:"user_uuid"`
+
+	StartedAt time.Time `json:"started_at"`
+	Duration  int       `json:"duration"`
+	Comment   string    `json:"comment"`
+	Billable  bool      `json:"billable"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTaskWorklogDTO(dm domain.TaskWorklog) TaskWorklogDTO {
+	return TaskWorklogDTO{
+		UUID:     dm.UUID,
+		TaskUUID: dm.TaskUUID,
+		UserUUID: dm.UserUUID,
+
+		StartedAt: dm.StartedAt,
+		Duration:  dm.Duration,
+		Comment:   dm.Comment,
+		Billable:  dm.Billable,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+// WorklogTotalDTO - minutes.
+type WorklogTotalDTO struct {
+	Duration int `json:"duration"`
+	Billable int `json:"billable"`
+}
+
+func NewWorklogTotalDTO(dm domain.WorklogTotal) WorklogTotalDTO {
+	return WorklogTotalDTO{
+		Duration: dm.Duration,
+		Billable: dm.Billable,
+	}
+}
+
+type TaskTimerDTO struct {
+	TaskUUID    uuid.UUID `json:"task_uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	StartedAt   time.Time `json:"started_at"`
+}
+
+func NewTaskTimerDTO(dm domain.TaskTimer) TaskTimerDTO {
+	return TaskTimerDTO{
+		TaskUUID:    dm.TaskUUID,
+		ProjectUUID: dm.ProjectUUID,
+		StartedAt:   dm.StartedAt,
+	}
+}
+
+// TimesheetRowDTO - a worklog with its task, the xlsx tags are the export columns.
+type TimesheetRowDTO struct {
+	UUID      uuid.UUID `json:"uuid"`
+	StartedAt time.Time `json:"started_at"`
+	Date      string    `json:"date" xlsx:"A" ru:"Дата"`
+
+	TaskUUID    uuid.UUID `json:"task_uuid"`
+	TaskID      int       `json:"task_id" xlsx:"B" ru:"Номер"`
+	TaskName    string    `json:"task_name" xlsx:"C" ru:"Задача"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	ProjectName string    `json:"project_name" xlsx:"D" ru:"Проект"`
+
+	Duration int     `json:"duration"`
+	Hours    float64 `json:"hours" xlsx:"E" ru:"Часы"`
+	Billable bool    `json:"billable" xlsx:"F" ru:"Оплачиваемое"`
+	Comment  string  `json:"comment" xlsx:"G" ru:"Комментарий"`
+
+	CreatedBy string `json:"created_by" xlsx:"H" ru:"Сотрудник"`
+}
+
+func NewTimesheetRowDTO(dm domain.TaskWorklog, task domain.Task, projectName string) TimesheetRowDTO {
+	return TimesheetRowDTO{
+		UUID:      dm.UUID,
+		StartedAt: dm.StartedAt,
+		Date:      dm.StartedAt.Format(time.DateOnly),
+
+		TaskUUID:    dm.TaskUUID,
+		TaskID:      task.ID,
+		TaskName:    task.Name,
+		ProjectUUID: dm.ProjectUUID,
+		ProjectName: projectName,
+
+		Duration: dm.Duration,
+		Hours:    math.Round(float64(dm.Duration)/60*100) / 100,
+		Billable: dm.Billable,
+		Comment:  dm.Comment,
+
+		CreatedBy: dm.CreatedBy,
+	}
+}
@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type WebhookDTO struct {
+	UUID           uuid.UUID  `json:"uuid"`
+	FederationUUID uuid.UUID  `json:"federation_uuid"`
+	CompanyUUID    *uuid.UUID `json:"company_uuid,omitempty"`
+	URL            string     `json:"url"`
+	Events         []string   `json:"events"`
+	Active         bool       `json:"active"`
+	Failures       int        `json:"failures"`
+	DisabledAt     *time.Time `json:"disabled_at,omitempty"`
+
+	// Secret - only in the response of the creation
+	Secret string `json:"secret,omitempty"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewWebhookDTO(dm domain.Webhook) WebhookDTO {
+	return WebhookDTO{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		URL:            dm.URL,
+		Events:         dm.Events,
+		Active:         dm.Active,
+		Failures:       dm.Failures,
+		DisabledAt:     dm.DisabledAt,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+type WebhookDeliveryDTO struct {
+	UUID        uuid.UUID       `json:"uuid"`
+	WebhookUUID uuid.UUID       `json:"webhook_uuid"`
+	Event       string          `json:"event"`
+	EventUUID   uuid.UUID       `json:"event_uuid"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      string          `json:"status"`
+
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+
+	ResponseCode int    `json:"response_code"`
+	ResponseBody string `json:"response_body"`
+	LastError    string `json:"last_error,omitempty"`
+	Duration     int    `json:"duration"`
+
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+}
+
+// NewWebhookDeliveryDTO - the response body of the receiver is shown to the federation operators only,
+// whatever an internal service answered is not to leak through the log.
+func NewWebhookDeliveryDTO(dm domain.WebhookDelivery, withResponse bool) WebhookDeliveryDTO {
+	if !withResponse {
+		dm.ResponseBody = ""
+	}
+
+	return WebhookDeliveryDTO{
+		UUID:        dm.UUID,
+		WebhookUUID: dm.WebhookUUID,
+		Event:       dm.Event,
+		EventUUID:   dm.EventUUID,
+		Payload:     dm.Payload,
+		Status:      dm.Status,
+
+		Attempts:      dm.Attempts,
+		NextAttemptAt: dm.NextAttemptAt,
+
+		ResponseCode: dm.ResponseCode,
+		ResponseBody: dm.ResponseBody,
+		LastError:    dm.LastError,
+		Duration:     dm.Duration,
+
+		CreatedAt:   dm.CreatedAt,
+		DeliveredAt: dm.DeliveredAt,
+	}
+}
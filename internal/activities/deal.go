@@ -0,0 +1,84 @@
+package activities
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+func (s *Service) GetDealActivities(dealUID uuid.UUID, limit, offset int) ([]domain.Activity, int64, error) {
+	orms, total, err := s.repo.GetEntityActivities("deal", dealUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lo.Map(orms, func(orm Activity, _ int) domain.Activity {
+		return domain.Activity{
+			UUID:        orm.UUID,
+			EntityUUID:  orm.EntityUUID,
+			EntityType:  orm.EntityType,
+			Description: orm.Description,
+			CreatedBy: domain.User{
+				UUID:  orm.CreatedByUUID,
+				Email: orm.CreatedBy,
+			},
+			CreatedAt: orm.CreatedAt,
+			Meta:      orm.Meta,
+			Type:      int(orm.Type),
+		}
+	}), total, nil
+}
+
+func (s *Service) DealWasCreated(creator domain.Creator, dealUID uuid.UUID, name string) (*Activity, error) {
+	return s.dealActivity(creator, dealUID, domain.ActivityDealCreated, dto.ActivityDealDTO{
+		Name: name,
+	})
+}
+
+func (s *Service) DealWasDeleted(creator domain.Creator, dealUID uuid.UUID, name string) (*Activity, error) {
+	return s.dealActivity(creator, dealUID, domain.ActivityDealWasDeleted, dto.ActivityDealDTO{
+		Name: name,
+	})
+}
+
+func (s *Service) DealWasChangedActivity(creator domain.Creator, dealUID uuid.UUID, field string, oldVal, newVal interface{}) (*Activity, error) {
+	if reflect.DeepEqual(oldVal, newVal) {
+		return nil, nil
+	}
+
+	return s.dealActivity(creator, dealUID, domain.ActivityDealField, dto.ActivityTaskFieldDTO{
+		Old:  oldVal,
+		New:  newVal,
+		Name: field,
+	})
+}
+
+func (s *Service) dealActivity(creator domain.Creator, dealUID uuid.UUID, tp domain.ActivityType, meta interface{}) (*Activity, error) {
+	mp, err := helpers.StructToMap(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    dealUID,
+		EntityType:    "deal",
+		Description:   fmt.Sprint(tp),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          tp,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
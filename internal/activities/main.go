@@ -1,6 +1,8 @@
 package activities
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/dictionary"
@@ -57,3 +59,31 @@ func (s *Service) GetTaskActivities(taskUID uuid.UUID, limit, offset int) ([]dom
 		}
 	}), total, nil
 }
+
+// GetTasksActivities returns activities of several tasks merged together, optionally in [from, to), newest first.
+func (s *Service) GetTasksActivities(taskUIDs []uuid.UUID, from, to *time.Time, limit, offset int) ([]domain.Activity, int64, error) {
+	if len(taskUIDs) == 0 {
+		return []domain.Activity{}, 0, nil
+	}
+
+	orms, total, err := s.repo.GetEntitiesActivities("task", taskUIDs, from, to, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lo.Map(orms, func(orm Activity, _ int) domain.Activity {
+		return domain.Activity{
+			UUID:        orm.UUID,
+			EntityUUID:  orm.EntityUUID,
+			EntityType:  orm.EntityType,
+			Description: orm.Description,
+			CreatedBy: domain.User{
+				UUID:  orm.CreatedByUUID,
+				Email: orm.CreatedBy,
+			},
+			CreatedAt: orm.CreatedAt,
+			Meta:      orm.Meta,
+			Type:      int(orm.Type),
+		}
+	}), total, nil
+}
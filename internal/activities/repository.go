@@ -1,6 +1,8 @@
 package activities
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/pkg/postgres"
 )
@@ -36,3 +38,49 @@ func (r *Repository) GetTaskActivities(taskUID uuid.UUID, limit, offset int) (or
 
 	return orms, total, err
 }
+
+func (r *Repository) GetEntityActivities(entityType string, uid uuid.UUID, limit, offset int) (orms []Activity, total int64, err error) {
+	err = r.gorm.DB.
+		Select("*, count(*) OVER() AS total").
+		Where("entity_uuid = ?", uid).
+		Where("entity_type = ?", entityType).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&orms).
+		Error
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	return orms, total, err
+}
+
+func (r *Repository) GetEntitiesActivities(entityType string, uids []uuid.UUID, from, to *time.Time, limit, offset int) (orms []Activity, total int64, err error) {
+	query := r.gorm.DB.
+		Select("*, count(*) OVER() AS total").
+		Where("entity_uuid in ?", uids).
+		Where("entity_type = ?", entityType)
+
+	if from != nil {
+		query = query.Where("created_at >= ?", *from)
+	}
+
+	if to != nil {
+		query = query.Where("created_at < ?", *to)
+	}
+
+	err = query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&orms).
+		Error
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	return orms, total, err
+}
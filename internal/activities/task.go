@@ -219,3 +219,75 @@ func (s *Service) TaskFileWasDeleted(creator domain.Creator, taskUUID uuid.UUID,
 
 	return act, nil
 }
+
+// TaskWasLinked - the link is logged on both tasks, each one with the type as seen from it.
+func (s *Service) TaskWasLinked(creator domain.Creator, link domain.TaskLink, from, to domain.Task) error {
+	return s.taskLinkActivity(creator, domain.ActivityTaskLinked, link, from, to)
+}
+
+func (s *Service) TaskWasUnlinked(creator domain.Creator, link domain.TaskLink, from, to domain.Task) error {
+	return s.taskLinkActivity(creator, domain.ActivityTaskUnlinked, link, from, to)
+}
+
+func (s *Service) taskLinkActivity(creator domain.Creator, tp domain.ActivityType, link domain.TaskLink, from, to domain.Task) error {
+	for _, pair := range [][2]domain.Task{{from, to}, {to, from}} {
+		task, other := pair[0], pair[1]
+
+		mp, err := helpers.StructToMap(dto.ActivityTaskLinkDTO{
+			Type:     link.TypeFor(task.UUID),
+			TaskUUID: other.UUID,
+			TaskID:   other.ID,
+			TaskName: other.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.CreateActivity(&Activity{
+			UUID:          uuid.New(),
+			EntityUUID:    task.UUID,
+			EntityType:    "task",
+			Description:   fmt.Sprint(tp),
+			CreatedByUUID: creator.UUID,
+			CreatedBy:     creator.Email,
+			Type:          tp,
+			Meta:          mp,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TaskChecklistChanged - the subtask is set when the item was converted to it.
+func (s *Service) TaskChecklistChanged(creator domain.Creator, action string, item domain.TaskChecklistItem, subtask *domain.Task) error {
+	meta := dto.ActivityTaskChecklistDTO{
+		Action:   action,
+		ItemUUID: item.UUID,
+		Text:     item.Text,
+		Done:     item.Done,
+	}
+
+	if subtask != nil {
+		meta.TaskUUID = &subtask.UUID
+		meta.TaskID = &subtask.ID
+	}
+
+	mp, err := helpers.StructToMap(meta)
+	if err != nil {
+		return err
+	}
+
+	return s.CreateActivity(&Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    item.TaskUUID,
+		EntityType:    "task",
+		Description:   fmt.Sprint(domain.ActivityTaskChecklist),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          domain.ActivityTaskChecklist,
+		Meta:          mp,
+	})
+}
@@ -0,0 +1,257 @@
+package agents
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// ReadRows reads the first sheet of a xlsx file or a csv file separated by comma or semicolon.
+func ReadRows(r io.Reader, filename string) ([][]string, error) {
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(filename), ".")) {
+	case FormatXLSX:
+		f, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return f.GetRows(f.GetSheetName(0))
+	case FormatCSV:
+		br := bufio.NewReader(r)
+
+		head, err := br.Peek(4096)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		if bytes.HasPrefix(head, []byte("\xef\xbb\xbf")) {
+			_, _ = br.Discard(3)
+			head = head[3:]
+		}
+
+		firstLine, _, _ := bytes.Cut(head, []byte("\n"))
+
+		cr := csv.NewReader(br)
+		cr.FieldsPerRecord = -1
+		cr.LazyQuotes = true
+		if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+			cr.Comma = ';'
+		}
+
+		return cr.ReadAll()
+	}
+
+	return nil, fmt.Errorf("неподдерживаемый формат файла %s, нужен csv или xlsx", filename)
+}
+
+// Import upserts agents keyed by contact: a row sharing any contact with an existing agent
+// adds its new contacts to that agent, otherwise a new agent is created. The same rows
+// give the same result on a second run. In dry-run mode nothing is stored.
+func (s *Service) Import(_ context.Context, federationUUID uuid.UUID, companyUUID *uuid.UUID, me domain.Me, rows [][]string, mapping map[string]string, dryRun bool) (res domain.AgentImportResult, err error) {
+	res.DryRun = dryRun
+	res.Errors = []domain.AgentImportError{}
+
+	if len(rows) == 0 {
+		return res, domain.ErrAgentImportNoHeader
+	}
+
+	if len(rows)-1 > domain.AgentImportMaxRows {
+		return res, domain.ErrAgentImportTooLarge
+	}
+
+	columns, err := domain.NewAgentColumns(rows[0], mapping)
+	if err != nil {
+		return res, err
+	}
+
+	agents, err := s.repo.GetFederationAgents(federationUUID)
+	if err != nil {
+		return res, err
+	}
+
+	existing := len(agents)
+	index := make(map[string]int)
+	addToIndex := func(i int) {
+		for _, c := range agents[i].Contacts {
+			if _, ok := index[c.Key()]; !ok {
+				index[c.Key()] = i
+			}
+		}
+	}
+
+	for i := range agents {
+		addToIndex(i)
+	}
+
+	updated := make(map[int]bool)
+
+	for n, row := range rows[1:] {
+		if len(lo.WithoutEmpty(lo.Map(row, func(cell string, _ int) string { return strings.TrimSpace(cell) }))) == 0 {
+			continue
+		}
+
+		res.Total++
+
+		dm, err := domain.NewAgentFromRow(federationUUID, companyUUID, me, row, columns)
+		if err != nil {
+			res.Errors = append(res.Errors, domain.AgentImportError{
+				Row:     n + 2,
+				Message: err.Error(),
+			})
+
+			continue
+		}
+
+		found := -1
+		for _, c := range dm.Contacts {
+			if i, ok := index[c.Key()]; ok {
+				found = i
+				break
+			}
+		}
+
+		if found == -1 {
+			agents = append(agents, *dm)
+			addToIndex(len(agents) - 1)
+			res.Created++
+
+			continue
+		}
+
+		before := len(agents[found].Contacts)
+		agents[found].MergeContacts(*dm)
+
+		if len(agents[found].Contacts) == before {
+			res.Skipped++
+			continue
+		}
+
+		addToIndex(found)
+		res.Updated++
+
+		if found < existing {
+			updated[found] = true
+		}
+	}
+
+	if dryRun {
+		return res, nil
+	}
+
+	updates := []domain.Agent{}
+	for i := range updated {
+		updates = append(updates, agents[i])
+	}
+
+	err = s.repo.Import(agents[existing:], updates)
+
+	return res, err
+}
+
+// Export writes every agent matching the filter, one column per contact type.
+// Several contacts of the same type are joined by comma, so the file can be imported back.
+func (s *Service) Export(ctx context.Context, filter domain.AgentFilter, format string, w io.Writer) error {
+	types, err := s.repo.GetContactTypes(filter)
+	if err != nil {
+		return err
+	}
+
+	header := append([]string{domain.AgentColumnName}, types...)
+
+	var (
+		writeRow func(row []string) error
+		flush    func() error
+	)
+
+	switch format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		writeRow = cw.Write
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	case FormatXLSX:
+		f := excelize.NewFile()
+		defer f.Close()
+
+		sw, err := f.NewStreamWriter(f.GetSheetName(0))
+		if err != nil {
+			return err
+		}
+
+		line := 0
+		writeRow = func(row []string) error {
+			line++
+
+			cell, err := excelize.CoordinatesToCellName(1, line)
+			if err != nil {
+				return err
+			}
+
+			return sw.SetRow(cell, lo.Map(row, func(v string, _ int) interface{} { return v }))
+		}
+		flush = func() error {
+			if err := sw.Flush(); err != nil {
+				return err
+			}
+
+			return f.Write(w)
+		}
+	default:
+		return fmt.Errorf("неподдерживаемый формат %s, нужен csv или xlsx", format)
+	}
+
+	if err := writeRow(header); err != nil {
+		return err
+	}
+
+	limit := 500
+	filter.Limit = &limit
+
+	for offset := 0; ; offset += limit {
+		filter.Offset = lo.ToPtr(offset)
+
+		dms, _, err := s.repo.Get(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, dm := range dms {
+			row := make([]string, len(header))
+			row[0] = dm.Name
+
+			for i, tp := range types {
+				row[i+1] = strings.Join(lo.FilterMap(dm.Contacts, func(c domain.AgentContacts, _ int) (string, bool) {
+					return c.Val, c.Type == tp
+				}), ", ")
+			}
+
+			if err := writeRow(row); err != nil {
+				return err
+			}
+		}
+
+		if len(dms) < limit {
+			break
+		}
+	}
+
+	return flush()
+}
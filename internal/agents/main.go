@@ -2,9 +2,12 @@ package agents
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
 )
 
 func New(repo *Repository) *Service {
@@ -23,6 +26,29 @@ func (s *Service) Get(ctx context.Context, filter domain.AgentFilter) ([]domain.
 	return s.repo.Get(ctx, filter)
 }
 
+func (s *Service) GetByUUID(_ context.Context, uid uuid.UUID) (domain.Agent, error) {
+	return s.repo.GetByUUID(uid)
+}
+
+// CheckFederationAgents makes sure every agent exists and belongs to the federation.
+func (s *Service) CheckFederationAgents(_ context.Context, federationUUID uuid.UUID, uids []uuid.UUID) error {
+	uids = lo.Uniq(uids)
+	if len(uids) == 0 {
+		return nil
+	}
+
+	count, err := s.repo.CountFederationAgents(federationUUID, uids)
+	if err != nil {
+		return err
+	}
+
+	if count != int64(len(uids)) {
+		return dto.NotFoundErr("агент не найден")
+	}
+
+	return nil
+}
+
 func (s *Service) Delete(_ context.Context, uid uuid.UUID) error {
 	return s.repo.Delete(uid)
 }
@@ -30,3 +56,55 @@ func (s *Service) Delete(_ context.Context, uid uuid.UUID) error {
 func (s *Service) Update(_ context.Context, a *domain.Agent) error {
 	return s.repo.Update(a)
 }
+
+// GetDuplicates groups agents of the federation matched by phone, email or a similar name.
+func (s *Service) GetDuplicates(_ context.Context, federationUUID uuid.UUID) ([]domain.AgentDuplicates, error) {
+	dms, err := s.repo.GetFederationAgents(federationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.GroupAgentDuplicates(dms), nil
+}
+
+// Merge moves contacts and task links of merged agents to the survivor and deletes merged agents.
+// Returns the survivor and uuids of tasks whose agents were changed.
+func (s *Service) Merge(_ context.Context, federationUUID, survivorUUID uuid.UUID, merged []uuid.UUID) (survivor domain.Agent, taskUUIDs []uuid.UUID, err error) {
+	merged = lo.Uniq(merged)
+	if len(merged) == 0 {
+		return survivor, nil, errors.New("не выбраны агенты для объединения")
+	}
+
+	if lo.Contains(merged, survivorUUID) {
+		return survivor, nil, errors.New("агент не может быть объединен сам с собой")
+	}
+
+	survivor, err = s.repo.GetByUUID(survivorUUID)
+	if err != nil {
+		return survivor, nil, err
+	}
+
+	if survivor.FederationUUID != federationUUID {
+		return survivor, nil, dto.NotFoundErr("агент не найден")
+	}
+
+	others := []domain.Agent{}
+	for _, uid := range merged {
+		other, err := s.repo.GetByUUID(uid)
+		if err != nil {
+			return survivor, nil, err
+		}
+
+		if other.FederationUUID != federationUUID {
+			return survivor, nil, dto.NotFoundErr("агент не найден")
+		}
+
+		others = append(others, other)
+	}
+
+	survivor.MergeContacts(others...)
+
+	taskUUIDs, err = s.repo.Merge(survivor, merged)
+
+	return survivor, taskUUIDs, err
+}
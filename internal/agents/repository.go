@@ -3,6 +3,7 @@ package agents
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -25,21 +26,65 @@ func NewRepository(db *postgres.GDB) *Repository {
 }
 
 func (r *Repository) Create(s *domain.Agent) error {
-	return r.gorm.DB.Create(&Agent{
-		UUID:           s.UUID,
-		FederationUUID: s.FederationUUID,
-		CompanyUUID:    s.CompanyUUID,
-		CreatedBy:      s.CreatedBy,
-		CreatedByUUID:  s.CreatedByUUID,
+	orm := toORM(s)
 
-		Name: s.Name,
-		Contacts: lo.Map(s.Contacts, func(c domain.AgentContacts, _ int) Contacts {
-			return Contacts{
-				Type: c.Type,
-				Val:  c.Val,
+	return r.gorm.DB.Create(&orm).Error
+}
+
+// Import creates and updates agents in one transaction.
+func (r *Repository) Import(creates, updates []domain.Agent) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		orms := lo.Map(creates, func(dm domain.Agent, _ int) Agent {
+			return toORM(&dm)
+		})
+
+		if len(orms) > 0 {
+			err := tx.CreateInBatches(&orms, 500).Error
+			if err != nil {
+				return err
 			}
-		}),
-	}).Error
+		}
+
+		for _, dm := range updates {
+			err := tx.Model(&Agent{}).
+				Where("uuid = ?", dm.UUID).
+				Where("deleted_at is null").
+				Updates(map[string]interface{}{
+					"contacts":   toORM(&dm).Contacts,
+					"updated_at": gorm.Expr("now()"),
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetContactTypes returns distinct contact types of agents matching the filter.
+func (r *Repository) GetContactTypes(filter domain.AgentFilter) (types []string, err error) {
+	query := r.gorm.DB.
+		Table("agents, jsonb_array_elements(agents.contacts) c").
+		Where("agents.federation_uuid = ?", filter.FederationUUID).
+		Where("agents.deleted_at is null").
+		Where("jsonb_typeof(agents.contacts) = 'array'")
+
+	if filter.CompanyUUID != nil {
+		query = query.Where("agents.company_uuid = ?", *filter.CompanyUUID)
+	}
+
+	if filter.Name != nil {
+		query = query.Where("agents.name ilike ?", *filter.Name+"%")
+	}
+
+	err = query.
+		Distinct().
+		Order("c->>'type'").
+		Pluck("c->>'type'", &types).
+		Error
+
+	return types, err
 }
 
 func (r *Repository) Get(_ context.Context, filter domain.AgentFilter) (dms []domain.Agent, total int64, err error) {
@@ -96,29 +141,176 @@ func (r *Repository) Get(_ context.Context, filter domain.AgentFilter) (dms []do
 	}
 
 	dms = helpers.Map(orms, func(item Agent, i int) domain.Agent {
-		return domain.Agent{
-			UUID:           item.UUID,
-			FederationUUID: item.FederationUUID,
-			CompanyUUID:    item.CompanyUUID,
+		return toDomain(item)
+	})
 
-			CreatedBy:     item.CreatedBy,
-			CreatedByUUID: item.CreatedByUUID,
+	return dms, total, nil
+}
 
-			Name: item.Name,
-			Contacts: lo.Map(item.Contacts, func(c Contacts, _ int) domain.AgentContacts {
-				return domain.AgentContacts{
-					Type: c.Type,
-					Val:  c.Val,
-				}
-			}),
+func (r *Repository) GetByUUID(uid uuid.UUID) (dm domain.Agent, err error) {
+	orm := Agent{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("агент не найден")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return toDomain(orm), nil
+}
+
+// CountFederationAgents returns how many of uids are alive agents of the federation.
+func (r *Repository) CountFederationAgents(federationUUID uuid.UUID, uids []uuid.UUID) (count int64, err error) {
+	err = r.gorm.DB.
+		Model(&Agent{}).
+		Where("uuid in ?", uids).
+		Where("federation_uuid = ?", federationUUID).
+		Where("deleted_at is null").
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+// GetFederationAgents returns all alive agents of the federation, oldest first.
+func (r *Repository) GetFederationAgents(federationUUID uuid.UUID) (dms []domain.Agent, err error) {
+	orms := []Agent{}
+
+	err = r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&orms).
+		Error
+
+	return lo.Map(orms, func(item Agent, _ int) domain.Agent {
+		return toDomain(item)
+	}), err
+}
+
+// Merge stores the survivor contacts, re-points tasks from merged agents to the survivor
+// and soft deletes merged agents. Returns uuids of the re-pointed tasks.
+func (r *Repository) Merge(survivor domain.Agent, merged []uuid.UUID) (taskUUIDs []uuid.UUID, err error) {
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		contacts := ContactsArray(lo.Map(survivor.Contacts, func(c domain.AgentContacts, _ int) Contacts {
+			return Contacts{
+				Type: c.Type,
+				Val:  c.Val,
+			}
+		}))
+
+		res := tx.Model(&Agent{}).
+			Where("uuid = ?", survivor.UUID).
+			Where("deleted_at is null").
+			Updates(map[string]interface{}{
+				"contacts":   contacts,
+				"updated_at": gorm.Expr("now()"),
+			})
+
+		if res.Error != nil {
+			return res.Error
+		}
 
-			CreatedAt: item.CreatedAt,
-			UpdatedAt: item.UpdatedAt,
-			DeletedAt: item.DeletedAt,
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("агент не найден")
 		}
+
+		rows := []struct {
+			UUID uuid.UUID
+		}{}
+
+		err := tx.Raw(`UPDATE tasks SET
+				agents = array(select distinct a from unnest(agents || ?::uuid) a where a <> all(?::uuid[])),
+				updated_at = now()
+			WHERE agents && ?::uuid[] RETURNING uuid`,
+			survivor.UUID, uuidArray(merged), uuidArray(merged)).
+			Scan(&rows).
+			Error
+		if err != nil {
+			return err
+		}
+
+		taskUUIDs = lo.Map(rows, func(row struct{ UUID uuid.UUID }, _ int) uuid.UUID {
+			return row.UUID
+		})
+
+		res = tx.Model(&Agent{}).
+			Where("uuid in ?", merged).
+			Where("federation_uuid = ?", survivor.FederationUUID).
+			Where("deleted_at is null").
+			Updates(map[string]interface{}{
+				"deleted_at": gorm.Expr("now()"),
+				"meta":       gorm.Expr("meta || jsonb_build_object('merged_into', ?::text)", survivor.UUID),
+			})
+
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected != int64(len(merged)) {
+			return dto.NotFoundErr("агент не найден")
+		}
+
+		return nil
 	})
 
-	return dms, total, nil
+	return taskUUIDs, err
+}
+
+func uuidArray(uids []uuid.UUID) string {
+	return "{" + strings.Join(lo.Map(uids, func(uid uuid.UUID, _ int) string {
+		return uid.String()
+	}), ",") + "}"
+}
+
+func toORM(s *domain.Agent) Agent {
+	return Agent{
+		UUID:           s.UUID,
+		FederationUUID: s.FederationUUID,
+		CompanyUUID:    s.CompanyUUID,
+		CreatedBy:      s.CreatedBy,
+		CreatedByUUID:  s.CreatedByUUID,
+
+		Name: s.Name,
+		Contacts: lo.Map(s.Contacts, func(c domain.AgentContacts, _ int) Contacts {
+			return Contacts{
+				Type: c.Type,
+				Val:  c.Val,
+			}
+		}),
+	}
+}
+
+func toDomain(item Agent) domain.Agent {
+	return domain.Agent{
+		UUID:           item.UUID,
+		FederationUUID: item.FederationUUID,
+		CompanyUUID:    item.CompanyUUID,
+		ProjectUUID:    item.ProjectUUID,
+
+		CreatedBy:     item.CreatedBy,
+		CreatedByUUID: item.CreatedByUUID,
+
+		Name: item.Name,
+		Contacts: lo.Map(item.Contacts, func(c Contacts, _ int) domain.AgentContacts {
+			return domain.AgentContacts{
+				Type: c.Type,
+				Val:  c.Val,
+			}
+		}),
+
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+		DeletedAt: item.DeletedAt,
+	}
 }
 
 func (r *Repository) Update(s *domain.Agent) error {
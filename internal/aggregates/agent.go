@@ -0,0 +1,145 @@
+package aggregates
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// agentTimelineTasks - the newest linked tasks the timeline is built of.
+const agentTimelineTasks = 1000
+
+// GetAgentTimeline merges linked tasks, their comments and activities and sms sent to the agent phones,
+// optionally in [from, to). Every source is read up to offset+limit newest items, so the merged page is exact.
+// Only the tasks and sms of the given companies are read, the tasks the visible func rejects
+// are left out together with their comments and activities.
+func (s *Service) GetAgentTimeline(ctx context.Context, federationUUID, agentUUID uuid.UUID, companyUUIDs []uuid.UUID, from, to *time.Time, limit, offset int, visible func(domain.Task) bool) (items []domain.AgentTimelineItem, total int64, err error) {
+	agent, err := s.ags.GetByUUID(ctx, agentUUID)
+	if err != nil {
+		return items, 0, err
+	}
+
+	if agent.FederationUUID != federationUUID {
+		return items, 0, dto.NotFoundErr("агент не найден")
+	}
+
+	window := offset + limit
+
+	// a task created before from may still have comments and activities inside the window
+	tasks, err := s.ts.GetAgentTasks(ctx, agent.UUID, companyUUIDs, to, agentTimelineTasks)
+	if err != nil {
+		return items, 0, err
+	}
+
+	tasks = lo.Filter(tasks, func(t domain.Task, _ int) bool {
+		return visible(t)
+	})
+
+	taskNames := make(map[uuid.UUID]string, len(tasks))
+	taskUUIDs := lo.Map(tasks, func(t domain.Task, _ int) uuid.UUID {
+		taskNames[t.UUID] = t.Name
+		return t.UUID
+	})
+
+	created := lo.Filter(tasks, func(t domain.Task, _ int) bool {
+		return from == nil || !t.CreatedAt.Before(*from)
+	})
+
+	total = int64(len(created))
+
+	taskItems := lo.Map(created[:min(window, len(created))], func(t domain.Task, _ int) domain.AgentTimelineItem {
+		return domain.AgentTimelineItem{
+			Type:      domain.AgentTimelineTask,
+			UUID:      t.UUID,
+			TaskUUID:  lo.ToPtr(t.UUID),
+			Title:     t.Name,
+			CreatedBy: t.CreatedBy,
+			Meta: map[string]interface{}{
+				"id":           t.ID,
+				"status":       t.Status,
+				"project_uuid": t.ProjectUUID,
+				"finished_at":  t.FinishedAt,
+			},
+			CreatedAt: t.CreatedAt,
+		}
+	})
+
+	taskComments, commentsTotal, err := s.cs.GetTasksComments(taskUUIDs, from, to, window, 0)
+	if err != nil {
+		return items, 0, err
+	}
+
+	total += commentsTotal
+
+	commentItems := lo.Map(taskComments, func(c domain.Comment, _ int) domain.AgentTimelineItem {
+		return domain.AgentTimelineItem{
+			Type:      domain.AgentTimelineComment,
+			UUID:      c.UUID,
+			TaskUUID:  lo.ToPtr(c.TaskUUID),
+			Title:     taskNames[c.TaskUUID],
+			Text:      c.Comment,
+			CreatedBy: c.CreatedBy,
+			CreatedAt: c.CreatedAt,
+		}
+	})
+
+	taskActivities, activitiesTotal, err := s.as.GetTasksActivities(taskUUIDs, from, to, window, 0)
+	if err != nil {
+		return items, 0, err
+	}
+
+	total += activitiesTotal
+
+	activityItems := lo.Map(taskActivities, func(a domain.Activity, _ int) domain.AgentTimelineItem {
+		meta := lo.Assign(map[string]interface{}{}, a.Meta)
+		meta["type"] = a.Type
+
+		return domain.AgentTimelineItem{
+			Type:      domain.AgentTimelineActivity,
+			UUID:      a.UUID,
+			TaskUUID:  lo.ToPtr(a.EntityUUID),
+			Title:     taskNames[a.EntityUUID],
+			Text:      a.Description,
+			CreatedBy: a.CreatedBy.Email,
+			Meta:      meta,
+			CreatedAt: a.CreatedAt,
+		}
+	})
+
+	smsItems := []domain.AgentTimelineItem{}
+	if phones := agent.Phones(); len(phones) > 0 && len(companyUUIDs) > 0 {
+		sent, smsTotal, err := s.ss.GetSms(ctx, dto.SmsFilterDTO{
+			FederationUUID: &agent.FederationUUID,
+			CompanyUUIDs:   companyUUIDs,
+			Phones:         phones,
+			Limit:          &window,
+			Offset:         lo.ToPtr(0),
+			From:           from,
+			To:             to,
+		})
+		if err != nil {
+			return items, 0, err
+		}
+
+		total += smsTotal
+
+		smsItems = lo.Map(sent, func(m domain.Sms, _ int) domain.AgentTimelineItem {
+			return domain.AgentTimelineItem{
+				Type:      domain.AgentTimelineSms,
+				UUID:      m.UUID,
+				Title:     m.To,
+				Text:      m.Text,
+				CreatedBy: m.CreatedBy,
+				CreatedAt: m.CreatedAt,
+			}
+		})
+	}
+
+	items = domain.MergeTimeline(limit, offset, taskItems, commentItems, activityItems, smsItems)
+
+	return items, total, nil
+}
@@ -6,12 +6,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/activities"
+	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/profile"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
 	"github.com/samber/lo"
 )
@@ -24,6 +27,9 @@ type Service struct {
 	s3ps              *s3.ServicePrivate
 	rm                *reminders.Service
 	federationService *federation.Service
+	as                *activities.Service
+	ss                *sms.Service
+	ags               *agents.Service
 }
 
 func New(
@@ -34,6 +40,9 @@ func New(
 	s3ps *s3.ServicePrivate,
 	rm *reminders.Service,
 	federationService *federation.Service,
+	as *activities.Service,
+	ss *sms.Service,
+	ags *agents.Service,
 ) *Service {
 	return &Service{
 		dictionaryService: dictionaryService,
@@ -43,6 +52,9 @@ func New(
 		s3ps:              s3ps,
 		rm:                rm,
 		federationService: federationService,
+		as:                as,
+		ss:                ss,
+		ags:               ags,
 	}
 }
 
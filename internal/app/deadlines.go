@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/sirupsen/logrus"
+)
+
+const deadlinesBatchSize = 100
+
+var deadlineSubjects = map[int]string{
+	domain.DeadlineApproaching: "Приближается срок задачи",
+	domain.DeadlineOverdue:     "Задача просрочена",
+	domain.DeadlineEscalated:   "Задача просрочена, требуется вмешательство",
+}
+
+// FireDeadlinesByTimeout periodically notifies the task people about approaching and passed deadlines.
+// Every replica scans, but a stage is notified only by the replica that moved the task to it.
+func (a *App) FireDeadlinesByTimeout(ctx context.Context) {
+	scanTime := time.Second * time.Duration(a.Options.DEADLINES_SCAN_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(scanTime)
+				a.FireDeadlinesByTimeout(ctx)
+			}
+		}()
+
+		for {
+			a.FireDeadlines()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(scanTime):
+			}
+		}
+	}()
+}
+
+func (a *App) FireDeadlines() {
+	now := time.Now()
+
+	dms, err := a.TaskService.GetDeadlineTasks(now, deadlinesBatchSize)
+	if err != nil {
+		logrus.WithError(err).Error("get deadline tasks error")
+		return
+	}
+
+	for _, t := range dms {
+		stage := domain.DeadlineStage(*t.FinishTo, t.ProjectOptions, now)
+		if stage <= t.Stage {
+			continue
+		}
+
+		// mark first: a failed delivery channel must not lead to duplicates on the next scan
+		marked, err := a.TaskService.MarkDeadline(t.UUID, stage)
+		if err != nil {
+			logrus.WithField("task", t.UUID).WithError(err).Error("deadline mark error")
+			continue
+		}
+
+		if !marked {
+			continue
+		}
+
+		a.Events.Publish(context.Background(), domain.TaskDeadline{
+			EventMeta: domain.NewEventMeta("", t.UUID, t.Recipients(stage)),
+			Stage:     stage,
+			ID:        t.ID,
+			Name:      t.Name,
+			FinishTo:  *t.FinishTo,
+		})
+	}
+}
+
+func (a *App) DeliverDeadline(e domain.TaskDeadline) {
+	l := logrus.WithField("task", e.TaskUUID).WithField("stage", e.Stage)
+
+	people := a.taskViewers(e.TaskUUID, e.People)
+	if len(people) == 0 {
+		return
+	}
+
+	err := a.NotificationsService.CreateTaskState(e, people)
+	if err != nil {
+		l.WithError(err).Error("deadline notification error")
+	}
+
+	task, err := a.TaskService.GetTaskGetTaskWithDeleted(context.Background(), e.TaskUUID)
+	if err != nil {
+		l.WithError(err).Error("deadline task not found")
+		return
+	}
+
+	people = a.NotificationsService.Recipients(e, task.ProjectUUID, people, domain.NotificationEmail)
+	if len(people) == 0 {
+		return
+	}
+
+	msg, err := emails.NewDeadlineMessage(deadlineSubjects[e.Stage], e.Name, e.ID, e.FinishTo)
+	if err != nil {
+		l.WithError(err).Error("deadline email message error")
+	} else if err := a.EmailService.SendEmail(people, msg); err != nil {
+		l.WithError(err).Error("deadline email error")
+	}
+}
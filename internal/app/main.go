@@ -3,9 +3,11 @@ package app
 import (
 	"context"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/aggregates"
 	"github.com/krisch/crm-backend/internal/cache"
@@ -13,8 +15,10 @@ import (
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/company"
 	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/deals"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/events"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
@@ -22,12 +26,16 @@ import (
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
+	"github.com/krisch/crm-backend/internal/outbox"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/realtime"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/search"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/internal/webhooks"
 	"github.com/krisch/crm-backend/pkg/redis"
 	"github.com/sirupsen/logrus"
 )
@@ -61,8 +69,16 @@ type App struct {
 	JWT                  jwt.IJWT
 	AgentsService        *agents.Service
 	PermissionsService   *permissions.Service
+	DealsService         *deals.Service
+	SearchService        *search.Service
+	Events               *events.Bus
+	OutboxService        *outbox.Service
+	WebhooksService      *webhooks.Service
+	Realtime             *realtime.Hub
 
 	MetricsCounters *helpers.MetricsCounters
+
+	subscribeOnce sync.Once
 }
 
 func (a *App) SyncDictionariesByTimeout() {
@@ -152,26 +168,71 @@ func (a *App) Work(ctx context.Context, rds *redis.RDS) {
 	}()
 
 	a.RedisSubscribe(ctx, rds, "update")
+	a.Realtime.Listen(ctx)
 	a.SyncDictionariesByTimeout()
 	a.SyncDictionariesByHook()
+	a.FireRemindersByTimeout(ctx, rds)
+	a.FireDeadlinesByTimeout(ctx)
+	a.FireRecurrencesByTimeout(ctx)
+	a.DeliverWebhooksByTimeout(ctx)
+
+	if a.Options.OUTBOX_ENABLED {
+		a.RelayOutboxByTimeout(ctx)
+	}
 }
 
+// Subscribe attaches the app handlers to the event bus, once however many times it is called.
 func (a *App) Subscribe(_ context.Context) {
-	a.TaskService.OnTaskUpdatedOrCreated(func(uid uuid.UUID, people []string) error {
-		logrus.Info("task updated or created")
-		err := a.NotificationsService.CreateTaskState(uid, people)
-		return err
+	a.subscribeOnce.Do(func() {
+		a.Events.Subscribe("notifications", func(_ context.Context, e domain.Event) error {
+			m := e.Meta()
+			logrus.WithField("task", m.TaskUUID).Info(e.EventName())
+
+			return a.NotificationsService.CreateTaskState(e, a.taskViewers(m.TaskUUID, m.People))
+		},
+			domain.EventTaskCreated, domain.EventTaskUpdated, domain.EventTaskStatusChanged, domain.EventTaskDeleted,
+			domain.EventCommentAdded, domain.EventCommentUpdated, domain.EventCommentDeleted, domain.EventCommentLiked,
+			domain.EventFileUploaded,
+			domain.EventReminderCreated, domain.EventReminderUpdated, domain.EventReminderStatusChanged, domain.EventReminderDeleted,
+		)
+
+		a.Events.Subscribe("notifications", func(_ context.Context, e domain.Event) error {
+			logrus.Info("task was open")
+			return a.NotificationsService.RemoveNotification(e.Meta().Actor, "task", e.Meta().TaskUUID)
+		}, domain.EventTaskOpened)
+
+		a.Events.Subscribe("reminders", func(ctx context.Context, e domain.Event) error {
+			a.DeliverReminder(ctx, e.(domain.ReminderDue))
+			return nil
+		}, domain.EventReminderDue)
+
+		a.Events.Subscribe("deadlines", func(_ context.Context, e domain.Event) error {
+			a.DeliverDeadline(e.(domain.TaskDeadline))
+			return nil
+		}, domain.EventTaskDeadline)
+
+		a.Events.Subscribe("webhooks", a.WebhooksService.Enqueue, domain.WebhookEvents...)
+
+		// after the notifications: the counts pushed are of the notifications just stored
+		a.Events.Subscribe("realtime", a.PushEvent,
+			domain.EventTaskCreated, domain.EventTaskUpdated, domain.EventTaskStatusChanged, domain.EventTaskDeleted,
+			domain.EventCommentAdded, domain.EventCommentUpdated, domain.EventCommentDeleted, domain.EventCommentLiked,
+			domain.EventFileUploaded,
+		)
+
+		a.Events.Subscribe("realtime", func(ctx context.Context, e domain.Event) error {
+			return a.PushNotificationsCount(ctx, e.Meta().Actor)
+		}, domain.EventTaskOpened)
 	})
+}
 
-	a.TaskService.OnOpenTask(func(uid uuid.UUID, email string) error {
-		logrus.Info("task was open")
-		err := a.NotificationsService.RemoveNotification(email, "task", uid)
-		return err
-	})
+// taskViewers drops the people who can not see a task of a private project.
+func (a *App) taskViewers(uid uuid.UUID, people []string) []string {
+	task, err := a.TaskService.GetTaskGetTaskWithDeleted(context.Background(), uid)
+	if err != nil {
+		logrus.Error("task viewers: ", err)
+		return nil
+	}
 
-	a.RemindersService.OnReminderWasUpdatedOrCreated(func(uid, taskUUID uuid.UUID, people []string) error {
-		logrus.Info("reminder updated or created: ", uid)
-		err := a.NotificationsService.CreateTaskState(taskUUID, people)
-		return err
-	})
+	return a.GateService.TaskViewers(task, people)
 }
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const outboxBatchSize = 500
+
+// RelayOutboxByTimeout periodically publishes the captured changes to kafka.
+// Every replica tries, but a pass runs only on the replica holding the relay lock.
+func (a *App) RelayOutboxByTimeout(ctx context.Context) {
+	scanTime := time.Second * time.Duration(a.Options.OUTBOX_SCAN_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(scanTime)
+				a.RelayOutboxByTimeout(ctx)
+			}
+		}()
+
+		for {
+			full := a.RelayOutbox(ctx)
+
+			// a full batch means there is more to publish right away
+			if full {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(scanTime):
+			}
+		}
+	}()
+}
+
+// RelayOutbox runs a relay pass, true - the pass published a full batch.
+func (a *App) RelayOutbox(ctx context.Context) bool {
+	batch, err := a.OutboxService.Relay(ctx, time.Now(), outboxBatchSize)
+	if err != nil {
+		logrus.WithError(err).Error("outbox relay error")
+		return false
+	}
+
+	for _, m := range batch.Dead {
+		logrus.WithField("aggregate", m.Aggregate).
+			WithField("uuid", m.AggregateUUID).
+			WithField("attempts", m.Attempts).
+			WithField("error", m.LastError).
+			Error("outbox message is dead")
+	}
+
+	if len(batch.Retried) > 0 {
+		logrus.WithField("count", len(batch.Retried)).Warn("outbox messages to retry")
+	}
+
+	return len(batch.Published) == outboxBatchSize
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// pushProjectEvents - the task changes pushed to the project subscribers as well
+var pushProjectEvents = []string{
+	domain.EventTaskCreated,
+	domain.EventTaskUpdated,
+	domain.EventTaskStatusChanged,
+	domain.EventTaskDeleted,
+}
+
+// PushEvent sends the event to the subscribers of its task and, for a task change, of its project,
+// then the notification counts of the people who want the event pushed.
+func (a *App) PushEvent(ctx context.Context, e domain.Event) error {
+	m := e.Meta()
+
+	var data interface{} = e
+	switch ev := e.(type) {
+	case domain.CommentAdded:
+		data = a.pushComment(ctx, ev.CommentUUID, e)
+	case domain.CommentUpdated:
+		data = a.pushComment(ctx, ev.CommentUUID, e)
+	}
+
+	msg, err := domain.NewPushMessage(e.EventName(), domain.PushTaskTopic(m.TaskUUID), data)
+	if err != nil {
+		return err
+	}
+
+	msgs := []domain.PushMessage{msg}
+
+	task, err := a.TaskService.GetTaskGetTaskWithDeleted(ctx, m.TaskUUID)
+	if err != nil {
+		logrus.WithField("task", m.TaskUUID).WithError(err).Error("push task error")
+		return a.Realtime.Publish(ctx, msgs...)
+	}
+
+	if lo.Contains(pushProjectEvents, e.EventName()) {
+		msg.Topic = domain.PushProjectTopic(task.ProjectUUID)
+		msgs = append(msgs, msg)
+	}
+
+	people := a.GateService.TaskViewers(task, m.People)
+	for _, email := range a.NotificationsService.Recipients(e, task.ProjectUUID, people, domain.NotificationPush) {
+		if count, ok := a.pushNotificationsCount(ctx, email); ok {
+			msgs = append(msgs, count)
+		}
+	}
+
+	return a.Realtime.Publish(ctx, msgs...)
+}
+
+// PushNotificationsCount sends the user the count of their notifications.
+func (a *App) PushNotificationsCount(ctx context.Context, email string) error {
+	msg, ok := a.pushNotificationsCount(ctx, email)
+	if !ok {
+		return nil
+	}
+
+	return a.Realtime.Publish(ctx, msg)
+}
+
+func (a *App) pushNotificationsCount(ctx context.Context, email string) (msg domain.PushMessage, ok bool) {
+	count, err := a.NotificationsService.Count(ctx, email)
+	if err != nil {
+		logrus.WithField("user", email).WithError(err).Error("notifications count error")
+		return msg, false
+	}
+
+	msg, err = domain.NewPushMessage(domain.PushNotificationsCount, domain.PushNotificationsTopic(email), map[string]int64{
+		"count": count,
+	})
+
+	return msg, err == nil
+}
+
+// pushComment - the comment itself for a new or edited one, the event when it cannot be read.
+func (a *App) pushComment(ctx context.Context, commentUUID uuid.UUID, e domain.Event) interface{} {
+	comment, err := a.CommentService.GetComment(ctx, commentUUID)
+	if err != nil {
+		logrus.WithField("comment", commentUUID).WithError(err).Error("push comment error")
+		return e
+	}
+
+	return dto.NewCommentDTO(comment, a.DictionaryService, a.ProfileService)
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const recurrencesBatchSize = 100
+
+// FireRecurrencesByTimeout periodically spawns the tasks of the due recurring series.
+// Every replica scans, but an occurrence is spawned only by the replica that advanced the series from it.
+func (a *App) FireRecurrencesByTimeout(ctx context.Context) {
+	scanTime := time.Second * time.Duration(a.Options.RECURRENCES_SCAN_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(scanTime)
+				a.FireRecurrencesByTimeout(ctx)
+			}
+		}()
+
+		for {
+			a.FireRecurrences()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(scanTime):
+			}
+		}
+	}()
+}
+
+func (a *App) FireRecurrences() {
+	now := time.Now()
+
+	dms, err := a.TaskService.GetDueRecurrences(now, recurrencesBatchSize)
+	if err != nil {
+		logrus.WithError(err).Error("get due recurrences error")
+		return
+	}
+
+	for _, dm := range dms {
+		task, err := a.TaskService.SpawnRecurrence(dm, now)
+		if err != nil {
+			logrus.WithField("recurrence", dm.UUID).WithError(err).Error("recurrence spawn error")
+			continue
+		}
+
+		if task != nil {
+			logrus.WithField("recurrence", dm.UUID).WithField("task", task.UUID).Debug("recurrence spawned")
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	remindersBatchSize = 100
+	remindersLockTTL   = 300
+)
+
+// FireRemindersByTimeout periodically delivers due reminders.
+// Every replica scans, but a reminder is fired only by the replica that took its redis lock.
+func (a *App) FireRemindersByTimeout(ctx context.Context, rds *redis.RDS) {
+	scanTime := time.Second * time.Duration(a.Options.REMINDERS_SCAN_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(scanTime)
+				a.FireRemindersByTimeout(ctx, rds)
+			}
+		}()
+
+		for {
+			a.FireReminders(ctx, rds)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(scanTime):
+			}
+		}
+	}()
+}
+
+func (a *App) FireReminders(ctx context.Context, rds *redis.RDS) {
+	dms, err := a.RemindersService.GetDue(time.Now(), remindersBatchSize)
+	if err != nil {
+		logrus.WithError(err).Error("get due reminders error")
+		return
+	}
+
+	for _, r := range dms {
+		key := fmt.Sprintf("reminders:fire:%s:%d", r.UUID, r.DateFrom.Unix())
+
+		locked, err := rds.SetNX(ctx, key, a.Name, remindersLockTTL)
+		if err != nil {
+			logrus.WithError(err).Error("reminder lock error")
+			continue
+		}
+
+		if !locked {
+			continue
+		}
+
+		// mark first: a failed delivery channel must not lead to duplicates on the next scan
+		err = a.RemindersService.MarkFired(r)
+		if err != nil {
+			logrus.WithField("reminder", r.UUID).WithError(err).Debug("reminder was not marked as fired")
+			continue
+		}
+
+		a.Events.Publish(ctx, domain.ReminderDue{
+			EventMeta: domain.NewEventMeta("", r.TaskUUID, []string{}),
+			Reminder:  r,
+		})
+	}
+}
+
+// DeliverReminder notifies the user of the reminder in-app, by email and by SMS, as far as their preferences allow.
+func (a *App) DeliverReminder(ctx context.Context, e domain.ReminderDue) {
+	r := e.Reminder
+	l := logrus.WithField("reminder", r.UUID)
+
+	userUUID := r.CreatedByUUID
+	if r.UserUUID != nil {
+		userUUID = *r.UserUUID
+	}
+
+	user, ok := a.DictionaryService.FindUserByUUID(userUUID)
+	if !ok {
+		l.Errorf("reminder user not found by uuid: %s", userUUID)
+		return
+	}
+
+	err := a.NotificationsService.CreateTaskState(e, a.taskViewers(r.TaskUUID, []string{user.Email}))
+	if err != nil {
+		l.WithError(err).Error("reminder notification error")
+	}
+
+	task, err := a.TaskService.GetTask(ctx, r.TaskUUID, []string{})
+	if err != nil {
+		l.WithError(err).Error("reminder task not found")
+		return
+	}
+
+	notify := func(channel string) bool {
+		return len(a.NotificationsService.Recipients(e, task.ProjectUUID, []string{user.Email}, channel)) > 0
+	}
+
+	if notify(domain.NotificationEmail) {
+		msg, err := emails.NewReminderMessage(r.Description, r.DateFrom)
+		if err != nil {
+			l.WithError(err).Error("reminder email message error")
+		} else if err := a.EmailService.SendEmail([]string{user.Email}, msg); err != nil {
+			l.WithError(err).Error("reminder email error")
+		}
+	}
+
+	if user.Phone == 0 || !notify(domain.NotificationSMS) {
+		return
+	}
+
+	so, err := a.CompanyService.GetSmsOptions(task.CompanyUUID)
+	if err != nil || so.API == "" {
+		return
+	}
+
+	company, ok := a.DictionaryService.FindCompany(task.CompanyUUID)
+	if !ok {
+		l.Errorf("reminder company not found by uuid: %s", task.CompanyUUID)
+		return
+	}
+
+	text := "Напоминание: " + r.Description
+	s := sms.NewCompanySms(strconv.Itoa(user.Phone), text, so.From, r.CreatedByUUID, r.CreatedBy, company)
+
+	_, err = a.SMSService.SmsSend(so.API, s)
+	if err != nil {
+		l.WithError(err).Error("reminder sms error")
+		return
+	}
+
+	err = a.SMSService.StoreSms(s)
+	if err != nil {
+		l.WithError(err).Error("reminder sms store error")
+	}
+}
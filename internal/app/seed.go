@@ -474,17 +474,18 @@ func (a *App) SeedTasks(ctx context.Context, total int, projectUUID uuid.UUID, c
 		if rand == 0 {
 			imageName := fmt.Sprintf("photo-%v.jpg", helpers.RandomNumber(1, 5))
 
-			_, err := a.S3PrivateService.UploadTaskFile(task.FederationUUID, task.UUID, imageName, "./"+imageName, user.UUID)
+			file, err := a.S3PrivateService.UploadTaskFile(task.FederationUUID, task.UUID, imageName, "./"+imageName, user.UUID)
 			if err != nil {
 				return nil, err
 			}
 
 			// @todo: mv to service
 			if len(task.People) > 0 {
-				err = a.TaskService.TaskWasUpdatedOrCreated(task.UUID, task.People)
-				if err != nil {
-					logrus.Error("TaskWasUpdatedOrCreated error: ", err)
-				}
+				a.Events.Publish(context.Background(), domain.FileUploaded{
+					EventMeta: domain.NewEventMeta("", task.UUID, task.People),
+					FileUUID:  file.UUID,
+					Name:      file.Name,
+				})
 			}
 
 		}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const webhooksBatchSize = 50
+
+// DeliverWebhooksByTimeout periodically sends the due webhook deliveries.
+// Every replica scans, but a delivery is sent only by the replica that has claimed it.
+func (a *App) DeliverWebhooksByTimeout(ctx context.Context) {
+	scanTime := time.Second * time.Duration(a.Options.WEBHOOKS_SCAN_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(scanTime)
+				a.DeliverWebhooksByTimeout(ctx)
+			}
+		}()
+
+		for {
+			a.DeliverWebhooks(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(scanTime):
+			}
+		}
+	}()
+}
+
+func (a *App) DeliverWebhooks(ctx context.Context) {
+	sent, err := a.WebhooksService.Deliver(ctx, time.Now(), webhooksBatchSize)
+	if err != nil {
+		logrus.WithError(err).Error("webhooks delivery error")
+		return
+	}
+
+	if sent > 0 {
+		logrus.WithField("count", sent).Debug("webhooks delivered")
+	}
+}
@@ -13,8 +13,10 @@ import (
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/company"
 	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/deals"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/events"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
@@ -22,12 +24,16 @@ import (
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
+	"github.com/krisch/crm-backend/internal/outbox"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/realtime"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/search"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/internal/webhooks"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
 )
@@ -44,6 +50,10 @@ func s3Conf(conf *configs.Configs) s3.Conf {
 	}
 }
 
+func outboxBroker(conf *configs.Configs) outbox.Broker {
+	return outbox.NewKafkaBroker(conf.NewOutboxKafkaWriter)
+}
+
 func s3PrivateConf(conf *configs.Configs) s3.ConfPrivate {
 	return s3.ConfPrivate{
 		Endpoint:        conf.CDN_PRIVATE_ENDPOINT,
@@ -74,6 +84,8 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 		cache.NewRepository,
 		cache.New,
 
+		events.New,
+
 		notifications.NewRepository,
 		notifications.New,
 
@@ -130,6 +142,21 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 		catalogs.NewRepository,
 		catalogs.New,
 
+		deals.NewRepository,
+		deals.New,
+
+		search.NewRepository,
+		search.New,
+
+		outboxBroker,
+		outbox.NewRepository,
+		outbox.New,
+
+		webhooks.NewRepository,
+		webhooks.New,
+
+		realtime.New,
+
 		NewApp,
 	)
 
@@ -162,6 +189,12 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	smsService *sms.Service,
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
+	dealsService *deals.Service,
+	searchService *search.Service,
+	bus *events.Bus,
+	outboxService *outbox.Service,
+	webhooksService *webhooks.Service,
+	hub *realtime.Hub,
 ) *App {
 	w := &App{
 		Env:  conf.ENV,
@@ -206,6 +239,12 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.SMSService = smsService
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
+	w.DealsService = dealsService
+	w.SearchService = searchService
+	w.Events = bus
+	w.OutboxService = outboxService
+	w.WebhooksService = webhooksService
+	w.Realtime = hub
 
 	return w
 }
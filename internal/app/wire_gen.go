@@ -15,8 +15,10 @@ import (
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/company"
 	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/deals"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/events"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
@@ -24,12 +26,16 @@ import (
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
+	"github.com/krisch/crm-backend/internal/outbox"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/realtime"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/search"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/internal/webhooks"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
 )
@@ -65,15 +71,20 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	confPrivate := s3PrivateConf(configsConfigs)
 	servicePrivate := s3.NewPrivate(confPrivate, s3Repository, cacheService)
 	commentsService := comments.New(commentsRepository, dictionaryService, servicePrivate, activitiesService)
-	taskService := task.New(taskRepository, dictionaryService, activitiesService, profileService, commentsService, servicePrivate)
+	bus := events.New()
+	taskService := task.New(taskRepository, dictionaryService, activitiesService, profileService, commentsService, servicePrivate, bus)
 	remindersRepository := reminders.NewRepository(gdb)
-	remindersService := reminders.New(remindersRepository, dictionaryService)
+	remindersService := reminders.New(remindersRepository, dictionaryService, bus)
 	federationRepository := federation.NewRepository(gdb, rds)
 	catalogsRepository := catalogs.NewRepository(gdb, rds, metricsCounters)
 	catalogsService := catalogs.New(catalogsRepository, dictionaryService)
 	federationService := federation.NewUserService(federationRepository, dictionaryService, catalogsService)
-	aggregatesService := aggregates.New(dictionaryService, profileService, taskService, commentsService, servicePrivate, remindersService, federationService)
-	notificationsService := notifications.New(repository, aggregatesService, dictionaryService)
+	smsRepository := sms.NewRepository(gdb)
+	smsService := sms.New(smsRepository)
+	agentsRepository := agents.NewRepository(gdb)
+	agentsService := agents.New(agentsRepository)
+	aggregatesService := aggregates.New(dictionaryService, profileService, taskService, commentsService, servicePrivate, remindersService, federationService, activitiesService, smsService, agentsService)
+	notificationsService := notifications.New(repository, aggregatesService, dictionaryService, profileService)
 	iLogRepository := logs.NewLogRepository(gdb)
 	iLogService := logs.NewLogService(iLogRepository)
 	emailRepository := emails.NewRepository(gdb)
@@ -81,17 +92,23 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	if err != nil {
 		return nil, err
 	}
-	gatesRepository := gates.NewRepository(gdb, rds)
-	gatesService := gates.New(gatesRepository, dictionaryService)
 	companyRepository := company.NewRepository(gdb, rds, cacheService)
 	companyService := company.New(companyRepository, dictionaryService)
-	smsRepository := sms.NewRepository(gdb)
-	smsService := sms.New(smsRepository)
-	agentsRepository := agents.NewRepository(gdb)
-	agentsService := agents.New(agentsRepository)
 	permissionsRepository := permissions.NewRepository(gdb, rds)
 	permissionsService := permissions.New(permissionsRepository)
-	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService)
+	gatesRepository := gates.NewRepository(gdb, rds)
+	gatesService := gates.New(gatesRepository, dictionaryService, permissionsService)
+	dealsRepository := deals.NewRepository(gdb)
+	dealsService := deals.New(dealsRepository, dictionaryService, activitiesService)
+	searchRepository := search.NewRepository(gdb)
+	searchService := search.New(searchRepository)
+	outboxRepository := outbox.NewRepository(gdb)
+	broker := outboxBroker(configsConfigs)
+	outboxService := outbox.New(outboxRepository, broker, configsConfigs)
+	webhooksRepository := webhooks.NewRepository(gdb)
+	webhooksService := webhooks.New(webhooksRepository, configsConfigs)
+	hub := realtime.New(rds)
+	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService, dealsService, searchService, bus, outboxService, webhooksService, hub)
 	return app, nil
 }
 
@@ -109,6 +126,10 @@ func s3Conf(conf *configs.Configs) s3.Conf {
 	}
 }
 
+func outboxBroker(conf *configs.Configs) outbox.Broker {
+	return outbox.NewKafkaBroker(conf.NewOutboxKafkaWriter)
+}
+
 func s3PrivateConf(conf *configs.Configs) s3.ConfPrivate {
 	return s3.ConfPrivate{
 		Endpoint:        conf.CDN_PRIVATE_ENDPOINT,
@@ -148,6 +169,12 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	smsService *sms.Service,
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
+	dealsService *deals.Service,
+	searchService *search.Service,
+	bus *events.Bus,
+	outboxService *outbox.Service,
+	webhooksService *webhooks.Service,
+	hub *realtime.Hub,
 ) *App {
 	w := &App{
 		Env:  conf.ENV,
@@ -190,6 +217,12 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.SMSService = smsService
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
+	w.DealsService = dealsService
+	w.SearchService = searchService
+	w.Events = bus
+	w.OutboxService = outboxService
+	w.WebhooksService = webhooksService
+	w.Realtime = hub
 
 	return w
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -161,7 +162,33 @@ func (s *Service) GetSortFields(catalogUUID uuid.UUID) []string {
 	return allowOrder
 }
 
+// filterColumns - core columns the fields filter accepts next to the custom fields.
+var filterColumns = map[string]domain.FieldDataType{
+	"created_by": domain.Email,
+	"created_at": domain.DateTime,
+	"updated_at": domain.DateTime,
+}
+
 func (s *Service) GetData(search dto.CatalogSearchDTO) (dmns []domain.CatalogData, total int64, err error) {
+	catalogFields, _ := s.dict.FindCatalogFields(search.CatalogUUID)
+
+	fields := make(map[string]domain.FieldDataType, len(catalogFields))
+	for _, field := range catalogFields {
+		fields[field.Hash] = domain.FieldDataType(field.DataType)
+	}
+
+	// a plain value on a text field keeps searching by prefix
+	for i, item := range search.Fields {
+		if dt, ok := fields[strings.TrimPrefix(item.Name, "fields.")]; ok && item.Operator == "" && dt.FilterKind() == domain.FilterKindText {
+			search.Fields[i].Operator = domain.FilterStartsWith
+		}
+	}
+
+	err = dto.CheckFilters(search.Fields, filterColumns, fields, time.Now())
+	if err != nil {
+		return dmns, -1, err
+	}
+
 	allowOrder := s.GetSortFields(search.CatalogUUID)
 
 	return s.repo.GetData(search, allowOrder)
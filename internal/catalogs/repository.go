@@ -378,15 +378,8 @@ func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (d
 	if len(filter.Fields) > 0 {
 		queryWhere := r.gorm.DB
 		for _, item := range filter.Fields {
-			// @todo: add regular to check array
-			if strings.HasPrefix(fmt.Sprintf("%v", item.Value), "@> [") && strings.HasSuffix(fmt.Sprintf("%v", item.Value), "]") {
-				v := strings.TrimPrefix(item.Value.(string), "@> ")
-				queryWhere = queryWhere.Where(" fields->? @> ?", item.Name, v)
-			} else {
-				// @todo: add ilike search
-				v := strings.ReplaceAll(fmt.Sprintf("%v", item.Value), "%", "")
-				queryWhere = queryWhere.Where("fields->>? ilike ?", item.Name, v+"%")
-			}
+			where, args := item.Where()
+			queryWhere = queryWhere.Where(where, args...)
 		}
 		sql2 := queryWhere.ToSQL(func(tx *gorm.DB) *gorm.DB {
 			return tx.Find(&orms)
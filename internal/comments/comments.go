@@ -93,6 +93,15 @@ func (s *Service) GetTaskComments(uid uuid.UUID, withFiles, withLikes bool) (dms
 	return dms, err
 }
 
+// GetTasksComments returns comments of several tasks without files and likes, optionally in [from, to), newest first.
+func (s *Service) GetTasksComments(uids []uuid.UUID, from, to *time.Time, limit, offset int) (dms []domain.Comment, total int64, err error) {
+	if len(uids) == 0 {
+		return dms, 0, nil
+	}
+
+	return s.repo.GetTasksComments(uids, from, to, limit, offset)
+}
+
 func (s *Service) GetCommentsFiles(uid uuid.UUID) (files []domain.File, err error) {
 	files, err = s.storage.GetCommentFiles(uid, true)
 	if err != nil {
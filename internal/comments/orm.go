@@ -26,6 +26,8 @@ type Comment struct {
 	People Persons `gorm:"type:text[];default:'{}';not null;"`
 
 	Pin bool `gorm:"type:boolean;default:false;not null;"`
+
+	Total int64 `gorm:"->"`
 }
 
 // JSONB Interface for JSONB Field of yourTableName Table.
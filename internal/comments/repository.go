@@ -2,6 +2,7 @@ package comments
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -185,6 +186,50 @@ func (r *Repository) GetTaskComments(uid uuid.UUID, commentUID *uuid.UUID) (dms
 	return dms, err
 }
 
+func (r *Repository) GetTasksComments(uids []uuid.UUID, from, to *time.Time, limit, offset int) (dms []domain.Comment, total int64, err error) {
+	defer r.storeTime("GetTasksComments", tm())
+
+	orm := []Comment{}
+	query := r.gorm.DB.
+		Model(&Comment{}).
+		Select("uuid, comment, created_by, reply_uuid, task_uuid, created_at, updated_at, count(*) OVER() AS total").
+		Where("task_uuid in ?", uids).
+		Where("deleted_at IS NULL")
+
+	if from != nil {
+		query = query.Where("created_at >= ?", *from)
+	}
+
+	if to != nil {
+		query = query.Where("created_at < ?", *to)
+	}
+
+	err = query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&orm).
+		Error
+
+	if len(orm) > 0 {
+		total = orm[0].Total
+	}
+
+	for _, o := range orm {
+		dms = append(dms, domain.Comment{
+			UUID:      o.UUID,
+			Comment:   o.Comment,
+			CreatedBy: o.CreatedBy,
+			ReplyUUID: o.ReplyUUID,
+			TaskUUID:  o.TaskUUID,
+			CreatedAt: o.CreatedAt,
+			UpdatedAt: o.UpdatedAt,
+		})
+	}
+
+	return dms, total, err
+}
+
 func (r *Repository) GetTaskComment(commentUID uuid.UUID) (dms domain.Comment, err error) {
 	defer r.storeTime("GetTaskComments", tm())
 
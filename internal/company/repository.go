@@ -67,7 +67,7 @@ func (r *Repository) CreateTag(tag domain.Tag) (err error) {
 }
 
 func (r *Repository) GetTag(uid uuid.UUID) (orm CompanyTags, err error) {
-	err = r.gorm.DB.Where("uid = ?", uid).First(&orm).Error
+	err = r.gorm.DB.Where("uuid = ?", uid).First(&orm).Error
 	return orm, err
 }
 
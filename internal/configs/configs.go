@@ -1,6 +1,7 @@
 package configs
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -35,15 +36,18 @@ type Configs struct {
 	SMTP_CREDS  string `env:"SMTP_CREDS" secured:"true"`
 
 	// APP
-	GZIP                     int    `env:"GZIP" envDefault:"5"`
-	LOG_LEVEL                string `env:"LOG_LEVEL" envDefault:"debug"`
-	LOG_FORMAT               string `env:"LOG_FORMAT" envDefault:"plain"`
-	LOG_TO_DB                bool   `env:"LOG_TO_DB" envDefault:"true"`
-	APP_NAME                 string `env:"APP_NAME" envDefault:"unknown"`
-	SOLT                     string `env:"SOLT" envDefault:"solt"`
-	TIME_ZONE                string `env:"TIME_ZONE" envDefault:"UTC"`
-	DICTIONARY_SYNC_INTERVAL int    `env:"DICTIONARY_SYNC_INTERVAL" envDefault:"10"`
-	URL_BACKEND              string `env:"URL_BACKEND" envDefault:"http://localhost:8080"`
+	GZIP                      int    `env:"GZIP" envDefault:"5"`
+	LOG_LEVEL                 string `env:"LOG_LEVEL" envDefault:"debug"`
+	LOG_FORMAT                string `env:"LOG_FORMAT" envDefault:"plain"`
+	LOG_TO_DB                 bool   `env:"LOG_TO_DB" envDefault:"true"`
+	APP_NAME                  string `env:"APP_NAME" envDefault:"unknown"`
+	SOLT                      string `env:"SOLT" envDefault:"solt"`
+	TIME_ZONE                 string `env:"TIME_ZONE" envDefault:"UTC"`
+	DICTIONARY_SYNC_INTERVAL  int    `env:"DICTIONARY_SYNC_INTERVAL" envDefault:"10"`
+	URL_BACKEND               string `env:"URL_BACKEND" envDefault:"http://localhost:8080"`
+	REMINDERS_SCAN_INTERVAL   int    `env:"REMINDERS_SCAN_INTERVAL" envDefault:"30"`
+	DEADLINES_SCAN_INTERVAL   int    `env:"DEADLINES_SCAN_INTERVAL" envDefault:"60"`
+	RECURRENCES_SCAN_INTERVAL int    `env:"RECURRENCES_SCAN_INTERVAL" envDefault:"60"`
 
 	// CDN
 	CDN_PUBLIC_REGION            string `env:"CDN_PUBLIC_REGION" envDefault:"us-east-1"`
@@ -98,6 +102,21 @@ type Configs struct {
 	EMAILS_INTEGRATION_ENABLED bool     `env:"EMAILS_INTEGRATION_ENABLED" envDefault:"false"`
 	KAFKA_BROKERS              []string `env:"KAFKA_BROKERS" envDefault:"kafka:9092"`
 	KAFKA_TOPIC                string   `env:"KAFKA_TOPIC" envDefault:"emails"`
+
+	// Outbox
+	OUTBOX_ENABLED       bool   `env:"OUTBOX_ENABLED" envDefault:"false"`
+	OUTBOX_TOPIC         string `env:"OUTBOX_TOPIC" envDefault:"changes"`
+	OUTBOX_SCAN_INTERVAL int    `env:"OUTBOX_SCAN_INTERVAL" envDefault:"1"`
+	OUTBOX_MAX_ATTEMPTS  int    `env:"OUTBOX_MAX_ATTEMPTS" envDefault:"20"`
+	// OUTBOX_CAPTURE - on or off, the cli switches the capture of the whole database, the servers never do
+	OUTBOX_CAPTURE string `env:"OUTBOX_CAPTURE" envDefault:""`
+
+	// Webhooks
+	WEBHOOKS_SCAN_INTERVAL  int  `env:"WEBHOOKS_SCAN_INTERVAL" envDefault:"5"`
+	WEBHOOKS_TIMEOUT        int  `env:"WEBHOOKS_TIMEOUT" envDefault:"10"`
+	WEBHOOKS_MAX_ATTEMPTS   int  `env:"WEBHOOKS_MAX_ATTEMPTS" envDefault:"10"`
+	WEBHOOKS_DISABLE_AFTER  int  `env:"WEBHOOKS_DISABLE_AFTER" envDefault:"50"`
+	WEBHOOKS_ALLOW_INTERNAL bool `env:"WEBHOOKS_ALLOW_INTERNAL" envDefault:"false"`
 }
 
 func (o *Configs) Debug() {
@@ -173,3 +192,16 @@ func (o *Configs) NewKafkaWriter() *kafka.Writer {
 		Topic:   o.KAFKA_TOPIC,
 	})
 }
+
+// NewOutboxKafkaWriter - the hash balancer keeps the messages of a key in one partition and so in order.
+func (o *Configs) NewOutboxKafkaWriter() (*kafka.Writer, error) {
+	if len(o.KAFKA_BROKERS) == 0 {
+		return nil, errors.New("kafka brokers not configured")
+	}
+	return &kafka.Writer{
+		Addr:         kafka.TCP(o.KAFKA_BROKERS...),
+		Topic:        o.OUTBOX_TOPIC,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}, nil
+}
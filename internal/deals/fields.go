@@ -0,0 +1,58 @@
+package deals
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+// FilterDealFields checks raw fields against the company fields, nil values are skipped.
+func (s *Service) FilterDealFields(deal domain.Deal) (filteredFields map[string]interface{}, err error) {
+	filteredFields = make(map[string]interface{})
+
+	if len(deal.RawFields) == 0 {
+		return filteredFields, nil
+	}
+
+	companyFields, _ := s.dict.FindCompanyFields(deal.CompanyUUID)
+
+	addedFieldsHash := []string{}
+	for _, cf := range companyFields {
+		value, ok := deal.RawFields[cf.Hash]
+		if !ok {
+			continue
+		}
+
+		addedFieldsHash = append(addedFieldsHash, cf.Hash)
+
+		if value == nil {
+			continue
+		}
+
+		v, err := domain.FieldValue(cf.Name, cf.Hash, domain.FieldDataType(cf.DataType), value)
+		if err != nil {
+			return filteredFields, err
+		}
+
+		if v != nil {
+			filteredFields[cf.Hash] = v
+		}
+	}
+
+	if len(addedFieldsHash) != len(deal.RawFields) {
+		unwantedFields := helpers.ArrayNonIntersection(addedFieldsHash, helpers.GetMapKeys(deal.RawFields))
+
+		if len(unwantedFields) == 0 {
+			return filteredFields, errors.New("в компании нет кастомных полей")
+		}
+
+		msg := fmt.Sprintf("невозможно добавить: (%s)", strings.Join(unwantedFields, ","))
+
+		return filteredFields, errors.New(msg)
+	}
+
+	return filteredFields, nil
+}
@@ -0,0 +1,180 @@
+package deals
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/activities"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	repo *Repository
+	dict *dictionary.Service
+	as   *activities.Service
+}
+
+func New(repo *Repository, dict *dictionary.Service, as *activities.Service) *Service {
+	return &Service{
+		repo: repo,
+		dict: dict,
+		as:   as,
+	}
+}
+
+func (s *Service) CreateDeal(crtr domain.Creator, deal domain.Deal) (id int, err error) {
+	filteredFields, err := s.FilterDealFields(deal)
+	if err != nil {
+		return id, err
+	}
+
+	deal.Fields = filteredFields
+
+	if deal.StageUUID != nil {
+		stage, err := s.repo.GetStage(*deal.StageUUID)
+		if err != nil {
+			return id, err
+		}
+
+		if stage.CompanyUUID != deal.CompanyUUID {
+			return id, domain.ErrDealStageNotFound
+		}
+	}
+
+	err = s.repo.Create(&deal)
+	if err != nil {
+		return id, err
+	}
+
+	_, err = s.as.DealWasCreated(crtr, deal.UUID, deal.Name)
+	if err != nil {
+		logrus.Error("DealWasCreated error: ", err)
+	}
+
+	return deal.ID, nil
+}
+
+func (s *Service) GetDeal(uid uuid.UUID) (dm domain.Deal, err error) {
+	return s.repo.Get(uid)
+}
+
+// filterColumns - core columns the fields filter accepts next to the custom fields.
+var filterColumns = map[string]domain.FieldDataType{
+	"id":          domain.Integer,
+	"name":        domain.String,
+	"status":      domain.Integer,
+	"priority":    domain.Integer,
+	"amount":      domain.Float,
+	"created_by":  domain.Email,
+	"tags":        domain.Array,
+	"created_at":  domain.DateTime,
+	"updated_at":  domain.DateTime,
+	"activity_at": domain.DateTime,
+	"finished_at": domain.DateTime,
+}
+
+func (s *Service) GetDeals(filter dto.DealSearchDTO) (dms []domain.Deal, total int64, err error) {
+	companyFields, _ := s.dict.FindCompanyFields(filter.CompanyUUID)
+
+	err = dto.CheckFilters(filter.Fields, filterColumns, lo.SliceToMap(companyFields, func(f dto.CompanyFieldDTO) (string, domain.FieldDataType) {
+		return f.Hash, domain.FieldDataType(f.DataType)
+	}), time.Now())
+	if err != nil {
+		return dms, -1, err
+	}
+
+	return s.repo.GetDeals(filter, s.GetSortFields(filter.CompanyUUID))
+}
+
+func (s *Service) GetSortFields(companyUUID uuid.UUID) []string {
+	allowSort := s.repo.GetSortFields()
+
+	companyFields, _ := s.dict.FindCompanyFields(companyUUID)
+	for _, cf := range companyFields {
+		allowSort = append(allowSort, "fields."+cf.Hash)
+	}
+
+	return allowSort
+}
+
+// UpdateDeal stores the fields listed in shouldUpdate and logs an activity per changed field.
+func (s *Service) UpdateDeal(crtr domain.Creator, deal domain.Deal, shouldUpdate []string) (err error) {
+	oldDeal, err := s.repo.Get(deal.UUID)
+	if err != nil {
+		return err
+	}
+
+	if deal.RawFields != nil {
+		filteredFields, err := s.FilterDealFields(deal)
+		if err != nil {
+			return err
+		}
+
+		if deal.Fields == nil {
+			deal.Fields = make(map[string]interface{})
+		}
+
+		for k, v := range filteredFields {
+			deal.Fields[k] = v
+		}
+
+		for k, v := range deal.RawFields {
+			if v == nil {
+				delete(deal.Fields, k)
+			}
+		}
+	}
+
+	err = s.repo.Update(deal, shouldUpdate)
+	if err != nil {
+		return err
+	}
+
+	changes := map[string][2]interface{}{
+		"name":        {oldDeal.Name, deal.Name},
+		"description": {oldDeal.Description, deal.Description},
+		"tags":        {oldDeal.Tags, deal.Tags},
+		"status":      {oldDeal.Status, deal.Status},
+		"priority":    {oldDeal.Priority, deal.Priority},
+		"amount":      {oldDeal.Amount, deal.Amount},
+		"fields":      {oldDeal.Fields, deal.Fields},
+	}
+
+	for _, field := range shouldUpdate {
+		change, ok := changes[field]
+		if !ok {
+			continue
+		}
+
+		_, err = s.as.DealWasChangedActivity(crtr, deal.UUID, field, change[0], change[1])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteDeal(crtr domain.Creator, uid uuid.UUID) (err error) {
+	deal, err := s.repo.Get(uid)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.Delete(uid)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.as.DealWasDeleted(crtr, uid, deal.Name)
+
+	return err
+}
+
+func (s *Service) GetActivities(dealUUID uuid.UUID, limit, offset int) (dms []domain.Activity, total int64, err error) {
+	return s.as.GetDealActivities(dealUUID, limit, offset)
+}
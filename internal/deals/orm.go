@@ -0,0 +1,87 @@
+package deals
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type Deal struct {
+	UUID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	ID          int       `gorm:"type:int8" order:""`
+	Name        string    `gorm:"type:varchar(50);default:'';not null" order:""`
+	Description string    `gorm:"type:text;default:'';not null"`
+	CreatedBy   string    `gorm:"type:varchar(100);default:'';not null;" order:""`
+
+	Tags pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null"`
+	CompanyUUID    uuid.UUID `gorm:"type:uuid;not null"`
+
+	Status   int `gorm:"type:int8;default:0;not null" order:""`
+	Priority int `gorm:"type:int8;default:10;not null" order:""`
+
+	StageUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+	Amount    float64    `gorm:"type:numeric(18,2);default:0;not null" order:""`
+
+	FinishedAt *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
+	ActivityAt time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
+	CreatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
+	UpdatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
+	DeletedAt  *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	Fields JSONB `gorm:"type:jsonb;default:'{}';not null;"`
+	Meta   JSONB `gorm:"type:jsonb;default:'{}';not null;"`
+
+	Total int64 `gorm:"->"`
+}
+
+type JSONB map[string]interface{}
+
+func (j JSONB) Value() (driver.Value, error) {
+	valueString, err := json.Marshal(j)
+	return string(valueString), err
+}
+
+func (j *JSONB) Scan(value interface{}) error {
+	if err := json.Unmarshal(value.([]byte), &j); err != nil {
+		return err
+	}
+	return nil
+}
+
+type DealStage struct {
+	UUID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	CompanyUUID uuid.UUID `gorm:"type:uuid;not null"`
+	Name        string    `gorm:"type:varchar(100);default:'';not null"`
+	Color       string    `gorm:"type:varchar(20);default:'';not null"`
+	Probability int       `gorm:"type:int;default:0;not null"`
+	Transitions UUIDs     `gorm:"type:jsonb;default:'[]';not null"`
+	Sort        int       `gorm:"type:int;default:0;not null"`
+	CreatedBy   string    `gorm:"type:varchar(100);default:'';not null"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+type UUIDs []uuid.UUID
+
+func (j UUIDs) Value() (driver.Value, error) {
+	if j == nil {
+		return "[]", nil
+	}
+
+	valueString, err := json.Marshal(j)
+	return string(valueString), err
+}
+
+func (j *UUIDs) Scan(value interface{}) error {
+	if err := json.Unmarshal(value.([]byte), &j); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,510 @@
+package deals
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm *domain.Deal) (err error) {
+	orm := &Deal{
+		UUID:        dm.UUID,
+		Name:        dm.Name,
+		Description: dm.Description,
+		CreatedBy:   dm.CreatedBy,
+
+		Tags: dm.Tags,
+
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+
+		Status:   dm.Status,
+		Priority: dm.Priority,
+
+		StageUUID: dm.StageUUID,
+		Amount:    dm.Amount,
+
+		CreatedAt: dm.CreatedAt,
+
+		Fields: dm.Fields,
+		Meta:   dm.Meta,
+	}
+
+	// deals are numbered inside a company, the lock serializes concurrent inserts
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("select pg_advisory_xact_lock(hashtext(?))", "deals:"+dm.CompanyUUID.String()).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Raw("select coalesce(max(id), 0) + 1 from deals where company_uuid = ?", dm.CompanyUUID).Scan(&orm.ID).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&orm).Error
+	})
+
+	if err == nil {
+		dm.ID = orm.ID
+	}
+
+	return err
+}
+
+func (r *Repository) Get(uid uuid.UUID) (dm domain.Deal, err error) {
+	orm := &Deal{}
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("сделка не найдена")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return toDomain(*orm), nil
+}
+
+func (r *Repository) Update(dm domain.Deal, shouldUpdate []string) error {
+	values := map[string]interface{}{
+		"name":        dm.Name,
+		"description": dm.Description,
+		"tags":        pq.StringArray(dm.Tags),
+		"status":      dm.Status,
+		"priority":    dm.Priority,
+		"finished_at": dm.FinishedAt,
+		"stage_uuid":  dm.StageUUID,
+		"amount":      dm.Amount,
+		"fields":      JSONB(dm.Fields),
+	}
+
+	updates := map[string]interface{}{
+		"activity_at": "now()",
+		"updated_at":  "now()",
+	}
+
+	for _, field := range shouldUpdate {
+		if v, ok := values[field]; ok {
+			updates[field] = v
+		}
+	}
+
+	res := r.gorm.DB.
+		Model(&Deal{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(updates)
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("нельзя обновлять удаленную сделку")
+	}
+
+	return res.Error
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Deal{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("сделка не найдена")
+	}
+
+	return res.Error
+}
+
+func (r *Repository) GetSortFields() []string {
+	st := reflect.TypeOf(Deal{})
+
+	allowSort := []string{}
+	for i := 0; i < st.NumField(); i++ {
+		field := st.Field(i)
+
+		if strings.Contains(string(field.Tag), "order") {
+			allowSort = append(allowSort, helpers.ToLowerSnake(field.Name))
+		}
+	}
+
+	return allowSort
+}
+
+func (r *Repository) GetDeals(filter dto.DealSearchDTO, allowSort []string) (dms []domain.Deal, total int64, err error) {
+	orms := []Deal{}
+
+	query := r.gorm.DB
+
+	if len(allowSort) > 0 && filter.Order != nil && helpers.InArray(*filter.Order, allowSort) {
+		by := "desc"
+		if filter.By != nil && *filter.By == "asc" {
+			by = "asc"
+		}
+
+		order := *filter.Order
+		if strings.HasPrefix(order, "fields.") {
+			order = "fields->>'" + strings.Replace(order, "fields.", "", 1) + "'"
+		}
+
+		query = query.Order(order + " " + by)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	query = r.filter(query, filter)
+
+	if filter.Limit != nil {
+		query = query.Limit(*filter.Limit)
+	} else {
+		query = query.Limit(5)
+	}
+
+	if filter.Offset != nil {
+		query = query.Offset(*filter.Offset)
+	} else {
+		query = query.Offset(0)
+	}
+
+	query = query.Select("*, count(*) OVER() AS total")
+
+	sql := query.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&orms)
+	})
+
+	logrus.Debug("sql: ", sql)
+
+	result := query.Find(&orms)
+	if result.Error != nil {
+		return dms, -1, result.Error
+	}
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	dms = helpers.Map(orms, func(item Deal, _ int) domain.Deal {
+		return toDomain(item)
+	})
+
+	return dms, total, nil
+}
+
+func (r *Repository) filter(query *gorm.DB, filter dto.DealSearchDTO) *gorm.DB {
+	// company_uuid is the partition key
+	query = query.Where("company_uuid = ?", filter.CompanyUUID)
+	query = query.Where("federation_uuid = ?", filter.FederationUUID)
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if filter.Priority != nil {
+		query = query.Where("priority = ?", *filter.Priority)
+	}
+
+	if filter.StageUUID != nil {
+		query = query.Where("stage_uuid = ?", *filter.StageUUID)
+	}
+
+	if filter.Tags != nil && len(*filter.Tags) > 0 {
+		for _, item := range *filter.Tags {
+			query = query.Where("? = ANY (tags)", item)
+		}
+	}
+
+	if filter.Name != nil && len(*filter.Name) >= 1 {
+		query = query.Where("name iLIKE ? OR name iLIKE ?", *filter.Name+"%", "% "+*filter.Name+"%")
+	}
+
+	if filter.IsMy != nil && *filter.IsMy && filter.MyEmail != nil {
+		query = query.Where("created_by = ?", *filter.MyEmail)
+	}
+
+	for _, item := range filter.Fields {
+		where, args := item.Where()
+		query = query.Where(where, args...)
+	}
+
+	return query.Where("deleted_at is null")
+}
+
+type StageTotal struct {
+	StageUUID *uuid.UUID
+	Count     int64
+	Amount    float64
+}
+
+// GetBoardTotals returns count and amount of the filtered deals per stage.
+func (r *Repository) GetBoardTotals(filter dto.DealSearchDTO) (totals []StageTotal, err error) {
+	err = r.filter(r.gorm.DB.Model(&Deal{}), filter).
+		Select("stage_uuid, count(*) AS count, coalesce(sum(amount), 0) AS amount").
+		Group("stage_uuid").
+		Scan(&totals).
+		Error
+
+	return totals, err
+}
+
+// GetBoardDeals returns up to limit of the most recently active deals per stage.
+func (r *Repository) GetBoardDeals(filter dto.DealSearchDTO, limit int) (dms []domain.Deal, err error) {
+	orms := []Deal{}
+
+	sub := r.filter(r.gorm.DB.Model(&Deal{}), filter).
+		Select("*, row_number() OVER (PARTITION BY stage_uuid ORDER BY activity_at DESC) AS rn")
+
+	query := r.gorm.DB.
+		Table("(?) AS d", sub).
+		Where("rn <= ?", limit).
+		Order("activity_at desc")
+
+	sql := query.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&orms)
+	})
+
+	logrus.Debug("sql: ", sql)
+
+	err = query.Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	return helpers.Map(orms, func(item Deal, _ int) domain.Deal {
+		return toDomain(item)
+	}), nil
+}
+
+func (r *Repository) CountStageDeals(companyUUID, stageUUID uuid.UUID) (count int64, err error) {
+	err = r.gorm.DB.
+		Model(&Deal{}).
+		Where("company_uuid = ?", companyUUID).
+		Where("stage_uuid = ?", stageUUID).
+		Where("deleted_at is null").
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+func (r *Repository) CreateStage(dm *domain.DealStage) (err error) {
+	orm := &DealStage{
+		UUID:        dm.UUID,
+		CompanyUUID: dm.CompanyUUID,
+		Name:        dm.Name,
+		Color:       dm.Color,
+		Probability: dm.Probability,
+		Transitions: dm.Transitions,
+		CreatedBy:   dm.CreatedBy,
+		CreatedAt:   dm.CreatedAt,
+	}
+
+	// new stages go to the end of the pipeline
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("select pg_advisory_xact_lock(hashtext(?))", "deal_stages:"+dm.CompanyUUID.String()).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Raw("select coalesce(max(sort), -1) + 1 from deal_stages where company_uuid = ? and deleted_at is null", dm.CompanyUUID).Scan(&orm.Sort).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&orm).Error
+	})
+
+	if err == nil {
+		dm.Sort = orm.Sort
+	}
+
+	return err
+}
+
+func (r *Repository) GetStage(uid uuid.UUID) (dm domain.DealStage, err error) {
+	orm := &DealStage{}
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("этап не найден")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return stageToDomain(*orm), nil
+}
+
+func (r *Repository) GetStages(companyUUID uuid.UUID) (dms []domain.DealStage, err error) {
+	orms := []DealStage{}
+	err = r.gorm.DB.
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Order("sort asc, created_at asc").
+		Find(&orms).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	return helpers.Map(orms, func(item DealStage, _ int) domain.DealStage {
+		return stageToDomain(item)
+	}), nil
+}
+
+func (r *Repository) UpdateStage(dm domain.DealStage) error {
+	res := r.gorm.DB.
+		Model(&DealStage{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"name":        dm.Name,
+			"color":       dm.Color,
+			"probability": dm.Probability,
+			"transitions": UUIDs(dm.Transitions),
+			"updated_at":  "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("этап не найден")
+	}
+
+	return nil
+}
+
+// SortStages sets the stage order, stages missing in uids keep their place after the listed ones.
+func (r *Repository) SortStages(companyUUID uuid.UUID, uids []uuid.UUID) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		for i, uid := range uids {
+			res := tx.
+				Model(&DealStage{}).
+				Where("uuid = ?", uid).
+				Where("company_uuid = ?", companyUUID).
+				Where("deleted_at is null").
+				Updates(map[string]interface{}{
+					"sort":       i,
+					"updated_at": "now()",
+				})
+
+			if res.Error != nil {
+				return res.Error
+			}
+
+			if res.RowsAffected == 0 {
+				return dto.NotFoundErr("этап не найден")
+			}
+		}
+
+		return tx.
+			Model(&DealStage{}).
+			Where("company_uuid = ?", companyUUID).
+			Where("uuid NOT IN ?", uids).
+			Where("deleted_at is null").
+			Update("sort", gorm.Expr("sort + ?", len(uids))).
+			Error
+	})
+}
+
+func (r *Repository) DeleteStage(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&DealStage{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("этап не найден")
+	}
+
+	return nil
+}
+
+func stageToDomain(orm DealStage) domain.DealStage {
+	return domain.DealStage{
+		UUID:        orm.UUID,
+		CompanyUUID: orm.CompanyUUID,
+		Name:        orm.Name,
+		Color:       orm.Color,
+		Probability: orm.Probability,
+		Transitions: orm.Transitions,
+		Sort:        orm.Sort,
+		CreatedBy:   orm.CreatedBy,
+		CreatedAt:   orm.CreatedAt,
+		UpdatedAt:   orm.UpdatedAt,
+	}
+}
+
+func toDomain(orm Deal) domain.Deal {
+	return domain.Deal{
+		UUID:        orm.UUID,
+		ID:          orm.ID,
+		Name:        orm.Name,
+		Description: orm.Description,
+		CreatedBy:   orm.CreatedBy,
+
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+
+		Tags:     orm.Tags,
+		Status:   orm.Status,
+		Priority: orm.Priority,
+
+		StageUUID: orm.StageUUID,
+		Amount:    orm.Amount,
+
+		Fields: orm.Fields,
+		Meta:   orm.Meta,
+
+		FinishedAt: orm.FinishedAt,
+		ActivityAt: orm.ActivityAt,
+		CreatedAt:  orm.CreatedAt,
+		UpdatedAt:  orm.UpdatedAt,
+		DeletedAt:  orm.DeletedAt,
+	}
+}
@@ -0,0 +1,176 @@
+package deals
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+func (s *Service) CreateStage(dm domain.DealStage) (err error) {
+	err = s.checkTransitions(dm)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.CreateStage(&dm)
+}
+
+func (s *Service) GetStage(uid uuid.UUID) (dm domain.DealStage, err error) {
+	return s.repo.GetStage(uid)
+}
+
+func (s *Service) GetStages(companyUUID uuid.UUID) (dms []domain.DealStage, err error) {
+	return s.repo.GetStages(companyUUID)
+}
+
+func (s *Service) UpdateStage(dm domain.DealStage) (err error) {
+	err = s.checkTransitions(dm)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateStage(dm)
+}
+
+func (s *Service) SortStages(companyUUID uuid.UUID, uids []uuid.UUID) (err error) {
+	uids = lo.Uniq(uids)
+	if len(uids) == 0 {
+		return errors.New("порядок этапов не может быть пустым")
+	}
+
+	return s.repo.SortStages(companyUUID, uids)
+}
+
+func (s *Service) DeleteStage(stage domain.DealStage) (err error) {
+	count, err := s.repo.CountStageDeals(stage.CompanyUUID, stage.UUID)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return errors.New("в этапе есть сделки, перенесите их в другой этап")
+	}
+
+	return s.repo.DeleteStage(stage.UUID)
+}
+
+// MoveDeal changes the deal stage, transitions are validated against the company stage graph.
+func (s *Service) MoveDeal(crtr domain.Creator, deal domain.Deal, stageUUID uuid.UUID) (err error) {
+	stages, err := s.repo.GetStages(deal.CompanyUUID)
+	if err != nil {
+		return err
+	}
+
+	stage, found := lo.Find(stages, func(item domain.DealStage) bool {
+		return item.UUID == stageUUID
+	})
+	if !found {
+		return domain.ErrDealStageNotFound
+	}
+
+	oldStage, _ := lo.Find(stages, func(item domain.DealStage) bool {
+		return deal.StageUUID != nil && item.UUID == *deal.StageUUID
+	})
+
+	err = deal.PatchStage(stage, domain.NewDealStageGraph(stages))
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.Update(deal, []string{"stage_uuid"})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.as.DealWasChangedActivity(crtr, deal.UUID, "stage", oldStage.Name, stage.Name)
+
+	return err
+}
+
+// GetBoard groups deals by stage, deals without a stage go to the first column.
+func (s *Service) GetBoard(filter dto.DealSearchDTO, limit int) (board domain.DealBoard, err error) {
+	stages, err := s.repo.GetStages(filter.CompanyUUID)
+	if err != nil {
+		return board, err
+	}
+
+	totals, err := s.repo.GetBoardTotals(filter)
+	if err != nil {
+		return board, err
+	}
+
+	dms, err := s.repo.GetBoardDeals(filter, limit)
+	if err != nil {
+		return board, err
+	}
+
+	column := func(stage *domain.DealStage) domain.DealBoardColumn {
+		col := domain.DealBoardColumn{
+			Stage: stage,
+			Deals: []domain.Deal{},
+		}
+
+		for _, t := range totals {
+			if (stage == nil && t.StageUUID == nil) || (stage != nil && t.StageUUID != nil && *t.StageUUID == stage.UUID) {
+				col.Count += t.Count
+				col.Amount += t.Amount
+			}
+		}
+
+		for _, dm := range dms {
+			if (stage == nil && dm.StageUUID == nil) || (stage != nil && dm.StageUUID != nil && *dm.StageUUID == stage.UUID) {
+				col.Deals = append(col.Deals, dm)
+			}
+		}
+
+		if stage != nil {
+			col.Weighted = stage.Weighted(col.Amount)
+		}
+
+		return col
+	}
+
+	board.Columns = []domain.DealBoardColumn{}
+
+	if noStage := column(nil); noStage.Count > 0 {
+		board.Columns = append(board.Columns, noStage)
+	}
+
+	for i := range stages {
+		board.Columns = append(board.Columns, column(&stages[i]))
+	}
+
+	for _, col := range board.Columns {
+		board.Count += col.Count
+		board.Amount += col.Amount
+		board.Weighted += col.Weighted
+	}
+
+	return board, nil
+}
+
+func (s *Service) checkTransitions(dm domain.DealStage) error {
+	if len(dm.Transitions) == 0 {
+		return nil
+	}
+
+	stages, err := s.repo.GetStages(dm.CompanyUUID)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range dm.Transitions {
+		if t == dm.UUID {
+			return errors.New("этап не может ссылаться на себя")
+		}
+
+		if !lo.ContainsBy(stages, func(item domain.DealStage) bool { return item.UUID == t }) {
+			return domain.ErrDealStageNotFound
+		}
+	}
+
+	return nil
+}
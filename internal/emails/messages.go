@@ -3,6 +3,7 @@ package emails
 import (
 	"bytes"
 	"text/template"
+	"time"
 
 	_ "embed"
 )
@@ -33,6 +34,12 @@ var confirmationTmpl string
 //go:embed reset.html
 var resetTmpl string
 
+//go:embed reminder.html
+var reminderTmpl string
+
+//go:embed deadline.html
+var deadlineTmpl string
+
 func NewConfirmationMessage(code string) (IMessage, error) {
 	templateData := struct {
 		Code string
@@ -71,6 +78,55 @@ func NewResetMessage(code string) (IMessage, error) {
 	return Message{}, nil
 }
 
+func NewReminderMessage(description string, date *time.Time) (IMessage, error) {
+	templateData := struct {
+		Description string
+		Date        string
+	}{
+		Description: description,
+	}
+
+	if date != nil {
+		templateData.Date = date.Format("02.01.2006 15:04")
+	}
+
+	body, err := parseTemplate("reminder", reminderTmpl, templateData)
+
+	if err == nil {
+		return Message{
+			subject: "Напоминание",
+			body:    body,
+		}, nil
+	}
+
+	return Message{}, err
+}
+
+func NewDeadlineMessage(subject, taskName string, taskID int, finishTo time.Time) (IMessage, error) {
+	templateData := struct {
+		Subject  string
+		TaskName string
+		TaskID   int
+		FinishTo string
+	}{
+		Subject:  subject,
+		TaskName: taskName,
+		TaskID:   taskID,
+		FinishTo: finishTo.Format("02.01.2006 15:04"),
+	}
+
+	body, err := parseTemplate("deadline", deadlineTmpl, templateData)
+
+	if err == nil {
+		return Message{
+			subject: subject,
+			body:    body,
+		}, nil
+	}
+
+	return Message{}, err
+}
+
 func parseTemplate(name, templateString string, data interface{}) (string, error) {
 	t, err := template.New(name).Parse(templateString)
 	if err != nil {
@@ -0,0 +1,78 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type Handler func(ctx context.Context, e domain.Event) error
+
+type subscription struct {
+	subscriber string
+	names      []string
+	fn         Handler
+}
+
+// Bus - in-process delivery of domain events to any number of subscribers. Handlers run synchronously
+// in the order they were attached, a failing or panicking handler is logged and affects neither
+// the other handlers nor the publisher.
+type Bus struct {
+	lock sync.RWMutex
+	subs []subscription
+}
+
+func New() *Bus {
+	return &Bus{}
+}
+
+// Subscribe attaches the handler to the events with the names, without names - to every event.
+// The subscriber names the handler in the logs.
+func (b *Bus) Subscribe(subscriber string, fn Handler, names ...string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.subs = append(b.subs, subscription{
+		subscriber: subscriber,
+		names:      names,
+		fn:         fn,
+	})
+}
+
+func (b *Bus) Publish(ctx context.Context, events ...domain.Event) {
+	b.lock.RLock()
+	subs := b.subs
+	b.lock.RUnlock()
+
+	for _, e := range events {
+		for _, sub := range subs {
+			if len(sub.names) > 0 && !lo.Contains(sub.names, e.EventName()) {
+				continue
+			}
+
+			err := sub.handle(ctx, e)
+			if err != nil {
+				logrus.WithField("event", e.EventName()).
+					WithField("subscriber", sub.subscriber).
+					WithField("task", e.Meta().TaskUUID).
+					WithError(err).
+					Error("event handler error")
+			}
+		}
+	}
+}
+
+func (s subscription) handle(ctx context.Context, e domain.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v\n%s", r, string(debug.Stack()))
+		}
+	}()
+
+	return s.fn(ctx, e)
+}
@@ -0,0 +1,49 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestBusPublish(t *testing.T) {
+	bus := New()
+	got := []string{}
+
+	bus.Subscribe("all", func(_ context.Context, e domain.Event) error {
+		got = append(got, "all:"+e.EventName())
+		return nil
+	})
+	bus.Subscribe("failing", func(_ context.Context, _ domain.Event) error {
+		return errors.New("broken")
+	}, domain.EventTaskCreated)
+	bus.Subscribe("panicking", func(_ context.Context, _ domain.Event) error {
+		panic("broken")
+	}, domain.EventTaskCreated)
+	bus.Subscribe("comments", func(_ context.Context, e domain.Event) error {
+		got = append(got, "comments:"+e.(domain.CommentAdded).CommentUUID.String())
+		return nil
+	}, domain.EventCommentAdded)
+
+	comment := uuid.New()
+	meta := domain.NewEventMeta("user@mail.ru", uuid.New(), []string{"boss@mail.ru"})
+
+	bus.Publish(context.Background(),
+		domain.TaskCreated{EventMeta: meta},
+		domain.CommentAdded{EventMeta: meta, CommentUUID: comment},
+	)
+
+	want := []string{"all:" + domain.EventTaskCreated, "all:" + domain.EventCommentAdded, "comments:" + comment.String()}
+	if len(got) != len(want) {
+		t.Fatalf("Publish() = %v, want %v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Publish() = %v, want %v", got, want)
+		}
+	}
+}
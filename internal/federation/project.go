@@ -72,6 +72,7 @@ func (s *Service) GetProject(uid uuid.UUID) (item domain.Project, err error) {
 
 		StatusCode:      orm.Status,
 		StatusUpdatedAt: orm.StatusUpdatedAt,
+		FieldsSort:      orm.FieldsSort,
 	}
 
 	return item, err
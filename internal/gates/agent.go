@@ -0,0 +1,60 @@
+package gates
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+func (a *Service) AgentCreate(federationUUID, userUUID uuid.UUID) error {
+	return a.agentCan(federationUUID, userUUID, domain.PermissionAgentCreate)
+}
+
+func (a *Service) AgentPatch(federationUUID, userUUID uuid.UUID) error {
+	return a.agentCan(federationUUID, userUUID, domain.PermissionAgentPatch)
+}
+
+func (a *Service) AgentDelete(federationUUID, userUUID uuid.UUID) error {
+	return a.agentCan(federationUUID, userUUID, domain.PermissionAgentDelete)
+}
+
+func (a *Service) AgentTimeline(federationUUID, userUUID uuid.UUID) error {
+	return a.federationMember(federationUUID, userUUID)
+}
+
+func (a *Service) AgentDuplicates(federationUUID, userUUID uuid.UUID) error {
+	return a.federationMember(federationUUID, userUUID)
+}
+
+// AgentMerge - the agent takes over the duplicates, which are deleted.
+func (a *Service) AgentMerge(federationUUID, userUUID uuid.UUID) error {
+	if err := a.AgentPatch(federationUUID, userUUID); err != nil {
+		return err
+	}
+
+	return a.AgentDelete(federationUUID, userUUID)
+}
+
+func (a *Service) AgentImport(federationUUID, userUUID uuid.UUID) error {
+	return a.AgentCreate(federationUUID, userUUID)
+}
+
+func (a *Service) AgentExport(federationUUID, userUUID uuid.UUID) error {
+	return a.federationMember(federationUUID, userUUID)
+}
+
+func (a *Service) agentCan(federationUUID, userUUID uuid.UUID, action string) error {
+	return a.can(domain.PermissionScope{FederationUUID: federationUUID}, userUUID, action)
+}
+
+func (a *Service) federationMember(federationUUID, userUUID uuid.UUID) error {
+	fUUIDs := a.dict.GetUserFederatons(userUUID)
+
+	if lo.IndexOf(fUUIDs, federationUUID) == -1 {
+		return fmt.Errorf("федерация не найдена")
+	}
+
+	return nil
+}
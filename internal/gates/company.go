@@ -4,16 +4,13 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
-	"github.com/samber/lo"
+	"github.com/krisch/crm-backend/domain"
 )
 
 func (a *Service) CompanyCreate(federationUUID, userUUID uuid.UUID) error {
-	fUUIDs := a.dict.GetUserFederatons(userUUID)
-
-	hasFederation := lo.IndexOf(fUUIDs, federationUUID)
-
-	if hasFederation == -1 {
-		return fmt.Errorf("федерация не найдена")
+	err := a.can(domain.PermissionScope{FederationUUID: federationUUID}, userUUID, domain.PermissionCompanyCreate)
+	if err != nil {
+		return err
 	}
 
 	federation, found := a.dict.FindFederation(federationUUID)
@@ -32,49 +29,30 @@ func (a *Service) CompanyCreate(federationUUID, userUUID uuid.UUID) error {
 }
 
 func (a *Service) CompanyDelete(companyUUID, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
-
-	hasCompany := lo.IndexOf(cUUIDs, companyUUID)
-
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
-	}
-
-	return nil
+	return a.companyCan(companyUUID, userUUID, domain.PermissionCompanyDelete)
 }
 
 func (a *Service) CompanyPatch(companyUUID, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
-
-	hasCompany := lo.IndexOf(cUUIDs, companyUUID)
-
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
-	}
-
-	return nil
+	return a.companyCan(companyUUID, userUUID, domain.PermissionCompanyPatch)
 }
 
 func (a *Service) CompanyAddUser(companyUUID, _, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
-
-	hasCompany := lo.IndexOf(cUUIDs, companyUUID)
-
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
-	}
-
-	return nil
+	return a.companyCan(companyUUID, userUUID, domain.PermissionCompanyAddUser)
 }
 
 func (a *Service) CompanyRemoveUser(companyUUID, _, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
+	return a.companyCan(companyUUID, userUUID, domain.PermissionCompanyDeleteUser)
+}
 
-	hasCompany := lo.IndexOf(cUUIDs, companyUUID)
+func (a *Service) TagPatch(companyUUID, userUUID uuid.UUID) error {
+	return a.companyCan(companyUUID, userUUID, domain.PermissionTagPatch)
+}
 
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
-	}
+// CatalogPatch - the catalog itself and its fields.
+func (a *Service) CatalogPatch(companyUUID, userUUID uuid.UUID) error {
+	return a.companyCan(companyUUID, userUUID, domain.PermissionCatalogPatch)
+}
 
-	return nil
+func (a *Service) CatalogDataCreate(companyUUID, userUUID uuid.UUID) error {
+	return a.companyCan(companyUUID, userUUID, domain.PermissionCatalogDataCreate)
 }
@@ -0,0 +1,49 @@
+package gates
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+func (a *Service) DealCreate(deal domain.Deal, userUUID uuid.UUID) error {
+	return a.companyCan(deal.CompanyUUID, userUUID, domain.PermissionDealCreate)
+}
+
+func (a *Service) DealGet(deal domain.Deal, userUUID uuid.UUID) error {
+	return a.dealCompany(deal.CompanyUUID, userUUID)
+}
+
+func (a *Service) DealsSearch(companyUUID, userUUID uuid.UUID) error {
+	return a.dealCompany(companyUUID, userUUID)
+}
+
+// DealPatch - moving a deal to another stage is a patch as well.
+func (a *Service) DealPatch(deal domain.Deal, userUUID uuid.UUID) error {
+	return a.companyCan(deal.CompanyUUID, userUUID, domain.PermissionDealPatch)
+}
+
+func (a *Service) DealDelete(deal domain.Deal, userUUID uuid.UUID) error {
+	return a.companyCan(deal.CompanyUUID, userUUID, domain.PermissionDealDelete)
+}
+
+func (a *Service) DealStages(companyUUID, userUUID uuid.UUID) error {
+	return a.dealCompany(companyUUID, userUUID)
+}
+
+// DealStagesEdit - the stages are a company setting.
+func (a *Service) DealStagesEdit(companyUUID, userUUID uuid.UUID) error {
+	return a.companyCan(companyUUID, userUUID, domain.PermissionCompanyPatch)
+}
+
+func (a *Service) dealCompany(companyUUID, userUUID uuid.UUID) error {
+	cUUIDs := a.dict.GetUserCompanies(userUUID)
+
+	if lo.IndexOf(cUUIDs, companyUUID) == -1 {
+		return fmt.Errorf("компания не найдена")
+	}
+
+	return nil
+}
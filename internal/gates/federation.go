@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
@@ -39,54 +41,21 @@ func (a *Service) FederationDelete(federationUUID, userUUID uuid.UUID) error {
 		return fmt.Errorf("федерация не найдена")
 	}
 
-	if federation.CreatedByUUID == nil || *federation.CreatedByUUID == userUUID {
-		return fmt.Errorf("федерцию может удалить только создатель")
+	if federation.CreatedByUUID == nil || *federation.CreatedByUUID != userUUID {
+		return dto.ForbiddenErr("федерацию может удалить только создатель")
 	}
 
 	return nil
 }
 
 func (a *Service) FederationPatch(federationUUID, userUUID uuid.UUID) error {
-	fUUIDs := a.dict.GetUserFederatons(userUUID)
-
-	hasFederation := lo.IndexOf(fUUIDs, federationUUID)
-
-	if hasFederation == -1 {
-		return fmt.Errorf("федерация не найдена")
-	}
-
-	federation, found := a.dict.FindFederation(federationUUID)
-	if !found {
-		return fmt.Errorf("федерация не найдена")
-	}
-
-	if federation.CreatedByUUID == nil || *federation.CreatedByUUID == userUUID {
-		return fmt.Errorf("федерцию может изменить только создатель")
-	}
-
-	return nil
+	return a.can(domain.PermissionScope{FederationUUID: federationUUID}, userUUID, domain.PermissionFederationPatch)
 }
 
 func (a *Service) FederationAddUser(federationUUID, _, userUUID uuid.UUID) error {
-	fUUIDs := a.dict.GetUserFederatons(userUUID)
-
-	hasFederation := lo.IndexOf(fUUIDs, federationUUID)
-
-	if hasFederation == -1 {
-		return fmt.Errorf("федерация не найдена")
-	}
-
-	return nil
+	return a.can(domain.PermissionScope{FederationUUID: federationUUID}, userUUID, domain.PermissionFederationInviteUser)
 }
 
 func (a *Service) FederationRemoveUser(federationUUID, _, userUUID uuid.UUID) error {
-	fUUIDs := a.dict.GetUserFederatons(userUUID)
-
-	hasFederation := lo.IndexOf(fUUIDs, federationUUID)
-
-	if hasFederation == -1 {
-		return fmt.Errorf("федерация не найдена")
-	}
-
-	return nil
+	return a.can(domain.PermissionScope{FederationUUID: federationUUID}, userUUID, domain.PermissionFederationDeleteUser)
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/permissions"
 )
 
 type IDictionary interface {
@@ -11,11 +12,15 @@ type IDictionary interface {
 	GetUserCompanies(userUUID uuid.UUID) []uuid.UUID
 	FindFederation(federationUUID uuid.UUID) (*dto.FederationDTO, bool)
 	FindCompany(uuid uuid.UUID) (*dto.CompanyDTO, bool)
+	FindProject(uid uuid.UUID) (*dto.ProjectDTO, bool)
+	FindUser(email string) (*dto.UserDTO, bool)
+	FindUserByUUID(uid uuid.UUID) (*dto.UserDTO, bool)
 }
 
 type Service struct {
-	dict IDictionary
-	repo *Repository
+	dict  IDictionary
+	repo  *Repository
+	perms *permissions.Service
 
 	federationLimit int
 	companiesLimit  int
@@ -33,10 +38,11 @@ const (
 	PermissionsUserAdmin       Permissions = "user:admin"
 )
 
-func New(repo *Repository, dict IDictionary) *Service {
+func New(repo *Repository, dict IDictionary, perms *permissions.Service) *Service {
 	return &Service{
-		dict: dict,
-		repo: repo,
+		dict:  dict,
+		repo:  repo,
+		perms: perms,
 
 		federationLimit: 3,
 		companiesLimit:  9,
@@ -50,10 +56,10 @@ func (a *Service) CreateOrUpdatePermisson(perm *domain.Permission) error {
 	return a.repo.CreateOrUpdatePermisson(perm)
 }
 
-func (a *Service) DeletePermission(userUUID uuid.UUID) error {
-	return a.repo.DeletePermission(userUUID)
+func (a *Service) DeletePermission(federationUUID, userUUID uuid.UUID) error {
+	return a.repo.DeletePermission(federationUUID, userUUID)
 }
 
-func (a *Service) GetPermisson(uid uuid.UUID) (domain.Permission, error) {
-	return a.repo.GetPermisson(uid)
+func (a *Service) GetPermisson(federationUUID, userUUID uuid.UUID) (domain.Permission, error) {
+	return a.repo.GetPermisson(federationUUID, userUUID)
 }
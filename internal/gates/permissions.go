@@ -0,0 +1,137 @@
+package gates
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// IsSuperuser - the federation owner and the members of its federation:admin groups bypass the rules.
+func (a *Service) IsSuperuser(federationUUID, userUUID uuid.UUID) (bool, error) {
+	federation, found := a.dict.FindFederation(federationUUID)
+	if found && federation.CreatedByUUID != nil && *federation.CreatedByUUID == userUUID {
+		return true, nil
+	}
+
+	return a.repo.HasGroupPermission(federationUUID, userUUID, domain.PermissionFederationAdmin)
+}
+
+// Rules returns the stored rules of the user in the federation, or the defaults if there are none.
+func (a *Service) Rules(federationUUID, userUUID uuid.UUID) (domain.PermissionRules, error) {
+	perm, err := a.repo.GetPermisson(federationUUID, userUUID)
+
+	var notFoundErr dto.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return domain.DefaultPermissionRules, nil
+	}
+
+	if err != nil {
+		return domain.PermissionRules{}, err
+	}
+
+	return perm.Rules, nil
+}
+
+// EffectiveRules - the stored rules narrowed or extended by the roles from the federation down to the scope.
+func (a *Service) EffectiveRules(scope domain.PermissionScope, userUUID uuid.UUID) (rules domain.PermissionRules, superuser bool, err error) {
+	superuser, err = a.IsSuperuser(scope.FederationUUID, userUUID)
+	if err != nil {
+		return rules, false, err
+	}
+
+	if superuser {
+		owner, _ := domain.FindPermissionRole(domain.RoleOwner)
+		return owner.Rules, true, nil
+	}
+
+	base, err := a.Rules(scope.FederationUUID, userUUID)
+	if err != nil {
+		return rules, false, err
+	}
+
+	roles, err := a.perms.GetUserRoles(userUUID, scope.FederationUUID)
+	if err != nil {
+		return rules, false, err
+	}
+
+	return scope.EffectiveRules(base, roles), false, nil
+}
+
+// Scope resolves a project, a company or a federation uuid into its permission scope.
+func (a *Service) Scope(entityUUID uuid.UUID) (domain.PermissionScope, error) {
+	if project, found := a.dict.FindProject(entityUUID); found {
+		return domain.PermissionScope{
+			FederationUUID: project.FederationUUID,
+			CompanyUUID:    lo.ToPtr(project.CompanyUUID),
+			ProjectUUID:    lo.ToPtr(project.UUID),
+		}, nil
+	}
+
+	if company, found := a.dict.FindCompany(entityUUID); found {
+		return domain.PermissionScope{
+			FederationUUID: company.FederationUUID,
+			CompanyUUID:    lo.ToPtr(company.UUID),
+		}, nil
+	}
+
+	if _, found := a.dict.FindFederation(entityUUID); found {
+		return domain.PermissionScope{
+			FederationUUID: entityUUID,
+		}, nil
+	}
+
+	return domain.PermissionScope{}, dto.NotFoundErr("федерация, компания или проект не найдены")
+}
+
+func (a *Service) can(scope domain.PermissionScope, userUUID uuid.UUID, action string) error {
+	if lo.IndexOf(a.dict.GetUserFederatons(userUUID), scope.FederationUUID) == -1 {
+		return dto.ForbiddenErr("федерация не найдена или у вас нет доступа к ней")
+	}
+
+	rules, _, err := a.EffectiveRules(scope, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if !rules.Allows(action) {
+		return dto.ForbiddenErrf("недостаточно прав (%s)", action)
+	}
+
+	return nil
+}
+
+func (a *Service) companyCan(companyUUID, userUUID uuid.UUID, action string) error {
+	if lo.IndexOf(a.dict.GetUserCompanies(userUUID), companyUUID) == -1 {
+		return dto.ForbiddenErr("компания не найдена")
+	}
+
+	company, found := a.dict.FindCompany(companyUUID)
+	if !found {
+		return dto.NotFoundErr("компания не найдена")
+	}
+
+	return a.can(domain.PermissionScope{
+		FederationUUID: company.FederationUUID,
+		CompanyUUID:    &companyUUID,
+	}, userUUID, action)
+}
+
+func (a *Service) projectCan(projectUUID, userUUID uuid.UUID, action string) error {
+	project, found := a.dict.FindProject(projectUUID)
+	if !found {
+		return domain.ErrProjectNotFound
+	}
+
+	if lo.IndexOf(a.dict.GetUserCompanies(userUUID), project.CompanyUUID) == -1 {
+		return dto.ForbiddenErr("компания не найдена")
+	}
+
+	return a.can(domain.PermissionScope{
+		FederationUUID: project.FederationUUID,
+		CompanyUUID:    &project.CompanyUUID,
+		ProjectUUID:    &projectUUID,
+	}, userUUID, action)
+}
@@ -5,16 +5,14 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
 )
 
 func (a *Service) ProjectCreate(project domain.Project, userUUID uuid.UUID) error {
-	fUUIDs := a.dict.GetUserFederatons(userUUID)
-
-	hasFederation := lo.IndexOf(fUUIDs, project.FederationUUID)
-
-	if hasFederation == -1 {
-		return fmt.Errorf("федерация не найдена")
+	err := a.companyCan(project.CompanyUUID, userUUID, domain.PermissionProjectCreate)
+	if err != nil {
+		return err
 	}
 
 	companyDTO, found := a.dict.FindCompany(project.CompanyUUID)
@@ -30,25 +28,40 @@ func (a *Service) ProjectCreate(project domain.Project, userUUID uuid.UUID) erro
 	return nil
 }
 
-func (a *Service) ProjectDelete(project domain.Project, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
+func (a *Service) ProjectDelete(projectUUID, userUUID uuid.UUID) error {
+	return a.projectCan(projectUUID, userUUID, domain.PermissionProjectDelete)
+}
 
-	hasCompany := lo.IndexOf(cUUIDs, project.CompanyUUID)
+func (a *Service) ProjectPatch(projectUUID, userUUID uuid.UUID) error {
+	return a.projectCan(projectUUID, userUUID, domain.PermissionProjectPatch)
+}
 
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
-	}
+func (a *Service) ProjectAddUser(projectUUID, _, userUUID uuid.UUID) error {
+	return a.projectCan(projectUUID, userUUID, domain.PermissionProjectAddUser)
+}
 
-	return nil
+func (a *Service) ProjectRemoveUser(projectUUID, _, userUUID uuid.UUID) error {
+	return a.projectCan(projectUUID, userUUID, domain.PermissionProjectDeleteUser)
 }
 
-func (a *Service) ProjectPatch(project domain.Project, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
+// ProjectWatch - the changes of every task of the project, so not for those who see only some of them.
+func (a *Service) ProjectWatch(projectUUID, userUUID uuid.UUID) error {
+	project, found := a.dict.FindProject(projectUUID)
+	if !found {
+		return domain.ErrProjectNotFound
+	}
+
+	if lo.IndexOf(a.dict.GetUserCompanies(userUUID), project.CompanyUUID) == -1 {
+		return dto.ForbiddenErr("компания не найдена")
+	}
 
-	hasCompany := lo.IndexOf(cUUIDs, project.CompanyUUID)
+	restricted, err := a.TasksRestricted(projectUUID, userUUID)
+	if err != nil {
+		return err
+	}
 
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
+	if restricted {
+		return dto.ForbiddenErr("проект приватный")
 	}
 
 	return nil
@@ -0,0 +1,37 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// ReminderCreate - whoever sees the task and may patch it sets reminders on it.
+func (a *Service) ReminderCreate(task domain.Task, userUUID uuid.UUID) error {
+	if err := a.TaskView(task, userUUID); err != nil {
+		return err
+	}
+
+	return a.projectCan(task.ProjectUUID, userUUID, domain.PermissionTaskPatch)
+}
+
+// ReminderEdit - the author edits their reminder, someone else's needs the task patch right.
+func (a *Service) ReminderEdit(task domain.Task, reminder domain.Reminder, userUUID uuid.UUID) error {
+	if err := a.TaskView(task, userUUID); err != nil {
+		return err
+	}
+
+	if reminder.CreatedByUUID == userUUID {
+		return nil
+	}
+
+	return a.projectCan(task.ProjectUUID, userUUID, domain.PermissionTaskPatch)
+}
+
+// ReminderStatus - the reminded user closes the reminder as well as its author.
+func (a *Service) ReminderStatus(task domain.Task, reminder domain.Reminder, userUUID uuid.UUID) error {
+	if reminder.UserUUID != nil && *reminder.UserUUID == userUUID {
+		return a.TaskView(task, userUUID)
+	}
+
+	return a.ReminderEdit(task, reminder, userUUID)
+}
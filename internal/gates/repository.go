@@ -41,7 +41,7 @@ func (r *Repository) CreateOrUpdatePermisson(perm *domain.Permission) (err error
 	}
 
 	err = r.gorm.DB.Clauses(clause.OnConflict{
-		Columns: []clause.Column{{Name: "user_uuid"}},
+		Columns: []clause.Column{{Name: "federation_uuid"}, {Name: "user_uuid"}},
 		DoUpdates: clause.Assignments(map[string]interface{}{
 			"rules":      perm.Rules,
 			"updated_at": "now()",
@@ -58,9 +58,10 @@ func (r *Repository) CreateOrUpdatePermisson(perm *domain.Permission) (err error
 	return err
 }
 
-func (r *Repository) DeletePermission(userUUID uuid.UUID) error {
+func (r *Repository) DeletePermission(federationUUID, userUUID uuid.UUID) error {
 	res := r.gorm.DB.
 		Model(&Permission{}).
+		Where("federation_uuid = ?", federationUUID).
 		Where("user_uuid = ?", userUUID).
 		Where("deleted_at is null").
 		Update("deleted_at", "now()")
@@ -76,10 +77,11 @@ func (r *Repository) DeletePermission(userUUID uuid.UUID) error {
 	return res.Error
 }
 
-func (r *Repository) GetPermisson(userUUID uuid.UUID) (dm domain.Permission, err error) {
+func (r *Repository) GetPermisson(federationUUID, userUUID uuid.UUID) (dm domain.Permission, err error) {
 	orm := &Permission{}
 
 	res := r.gorm.DB.Model(&orm).
+		Where("federation_uuid = ?", federationUUID).
 		Where("user_uuid = ?", userUUID).
 		Where("deleted_at is null").
 		Find(&orm)
@@ -96,3 +98,24 @@ func (r *Repository) GetPermisson(userUUID uuid.UUID) (dm domain.Permission, err
 		UpdatedAt:      orm.UpdatedAt,
 	}, nil
 }
+
+// HasGroupPermission checks the permissions of every group of the federation the user is a member of.
+func (r *Repository) HasGroupPermission(federationUUID, userUUID uuid.UUID, permission string) (found bool, err error) {
+	err = r.gorm.DB.Raw(`select exists(
+		select 1 from permissions.users u
+		join permissions.groups g on g.uuid = any(u.groups)
+		where u.user_uuid = ? and g.federation_uuid = ? and u.deleted_at is null and g.deleted_at is null
+		and g.state->'permissions' @> to_jsonb(?::text)
+	)`, userUUID, federationUUID, permission).Scan(&found).Error
+
+	return found, err
+}
+
+func (r *Repository) IsProjectMember(projectUUID, userUUID uuid.UUID) (found bool, err error) {
+	err = r.gorm.DB.Raw(`select exists(
+		select 1 from project_users
+		where project_uuid = ? and user_uuid = ? and deleted_at is null
+	)`, projectUUID, userUUID).Scan(&found).Error
+
+	return found, err
+}
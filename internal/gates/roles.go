@@ -0,0 +1,88 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// RoleAssign - whoever may add users to the entity may set the roles of its other members,
+// but can not grant more than they are allowed themselves.
+func (a *Service) RoleAssign(scope domain.PermissionScope, userUUID, memberUUID uuid.UUID, granted domain.PermissionRules) error {
+	err := a.roleManage(scope, userUUID, memberUUID)
+	if err != nil {
+		return err
+	}
+
+	if scope.CompanyUUID != nil {
+		if lo.IndexOf(a.dict.GetUserCompanies(memberUUID), *scope.CompanyUUID) == -1 {
+			return dto.NotFoundErr("пользователь не состоит в компании")
+		}
+	} else if lo.IndexOf(a.dict.GetUserFederatons(memberUUID), scope.FederationUUID) == -1 {
+		return dto.NotFoundErr("пользователь не состоит в федерации")
+	}
+
+	rules, _, err := a.EffectiveRules(scope, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if !rules.Covers(granted) {
+		return dto.ForbiddenErr("нельзя выдать права, которых нет у вас")
+	}
+
+	return nil
+}
+
+// RoleRemove - the roles of a former member are removed as well.
+func (a *Service) RoleRemove(scope domain.PermissionScope, userUUID, memberUUID uuid.UUID) error {
+	return a.roleManage(scope, userUUID, memberUUID)
+}
+
+func (a *Service) roleManage(scope domain.PermissionScope, userUUID, memberUUID uuid.UUID) error {
+	if memberUUID == userUUID {
+		return dto.ForbiddenErr("нельзя менять свои права")
+	}
+
+	switch scope.Type() {
+	case domain.PermissionScopeProject:
+		return a.projectCan(*scope.ProjectUUID, userUUID, domain.PermissionProjectAddUser)
+	case domain.PermissionScopeCompany:
+		return a.companyCan(*scope.CompanyUUID, userUUID, domain.PermissionCompanyAddUser)
+	default:
+		return a.can(scope, userUUID, domain.PermissionFederationInviteUser)
+	}
+}
+
+func (a *Service) RolesView(federationUUID, userUUID uuid.UUID) error {
+	return a.federationMember(federationUUID, userUUID)
+}
+
+// PermissionGrant - whoever may patch the federation may store the rules of its other members,
+// but can not grant more than they are allowed themselves.
+func (a *Service) PermissionGrant(federationUUID, userUUID, memberUUID uuid.UUID, granted domain.PermissionRules) error {
+	if memberUUID == userUUID {
+		return dto.ForbiddenErr("нельзя менять свои права")
+	}
+
+	err := a.FederationPatch(federationUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if lo.IndexOf(a.dict.GetUserFederatons(memberUUID), federationUUID) == -1 {
+		return dto.NotFoundErr("пользователь не состоит в федерации")
+	}
+
+	rules, _, err := a.EffectiveRules(domain.PermissionScope{FederationUUID: federationUUID}, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if !rules.Covers(granted) {
+		return dto.ForbiddenErr("нельзя выдать права, которых нет у вас")
+	}
+
+	return nil
+}
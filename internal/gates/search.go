@@ -0,0 +1,35 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// Search checks the federation membership and limits the query to what the user may see.
+func (a *Service) Search(q *domain.SearchQuery, userUUID uuid.UUID) error {
+	if err := a.federationMember(q.FederationUUID, userUUID); err != nil {
+		return dto.ForbiddenErr(err.Error())
+	}
+
+	user, found := a.dict.FindUserByUUID(userUUID)
+	if !found {
+		return dto.ForbiddenErr("пользователь не найден")
+	}
+
+	superuser, err := a.IsSuperuser(q.FederationUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	q.UserUUID = userUUID
+	q.UserEmail = user.Email
+	q.Superuser = superuser
+	q.CompanyUUIDs = lo.Filter(a.dict.GetUserCompanies(userUUID), func(uid uuid.UUID, _ int) bool {
+		company, found := a.dict.FindCompany(uid)
+		return found && company.FederationUUID == q.FederationUUID
+	})
+
+	return nil
+}
@@ -1,45 +1,109 @@
 package gates
 
 import (
-	"fmt"
-
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
 )
 
 func (a *Service) TaskCreate(task domain.Task, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
+	return a.projectCan(task.ProjectUUID, userUUID, domain.PermissionTaskCreate)
+}
+
+func (a *Service) TaskDelete(task domain.Task, userUUID uuid.UUID) error {
+	if err := a.TaskView(task, userUUID); err != nil {
+		return err
+	}
 
-	hasCompany := lo.IndexOf(cUUIDs, task.CompanyUUID)
+	return a.projectCan(task.ProjectUUID, userUUID, domain.PermissionTaskDelete)
+}
 
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
+func (a *Service) TaskPatch(task domain.Task, userUUID uuid.UUID) error {
+	if err := a.TaskView(task, userUUID); err != nil {
+		return err
 	}
 
-	return nil
+	return a.projectCan(task.ProjectUUID, userUUID, domain.PermissionTaskPatch)
 }
 
-func (a *Service) TaskDelete(task domain.Task, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
+// TaskView - tasks are visible to the members of their company, those of a private project only to its members
+// and the task participants.
+func (a *Service) TaskView(task domain.Task, userUUID uuid.UUID) error {
+	if err := a.TaskViews(task.ProjectUUID, userUUID); err != nil {
+		return err
+	}
+
+	user, found := a.dict.FindUserByUUID(userUUID)
+	if !found {
+		return dto.ForbiddenErr("пользователь не найден")
+	}
 
-	hasCompany := lo.IndexOf(cUUIDs, task.CompanyUUID)
+	visible, err := a.taskVisible(task, userUUID, user.Email)
+	if err != nil {
+		return err
+	}
 
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
+	if !visible {
+		return dto.ForbiddenErr("задача в приватном проекте")
 	}
 
 	return nil
 }
 
-func (a *Service) TaskPatch(task domain.Task, userUUID uuid.UUID) error {
-	cUUIDs := a.dict.GetUserCompanies(userUUID)
+// TaskWatch - the live changes of the task, for whoever sees it.
+func (a *Service) TaskWatch(task domain.Task, userUUID uuid.UUID) error {
+	return a.TaskView(task, userUUID)
+}
+
+// TaskViewers keeps only the emails that can see the task.
+func (a *Service) TaskViewers(task domain.Task, emails []string) []string {
+	return lo.Filter(emails, func(email string, _ int) bool {
+		user, found := a.dict.FindUser(email)
+		if !found {
+			return false
+		}
 
-	hasCompany := lo.IndexOf(cUUIDs, task.CompanyUUID)
+		if a.TaskViews(task.ProjectUUID, user.UUID) != nil {
+			return false
+		}
 
-	if hasCompany == -1 {
-		return fmt.Errorf("компания не найдена")
+		visible, err := a.taskVisible(task, user.UUID, email)
+
+		return err == nil && visible
+	})
+}
+
+// TasksRestricted - the user sees only the tasks of a private project they participate in.
+func (a *Service) TasksRestricted(projectUUID, userUUID uuid.UUID) (bool, error) {
+	project, found := a.dict.FindProject(projectUUID)
+	if !found || !project.Options.IsPrivate() {
+		return false, nil
 	}
 
-	return nil
+	member, err := a.projectMember(project, userUUID)
+
+	return !member, err
+}
+
+func (a *Service) taskVisible(task domain.Task, userUUID uuid.UUID, email string) (bool, error) {
+	project, found := a.dict.FindProject(task.ProjectUUID)
+	if !found || !project.Options.IsPrivate() {
+		return true, nil
+	}
+
+	if lo.Contains(task.People, email) || task.CreatedBy == email {
+		return true, nil
+	}
+
+	return a.projectMember(project, userUUID)
+}
+
+func (a *Service) projectMember(project *dto.ProjectDTO, userUUID uuid.UUID) (bool, error) {
+	superuser, err := a.IsSuperuser(project.FederationUUID, userUUID)
+	if err != nil || superuser {
+		return superuser, err
+	}
+
+	return a.repo.IsProjectMember(project.UUID, userUUID)
 }
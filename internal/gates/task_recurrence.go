@@ -0,0 +1,16 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// TaskRecurrences - the series of a project are seen by the members of its company.
+func (a *Service) TaskRecurrences(projectUUID, userUUID uuid.UUID) error {
+	return a.TaskViews(projectUUID, userUUID)
+}
+
+// TaskRecurrenceEdit - a series creates tasks in its project, so it needs the task create right there.
+func (a *Service) TaskRecurrenceEdit(dm domain.TaskRecurrence, userUUID uuid.UUID) error {
+	return a.projectCan(dm.ProjectUUID, userUUID, domain.PermissionTaskCreate)
+}
@@ -0,0 +1,40 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// TaskTemplates - the templates of a company are seen by its members.
+func (a *Service) TaskTemplates(companyUUID, userUUID uuid.UUID) error {
+	if lo.IndexOf(a.dict.GetUserCompanies(userUUID), companyUUID) == -1 {
+		return dto.ForbiddenErr("компания не найдена")
+	}
+
+	return nil
+}
+
+// TaskTemplateUse - a project gets the templates of its company and its own ones.
+func (a *Service) TaskTemplateUse(tmpl domain.TaskTemplate, projectUUID, userUUID uuid.UUID) error {
+	project, found := a.dict.FindProject(projectUUID)
+	if !found {
+		return domain.ErrProjectNotFound
+	}
+
+	if project.CompanyUUID != tmpl.CompanyUUID || (tmpl.ProjectUUID != nil && *tmpl.ProjectUUID != projectUUID) {
+		return dto.NotFoundErr("шаблон не найден")
+	}
+
+	return a.TaskTemplates(tmpl.CompanyUUID, userUUID)
+}
+
+// TaskTemplateEdit - a project template needs the project patch right, a company one the company patch right.
+func (a *Service) TaskTemplateEdit(tmpl domain.TaskTemplate, userUUID uuid.UUID) error {
+	if tmpl.ProjectUUID != nil {
+		return a.projectCan(*tmpl.ProjectUUID, userUUID, domain.PermissionProjectPatch)
+	}
+
+	return a.companyCan(tmpl.CompanyUUID, userUUID, domain.PermissionCompanyPatch)
+}
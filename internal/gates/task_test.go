@@ -0,0 +1,91 @@
+package gates
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+)
+
+type testDictionary struct {
+	users     []dto.UserDTO
+	projects  []dto.ProjectDTO
+	companies map[uuid.UUID][]uuid.UUID
+}
+
+func (d testDictionary) GetUserFederatons(_ uuid.UUID) []uuid.UUID {
+	return nil
+}
+
+func (d testDictionary) GetUserCompanies(userUUID uuid.UUID) []uuid.UUID {
+	return d.companies[userUUID]
+}
+
+func (d testDictionary) FindFederation(_ uuid.UUID) (*dto.FederationDTO, bool) {
+	return &dto.FederationDTO{}, false
+}
+
+func (d testDictionary) FindCompany(_ uuid.UUID) (*dto.CompanyDTO, bool) {
+	return &dto.CompanyDTO{}, false
+}
+
+func (d testDictionary) FindProject(uid uuid.UUID) (*dto.ProjectDTO, bool) {
+	for _, p := range d.projects {
+		if p.UUID == uid {
+			return &p, true
+		}
+	}
+
+	return &dto.ProjectDTO{}, false
+}
+
+func (d testDictionary) FindUser(email string) (*dto.UserDTO, bool) {
+	for _, u := range d.users {
+		if u.Email == email {
+			return &u, true
+		}
+	}
+
+	return &dto.UserDTO{}, false
+}
+
+func (d testDictionary) FindUserByUUID(uid uuid.UUID) (*dto.UserDTO, bool) {
+	for _, u := range d.users {
+		if u.UUID == uid {
+			return &u, true
+		}
+	}
+
+	return &dto.UserDTO{}, false
+}
+
+func TestTaskViewCompany(t *testing.T) {
+	company, other := uuid.New(), uuid.New()
+	member := dto.UserDTO{UUID: uuid.New(), Email: "member@mail.ru"}
+	stranger := dto.UserDTO{UUID: uuid.New(), Email: "stranger@mail.ru"}
+	project := dto.ProjectDTO{UUID: uuid.New(), CompanyUUID: company}
+
+	gates := New(nil, testDictionary{
+		users:    []dto.UserDTO{member, stranger},
+		projects: []dto.ProjectDTO{project},
+		companies: map[uuid.UUID][]uuid.UUID{
+			member.UUID:   {company},
+			stranger.UUID: {other},
+		},
+	}, nil)
+
+	task := domain.Task{UUID: uuid.New(), ProjectUUID: project.UUID, CreatedBy: stranger.Email, People: []string{stranger.Email}}
+
+	if err := gates.TaskView(task, member.UUID); err != nil {
+		t.Errorf("TaskView() of a company member error = %v", err)
+	}
+
+	if err := gates.TaskView(task, stranger.UUID); err == nil {
+		t.Errorf("TaskView() of a user of another company should fail")
+	}
+
+	if got := gates.TaskViewers(task, []string{member.Email, stranger.Email}); len(got) != 1 || got[0] != member.Email {
+		t.Errorf("TaskViewers() = %v", got)
+	}
+}
@@ -0,0 +1,47 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+func (a *Service) TaskViews(projectUUID, userUUID uuid.UUID) error {
+	project, found := a.dict.FindProject(projectUUID)
+	if !found {
+		return domain.ErrProjectNotFound
+	}
+
+	if lo.IndexOf(a.dict.GetUserCompanies(userUUID), project.CompanyUUID) == -1 {
+		return dto.ForbiddenErr("компания не найдена")
+	}
+
+	return nil
+}
+
+// TaskViewUse - a private view is available only to its creator.
+func (a *Service) TaskViewUse(view domain.TaskView, userUUID uuid.UUID) error {
+	if err := a.TaskViews(view.ProjectUUID, userUUID); err != nil {
+		return err
+	}
+
+	if !view.VisibleTo(userUUID) {
+		return dto.NotFoundErr("представление не найдено")
+	}
+
+	return nil
+}
+
+// TaskViewEdit - the creator edits a private view, a shared one needs the project patch right.
+func (a *Service) TaskViewEdit(view domain.TaskView, userUUID uuid.UUID) error {
+	if err := a.TaskViewUse(view, userUUID); err != nil {
+		return err
+	}
+
+	if !view.Shared && view.UserUUID == userUUID {
+		return nil
+	}
+
+	return a.projectCan(view.ProjectUUID, userUUID, domain.PermissionProjectPatch)
+}
@@ -0,0 +1,16 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// WebhookEdit - a company webhook needs the company patch right, a federation one the federation patch right.
+// The webhooks hold secrets and see every change, so they are not shown to the others.
+func (a *Service) WebhookEdit(hook domain.Webhook, userUUID uuid.UUID) error {
+	if hook.CompanyUUID != nil {
+		return a.companyCan(*hook.CompanyUUID, userUUID, domain.PermissionCompanyPatch)
+	}
+
+	return a.FederationPatch(hook.FederationUUID, userUUID)
+}
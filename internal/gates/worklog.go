@@ -0,0 +1,46 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+)
+
+// WorklogCreate - whoever sees the task and may patch it logs their own time on it.
+func (a *Service) WorklogCreate(task domain.Task, userUUID uuid.UUID) error {
+	if err := a.TaskView(task, userUUID); err != nil {
+		return err
+	}
+
+	return a.projectCan(task.ProjectUUID, userUUID, domain.PermissionTaskPatch)
+}
+
+// WorklogEdit - the author edits their worklog, someone else's needs the task patch right.
+func (a *Service) WorklogEdit(task domain.Task, worklog domain.TaskWorklog, userUUID uuid.UUID) error {
+	if err := a.TaskView(task, userUUID); err != nil {
+		return err
+	}
+
+	if worklog.UserUUID == userUUID {
+		return nil
+	}
+
+	return a.projectCan(task.ProjectUUID, userUUID, domain.PermissionTaskPatch)
+}
+
+func (a *Service) WorklogProject(projectUUID, userUUID uuid.UUID) error {
+	return a.TaskViews(projectUUID, userUUID)
+}
+
+// Timesheet - a user sees their own timesheet, someone else's needs the federation patch right.
+func (a *Service) Timesheet(federationUUID, targetUUID, userUUID uuid.UUID) error {
+	if err := a.federationMember(federationUUID, userUUID); err != nil {
+		return dto.ForbiddenErr(err.Error())
+	}
+
+	if targetUUID == userUUID {
+		return nil
+	}
+
+	return a.can(domain.PermissionScope{FederationUUID: federationUUID}, userUUID, domain.PermissionFederationPatch)
+}
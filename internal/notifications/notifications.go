@@ -7,19 +7,22 @@ import (
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/aggregates"
 	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/profile"
 )
 
 type Service struct {
 	repo *Repository
 	dict *dictionary.Service
 	aggs *aggregates.Service
+	prof *profile.Service
 }
 
-func New(repo *Repository, aggs *aggregates.Service, dict *dictionary.Service) *Service {
+func New(repo *Repository, aggs *aggregates.Service, dict *dictionary.Service, prof *profile.Service) *Service {
 	return &Service{
 		repo: repo,
 		aggs: aggs,
 		dict: dict,
+		prof: prof,
 	}
 }
 
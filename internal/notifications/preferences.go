@@ -0,0 +1,25 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// Recipients - the people who want to be notified of the event of a task of the project in the channel now.
+// The people without preferences get the defaults, so does everyone when the preferences can not be read.
+func (s *Service) Recipients(e domain.Event, projectUUID uuid.UUID, people []string, channel string) []string {
+	prefs, err := s.prof.GetPreferencesByEmails(people)
+	if err != nil {
+		logrus.WithField("task", e.Meta().TaskUUID).WithError(err).Error("notification preferences error")
+	}
+
+	now := time.Now()
+
+	return lo.Filter(people, func(email string, _ int) bool {
+		return prefs[email].Notify(domain.NotificationKind(e, email), channel, projectUUID, now)
+	})
+}
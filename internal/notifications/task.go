@@ -6,29 +6,37 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/aggregates"
 	"github.com/sirupsen/logrus"
 )
 
-func (s *Service) CreateTaskState(uid uuid.UUID, people []string) error {
+// CreateTaskState stores the task state for the people who want the event in-app,
+// a deleted task is removed from the notifications of everyone.
+func (s *Service) CreateTaskState(e domain.Event, people []string) error {
+	uid := e.Meta().TaskUUID
+
 	task, err := s.aggs.GetTaskWithFields(context.TODO(), uid)
 	if err != nil {
 		logrus.Error("send task updated or created error: ", err)
 		return err
 	}
 
-	for _, p := range people {
-		user, ok := s.dict.FindUser(p)
-		if !ok {
-			logrus.Errorf("user not found: %s", p)
-			continue
-		}
-
-		if task.DeletedAt != nil {
+	if task.DeletedAt != nil {
+		for _, p := range people {
 			err := s.repo.RemoveNotification(p, "task:"+uid.String())
 			if err != nil {
 				logrus.Error("RemoveNotification error: ", err)
 			}
+		}
+
+		return nil
+	}
+
+	for _, p := range s.Recipients(e, task.Project.UUID, people, domain.NotificationInApp) {
+		user, ok := s.dict.FindUser(p)
+		if !ok {
+			logrus.Errorf("user not found: %s", p)
 			continue
 		}
 
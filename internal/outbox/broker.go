@@ -0,0 +1,136 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+	"github.com/segmentio/kafka-go"
+)
+
+// Broker publishes a batch of messages in their order. PublishErrors tells which messages of the batch failed,
+// any other error fails the whole batch.
+type Broker interface {
+	Publish(ctx context.Context, msgs []domain.OutboxMessage) error
+}
+
+// PublishErrors - an error per message of the batch, nil for the published ones.
+type PublishErrors []error
+
+func (e PublishErrors) Error() string {
+	return helpers.Join(lo.FilterMap(e, func(err error, _ int) (string, bool) {
+		if err == nil {
+			return "", false
+		}
+		return err.Error(), true
+	}), ", ")
+}
+
+// KafkaBroker - the message key is the aggregate key, the writer must balance by hash to keep the order of a key.
+// The writer is built on the first publish, so nothing of kafka is needed while the relay is off.
+type KafkaBroker struct {
+	newWriter func() (*kafka.Writer, error)
+
+	once   sync.Once
+	writer *kafka.Writer
+	err    error
+}
+
+func NewKafkaBroker(newWriter func() (*kafka.Writer, error)) *KafkaBroker {
+	return &KafkaBroker{
+		newWriter: newWriter,
+	}
+}
+
+func (b *KafkaBroker) Publish(ctx context.Context, msgs []domain.OutboxMessage) error {
+	b.once.Do(func() {
+		b.writer, b.err = b.newWriter()
+	})
+	if b.err != nil {
+		return b.err
+	}
+
+	kms := make([]kafka.Message, 0, len(msgs))
+	for _, m := range msgs {
+		value, err := json.Marshal(dto.NewOutboxMessageDTO(m))
+		if err != nil {
+			return err
+		}
+
+		kms = append(kms, kafka.Message{
+			Key:   []byte(m.Key()),
+			Value: value,
+			Headers: []kafka.Header{
+				{Key: "aggregate", Value: []byte(m.Aggregate)},
+				{Key: "operation", Value: []byte(m.Operation)},
+			},
+		})
+	}
+
+	err := b.writer.WriteMessages(ctx, kms...)
+
+	var werrs kafka.WriteErrors
+	if errors.As(err, &werrs) {
+		return PublishErrors(werrs)
+	}
+
+	return err
+}
+
+// MemoryBroker - an in-process stand-in for kafka keeping the published messages in their order.
+type MemoryBroker struct {
+	lock     sync.Mutex
+	messages []domain.OutboxMessage
+
+	// Reject - when set, a message it returns an error for is not published
+	// and neither are the later messages of its key in the batch, as with a failed partition write
+	Reject func(m domain.OutboxMessage) error
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{}
+}
+
+func (b *MemoryBroker) Publish(_ context.Context, msgs []domain.OutboxMessage) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	errs := make(PublishErrors, len(msgs))
+	failed := map[string]error{}
+
+	for i, m := range msgs {
+		if err, ok := failed[m.Key()]; ok {
+			errs[i] = err
+			continue
+		}
+
+		if b.Reject != nil {
+			errs[i] = b.Reject(m)
+		}
+
+		if errs[i] != nil {
+			failed[m.Key()] = errs[i]
+			continue
+		}
+
+		b.messages = append(b.messages, m)
+	}
+
+	if len(failed) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func (b *MemoryBroker) Messages() []domain.OutboxMessage {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return append([]domain.OutboxMessage{}, b.messages...)
+}
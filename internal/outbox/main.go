@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/configs"
+)
+
+type Service struct {
+	repo        *Repository
+	broker      Broker
+	maxAttempts int
+}
+
+func New(repo *Repository, broker Broker, conf *configs.Configs) *Service {
+	return &Service{
+		repo:        repo,
+		broker:      broker,
+		maxAttempts: conf.OUTBOX_MAX_ATTEMPTS,
+	}
+}
+
+// Relay publishes the first ready messages, a message is deleted from the outbox
+// only once the broker has acknowledged it, so it is delivered at least once.
+func (s *Service) Relay(ctx context.Context, now time.Time, limit int) (batch domain.OutboxBatch, err error) {
+	err = s.repo.Relay(limit, now, func(ready []domain.OutboxMessage) domain.OutboxBatch {
+		batch = Deliver(ctx, s.broker, ready, now, s.maxAttempts)
+		return batch
+	})
+
+	return batch, err
+}
+
+// Deliver publishes the messages in one batch. A failed message is retried later or, out of attempts, dead;
+// the later messages of its key stay pending as they are to be published after it.
+func Deliver(ctx context.Context, broker Broker, msgs []domain.OutboxMessage, now time.Time, maxAttempts int) (batch domain.OutboxBatch) {
+	if len(msgs) == 0 {
+		return batch
+	}
+
+	errs := make([]error, len(msgs))
+	if err := broker.Publish(ctx, msgs); err != nil {
+		var perMessage PublishErrors
+		if errors.As(err, &perMessage) && len(perMessage) == len(msgs) {
+			copy(errs, perMessage)
+		} else {
+			for i := range errs {
+				errs[i] = err
+			}
+		}
+	}
+
+	failed := map[string]bool{}
+	for i, m := range msgs {
+		switch {
+		case failed[m.Key()]:
+			continue
+		case errs[i] != nil:
+			failed[m.Key()] = true
+
+			if m.Fail(errs[i], now, maxAttempts) {
+				batch.Dead = append(batch.Dead, m)
+			} else {
+				batch.Retried = append(batch.Retried, m)
+			}
+		default:
+			batch.Published = append(batch.Published, m.ID)
+		}
+	}
+
+	return batch
+}
@@ -0,0 +1,43 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type Outbox struct {
+	ID            int64          `gorm:"<-:false;primary_key"`
+	UUID          uuid.UUID      `gorm:"<-:false;type:uuid"`
+	Aggregate     string         `gorm:"<-:false;type:varchar(50)"`
+	AggregateUUID uuid.UUID      `gorm:"<-:false;type:uuid"`
+	Operation     string         `gorm:"<-:false;type:varchar(10)"`
+	Payload       datatypes.JSON `gorm:"<-:false;type:jsonb"`
+	CreatedAt     time.Time      `gorm:"<-:false;type:timestamptz"`
+
+	Attempts      int       `gorm:"type:integer"`
+	NextAttemptAt time.Time `gorm:"type:timestamptz"`
+	LastError     string    `gorm:"type:text"`
+}
+
+func (o *Outbox) TableName() string {
+	return "outbox"
+}
+
+type OutboxDeadLetter struct {
+	ID            int64          `gorm:"<-:create;primary_key"`
+	UUID          uuid.UUID      `gorm:"<-:create;type:uuid"`
+	Aggregate     string         `gorm:"<-:create;type:varchar(50)"`
+	AggregateUUID uuid.UUID      `gorm:"<-:create;type:uuid"`
+	Operation     string         `gorm:"<-:create;type:varchar(10)"`
+	Payload       datatypes.JSON `gorm:"<-:create;type:jsonb"`
+	Attempts      int            `gorm:"<-:create;type:integer"`
+	LastError     string         `gorm:"<-:create;type:text"`
+	CreatedAt     time.Time      `gorm:"<-:create;type:timestamptz"`
+	FailedAt      time.Time      `gorm:"<-:create;type:timestamptz"`
+}
+
+func (o *OutboxDeadLetter) TableName() string {
+	return "outbox_dead_letters"
+}
@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type brokenBroker struct{}
+
+func (brokenBroker) Publish(_ context.Context, _ []domain.OutboxMessage) error {
+	return errors.New("broker is down")
+}
+
+func messages(keys ...uuid.UUID) []domain.OutboxMessage {
+	msgs := []domain.OutboxMessage{}
+	for i, key := range keys {
+		msgs = append(msgs, domain.OutboxMessage{
+			ID:            int64(i + 1),
+			Aggregate:     "task",
+			AggregateUUID: key,
+			Operation:     domain.OutboxUpdate,
+		})
+	}
+
+	return msgs
+}
+
+func ids(msgs []domain.OutboxMessage) []int64 {
+	res := []int64{}
+	for _, m := range msgs {
+		res = append(res, m.ID)
+	}
+
+	return res
+}
+
+func equal(got, want []int64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestDeliver(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	a, b := uuid.New(), uuid.New()
+
+	t.Run("published in order", func(t *testing.T) {
+		broker := NewMemoryBroker()
+		batch := Deliver(ctx, broker, messages(a, b, a), now, 3)
+
+		if !equal(batch.Published, []int64{1, 2, 3}) || len(batch.Retried) != 0 || len(batch.Dead) != 0 {
+			t.Errorf("Deliver() = %+v", batch)
+		}
+
+		if got := ids(broker.Messages()); !equal(got, []int64{1, 2, 3}) {
+			t.Errorf("broker got %v", got)
+		}
+	})
+
+	t.Run("failed message holds back its key", func(t *testing.T) {
+		broker := NewMemoryBroker()
+		broker.Reject = func(m domain.OutboxMessage) error {
+			if m.ID == 1 {
+				return errors.New("rejected")
+			}
+			return nil
+		}
+
+		batch := Deliver(ctx, broker, messages(a, b, a), now, 3)
+
+		if !equal(batch.Published, []int64{2}) || !equal(ids(batch.Retried), []int64{1}) || len(batch.Dead) != 0 {
+			t.Fatalf("Deliver() = %+v", batch)
+		}
+
+		retried := batch.Retried[0]
+		if retried.Attempts != 1 || retried.LastError != "rejected" || !retried.NextAttemptAt.After(now) {
+			t.Errorf("retried = %+v", retried)
+		}
+
+		if got := ids(broker.Messages()); !equal(got, []int64{2}) {
+			t.Errorf("broker got %v", got)
+		}
+	})
+
+	t.Run("out of attempts is dead", func(t *testing.T) {
+		broker := NewMemoryBroker()
+		broker.Reject = func(m domain.OutboxMessage) error {
+			return errors.New("rejected")
+		}
+
+		msgs := messages(a)
+		msgs[0].Attempts = 2
+
+		batch := Deliver(ctx, broker, msgs, now, 3)
+		if len(batch.Published) != 0 || len(batch.Retried) != 0 || !equal(ids(batch.Dead), []int64{1}) {
+			t.Errorf("Deliver() = %+v", batch)
+		}
+	})
+
+	t.Run("broken broker fails the first message of every key", func(t *testing.T) {
+		batch := Deliver(ctx, brokenBroker{}, messages(a, b, a, b), now, 3)
+
+		if len(batch.Published) != 0 || !equal(ids(batch.Retried), []int64{1, 2}) {
+			t.Errorf("Deliver() = %+v", batch)
+		}
+	})
+
+	t.Run("retry keeps the order of a key", func(t *testing.T) {
+		broker := NewMemoryBroker()
+		rejected := false
+		broker.Reject = func(m domain.OutboxMessage) error {
+			if m.ID == 1 && !rejected {
+				rejected = true
+				return errors.New("rejected")
+			}
+			return nil
+		}
+
+		pending := messages(a, a, b)
+		batch := Deliver(ctx, broker, domain.OutboxReady(pending, now), now, 3)
+		pending[0] = batch.Retried[0]
+
+		later := now.Add(domain.OutboxBackoff(1))
+		Deliver(ctx, broker, domain.OutboxReady(pending[:2], later), later, 3)
+
+		if got := ids(broker.Messages()); !equal(got, []int64{3, 1, 2}) {
+			t.Errorf("broker got %v", got)
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// relayLock - the advisory lock key, only one replica relays at a time to keep the order of a key
+const relayLock = "outbox:relay"
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+// readyQuery - the messages to publish now in id order, a message waiting for its retry holds back
+// the later messages of its key only (domain.OutboxReady in SQL)
+const readyQuery = `
+select o.* from outbox o
+where o.next_attempt_at <= @now
+and not exists (
+	select 1 from outbox h
+	where h.aggregate = o.aggregate and h.aggregate_uuid = o.aggregate_uuid
+	and h.id < o.id and h.next_attempt_at > @now
+)
+order by o.id
+limit @limit`
+
+// Relay hands the first ready messages to fn and then stores what it has done with them.
+// fn publishes outside of any transaction, the relay lock is held by the session for the whole pass.
+// Published messages are deleted only after fn returns, so a crash in between publishes them again.
+func (r *Repository) Relay(limit int, now time.Time, fn func(ready []domain.OutboxMessage) domain.OutboxBatch) error {
+	return r.gorm.DB.Connection(func(conn *gorm.DB) error {
+		locked := false
+		err := conn.Raw("select pg_try_advisory_lock(hashtext(?))", relayLock).Scan(&locked).Error
+		if err != nil || !locked {
+			return err
+		}
+
+		defer conn.Exec("select pg_advisory_unlock(hashtext(?))", relayLock)
+
+		orms := []Outbox{}
+		err = conn.Raw(readyQuery, map[string]interface{}{"now": now, "limit": limit}).Scan(&orms).Error
+		if err != nil || len(orms) == 0 {
+			return err
+		}
+
+		batch := fn(lo.Map(orms, func(item Outbox, _ int) domain.OutboxMessage {
+			return toDomain(item)
+		}))
+
+		return conn.Transaction(func(tx *gorm.DB) error {
+			return store(tx, batch, now)
+		})
+	})
+}
+
+func store(tx *gorm.DB, batch domain.OutboxBatch, now time.Time) error {
+	if len(batch.Published) > 0 {
+		err := tx.Where("id IN ?", batch.Published).Delete(&Outbox{}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, dm := range batch.Retried {
+		err := tx.Model(&Outbox{}).
+			Where("id = ?", dm.ID).
+			Updates(map[string]interface{}{
+				"attempts":        dm.Attempts,
+				"next_attempt_at": dm.NextAttemptAt,
+				"last_error":      dm.LastError,
+			}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(batch.Dead) > 0 {
+		dead := lo.Map(batch.Dead, func(dm domain.OutboxMessage, _ int) OutboxDeadLetter {
+			return toDeadLetter(dm, now)
+		})
+
+		err := tx.Create(&dead).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("id IN ?", lo.Map(batch.Dead, func(dm domain.OutboxMessage, _ int) int64 {
+			return dm.ID
+		})).Delete(&Outbox{}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetCapture turns the capturing triggers on or off for every replica, the pending messages are kept
+// and relayed whenever a relay runs.
+func (r *Repository) SetCapture(enabled bool) error {
+	return r.gorm.DB.Exec("update outbox_settings set enabled = ?", enabled).Error
+}
+
+func toDomain(orm Outbox) domain.OutboxMessage {
+	return domain.OutboxMessage{
+		ID:            orm.ID,
+		UUID:          orm.UUID,
+		Aggregate:     orm.Aggregate,
+		AggregateUUID: orm.AggregateUUID,
+		Operation:     orm.Operation,
+		Payload:       json.RawMessage(orm.Payload),
+		CreatedAt:     orm.CreatedAt,
+		Attempts:      orm.Attempts,
+		NextAttemptAt: orm.NextAttemptAt,
+		LastError:     orm.LastError,
+	}
+}
+
+func toDeadLetter(dm domain.OutboxMessage, failedAt time.Time) OutboxDeadLetter {
+	return OutboxDeadLetter{
+		ID:            dm.ID,
+		UUID:          dm.UUID,
+		Aggregate:     dm.Aggregate,
+		AggregateUUID: dm.AggregateUUID,
+		Operation:     dm.Operation,
+		Payload:       datatypes.JSON(dm.Payload),
+		Attempts:      dm.Attempts,
+		LastError:     dm.LastError,
+		CreatedAt:     dm.CreatedAt,
+		FailedAt:      failedAt,
+	}
+}
@@ -38,7 +38,8 @@ func New(repo *Repository) *Service {
 	}
 }
 
-func (a *Service) UCreateGroup(crtr domain.Creator, name string, permissions []string) (uuid.UUID, error) {
+// UCreateGroup - the permissions of the group apply in the federation only.
+func (a *Service) UCreateGroup(crtr domain.Creator, federationUUID uuid.UUID, name string, permissions []string) (uuid.UUID, error) {
 	allowedPermissions := a.AllowedPermissions()
 	for _, permission := range permissions {
 		if lo.IndexOf(allowedPermissions, permission) == -1 {
@@ -46,7 +47,7 @@ func (a *Service) UCreateGroup(crtr domain.Creator, name string, permissions []s
 		}
 	}
 
-	return a.repo.UCreateGroup(crtr, name, permissions)
+	return a.repo.UCreateGroup(crtr, federationUUID, name, permissions)
 }
 
 func (a *Service) DeleteGroup(uid uuid.UUID) error {
@@ -57,6 +58,22 @@ func (a *Service) AddUserToGroup(userUUID, groupUUID uuid.UUID) error {
 	return a.repo.AddUserToGroup(userUUID, groupUUID)
 }
 
+func (a *Service) Roles() []domain.PermissionRole {
+	return domain.PermissionRoles
+}
+
+func (a *Service) AssignRole(dm *domain.PermissionRoleAssignment) error {
+	return a.repo.CreateOrUpdateRole(dm)
+}
+
+func (a *Service) RemoveRole(userUUID, entityUUID uuid.UUID) error {
+	return a.repo.DeleteRole(userUUID, entityUUID)
+}
+
+func (a *Service) GetUserRoles(userUUID, federationUUID uuid.UUID) ([]domain.PermissionRoleAssignment, error) {
+	return a.repo.GetUserRoles(userUUID, federationUUID)
+}
+
 // func (a *Service) RemoveUserFromGroup(name string, userUUID uuid.UUID) error {
 // 	logrus.Info("RemoveUserFromGroup", name, userUUID)
 // 	return nil
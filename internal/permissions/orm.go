@@ -8,12 +8,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Group struct {
-	UUID  uuid.UUID `json:"uuid" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
-	Name  string    `json:"name" gorm:"unique;not null"`
-	State Meta      `json:"permissions" gorm:"type:jsonb;default:'{}'::jsonb;not null"`
+	UUID           uuid.UUID `json:"uuid" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	FederationUUID uuid.UUID `json:"federation_uuid" gorm:"<-:create;"`
+	Name           string    `json:"name" gorm:"unique;not null"`
+	State          Meta      `json:"permissions" gorm:"type:jsonb;default:'{}'::jsonb;not null"`
 
 	CreatedBy uuid.UUID `json:"created_by"`
 
@@ -58,3 +60,24 @@ func (j *Meta) Scan(value interface{}) error {
 func (j Meta) Value() (driver.Value, error) {
 	return json.Marshal(j)
 }
+
+type Role struct {
+	UUID           uuid.UUID      `json:"uuid" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserUUID       uuid.UUID      `json:"user_uuid" gorm:"<-:create;"`
+	FederationUUID uuid.UUID      `json:"federation_uuid" gorm:"<-:create;"`
+	Scope          string         `json:"scope"`
+	EntityUUID     uuid.UUID      `json:"entity_uuid" gorm:"<-:create;"`
+	Role           string         `json:"role"`
+	Allow          pq.StringArray `json:"allow" gorm:"type:text[]"`
+	Deny           pq.StringArray `json:"deny" gorm:"type:text[]"`
+
+	CreatedBy string `json:"created_by"`
+
+	CreatedAt time.Time  `json:"created_at" gorm:"<-:create;"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+func (u *Role) TableName() string {
+	return "permissions.roles"
+}
@@ -32,9 +32,10 @@ func (r *Repository) PubUpdate() {
 	}
 }
 
-func (r *Repository) UCreateGroup(crtr domain.Creator, name string, permissions []string) (uuid.UUID, error) {
+func (r *Repository) UCreateGroup(crtr domain.Creator, federationUUID uuid.UUID, name string, permissions []string) (uuid.UUID, error) {
 	group := &Group{
-		Name: name,
+		FederationUUID: federationUUID,
+		Name:           name,
 		State: Meta{
 			"permissions": permissions,
 		},
@@ -89,3 +90,91 @@ func (r *Repository) AddUserToGroup(userUUID, groupUUID uuid.UUID) error {
 
 	return err
 }
+
+func (r *Repository) CreateOrUpdateRole(dm *domain.PermissionRoleAssignment) error {
+	orm := &Role{
+		UUID:           dm.UUID,
+		UserUUID:       dm.UserUUID,
+		FederationUUID: dm.FederationUUID,
+		Scope:          dm.Scope,
+		EntityUUID:     dm.EntityUUID,
+		Role:           dm.Role,
+		Allow:          dm.Allow,
+		Deny:           dm.Deny,
+		CreatedBy:      dm.CreatedBy,
+	}
+
+	err := r.gorm.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_uuid"}, {Name: "entity_uuid"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"role":       orm.Role,
+			"allow":      orm.Allow,
+			"deny":       orm.Deny,
+			"created_by": orm.CreatedBy,
+			"updated_at": "now()",
+			"deleted_at": nil,
+		}),
+	}).Clauses(clause.Returning{Columns: []clause.Column{{Name: "uuid"}, {Name: "created_at"}, {Name: "updated_at"}}}).
+		Create(&orm).Error
+	if err != nil {
+		return err
+	}
+
+	dm.UUID = orm.UUID
+	dm.CreatedAt = orm.CreatedAt
+	dm.UpdatedAt = orm.UpdatedAt
+
+	r.PubUpdate()
+
+	return nil
+}
+
+func (r *Repository) DeleteRole(userUUID, entityUUID uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Role{}).
+		Where("user_uuid = ?", userUUID).
+		Where("entity_uuid = ?", entityUUID).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("роль не найдена")
+	}
+
+	r.PubUpdate()
+
+	return nil
+}
+
+func (r *Repository) GetUserRoles(userUUID, federationUUID uuid.UUID) (dms []domain.PermissionRoleAssignment, err error) {
+	orms := []Role{}
+
+	err = r.gorm.DB.
+		Where("user_uuid = ?", userUUID).
+		Where("federation_uuid = ?", federationUUID).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&orms).Error
+
+	for _, orm := range orms {
+		dms = append(dms, domain.PermissionRoleAssignment{
+			UUID:           orm.UUID,
+			UserUUID:       orm.UserUUID,
+			FederationUUID: orm.FederationUUID,
+			Scope:          orm.Scope,
+			EntityUUID:     orm.EntityUUID,
+			Role:           orm.Role,
+			Allow:          orm.Allow,
+			Deny:           orm.Deny,
+			CreatedBy:      orm.CreatedBy,
+			CreatedAt:      orm.CreatedAt,
+			UpdatedAt:      orm.UpdatedAt,
+		})
+	}
+
+	return dms, err
+}
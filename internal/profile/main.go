@@ -450,6 +450,13 @@ func (s *Service) ChangePreferences(uid uuid.UUID, prefs domain.ProfilePreferenc
 		}
 	}
 
+	if prefs.Notifications != nil {
+		err = prefs.Notifications.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	err = s.repo.gorm.DB.
 		Exec("UPDATE users SET updated_at = NOW(), preferences = preferences || ? WHERE uuid = ?", j, uid).
 		Error
@@ -457,6 +464,14 @@ func (s *Service) ChangePreferences(uid uuid.UUID, prefs domain.ProfilePreferenc
 	return err
 }
 
+func (s *Service) GetPreferencesByEmails(emails []string) (map[string]domain.ProfilePreferences, error) {
+	if len(emails) == 0 {
+		return map[string]domain.ProfilePreferences{}, nil
+	}
+
+	return s.repo.GetPreferencesByEmails(emails)
+}
+
 func (s *Service) isDev() bool {
 	return s.conf.ENV == "dev"
 }
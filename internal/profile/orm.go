@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"gorm.io/datatypes"
 )
@@ -37,7 +38,8 @@ type User struct {
 }
 
 type UserPreferences struct {
-	Timezone *string `json:"timezone,omitempty"`
+	Timezone      *string                         `json:"timezone,omitempty"`
+	Notifications *domain.NotificationPreferences `json:"notifications,omitempty"`
 }
 
 func (j *UserPreferences) Scan(value interface{}) error {
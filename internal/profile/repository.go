@@ -131,7 +131,8 @@ func (r *Repository) GetUser(uid uuid.UUID, fields ...string) (user domain.User,
 		Color:    orm.Color,
 
 		Preferences: domain.ProfilePreferences{
-			Timezone: orm.Preferences.Timezone,
+			Timezone:      orm.Preferences.Timezone,
+			Notifications: orm.Preferences.Notifications,
 		},
 
 		CreatedAt: orm.CreatedAt,
@@ -155,6 +156,31 @@ func (r *Repository) GetUserByEmail(email string, fields ...string) (user domain
 	return user, err
 }
 
+// GetPreferencesByEmails - the preferences of the users by their emails, the unknown emails are left out.
+func (r *Repository) GetPreferencesByEmails(emails []string) (map[string]domain.ProfilePreferences, error) {
+	orms := []User{}
+
+	err := r.gorm.DB.Model(User{}).
+		Where("email IN ?", emails).
+		Where("deleted_at IS NULL").
+		Select("email", "preferences").
+		Find(&orms).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	prefs := make(map[string]domain.ProfilePreferences, len(orms))
+	for _, orm := range orms {
+		prefs[orm.Email] = domain.ProfilePreferences{
+			Timezone:      orm.Preferences.Timezone,
+			Notifications: orm.Preferences.Notifications,
+		}
+	}
+
+	return prefs, nil
+}
+
 func (r *Repository) ResetPassword(code string, user domain.User) error {
 	email, err := r.rds.GetStr(context.TODO(), "resetpsswrd:"+code)
 	if err != nil {
@@ -0,0 +1,263 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/sirupsen/logrus"
+)
+
+// channel - every replica publishes the push messages to it and dispatches what it receives to its own clients
+const channel = "realtime"
+
+const sendBuffer = 64
+
+// Check - whether the client may still receive the messages of a topic, run on every dispatch,
+// so that a subscription outlives neither the membership nor the privacy it was made with.
+type Check func() error
+
+// Client - a websocket connection of a user, it reads the messages to write from Send.
+type Client struct {
+	Email    string
+	UserUUID uuid.UUID
+
+	send   chan []byte
+	topics map[string]bool
+	closed bool
+}
+
+func NewClient(email string, userUUID uuid.UUID) *Client {
+	return &Client{
+		Email:    email,
+		UserUUID: userUUID,
+		send:     make(chan []byte, sendBuffer),
+		topics:   map[string]bool{},
+	}
+}
+
+// Send is closed when the client is unregistered or is too slow to keep up.
+func (c *Client) Send() <-chan []byte {
+	return c.send
+}
+
+// Hub - the clients of this replica by topic.
+type Hub struct {
+	rds *redis.RDS
+
+	lock   sync.Mutex
+	topics map[string]map[*Client]Check
+
+	listenOnce sync.Once
+}
+
+func New(rds *redis.RDS) *Hub {
+	return &Hub{
+		rds:    rds,
+		topics: map[string]map[*Client]Check{},
+	}
+}
+
+// Subscribe - the check, if any, has passed already, a message of the topic is dropped with the subscription
+// once it fails.
+func (h *Hub) Subscribe(c *Client, topic string, check Check) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	if h.topics[topic] == nil {
+		h.topics[topic] = map[*Client]Check{}
+	}
+
+	h.topics[topic][c] = check
+	c.topics[topic] = true
+}
+
+func (h *Hub) Unsubscribe(c *Client, topic string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.unsubscribe(c, topic)
+}
+
+// Unregister drops every subscription of the client and closes its Send.
+func (h *Hub) Unregister(c *Client) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.close(c)
+}
+
+func (h *Hub) unsubscribe(c *Client, topic string) {
+	delete(h.topics[topic], c)
+	if len(h.topics[topic]) == 0 {
+		delete(h.topics, topic)
+	}
+
+	delete(c.topics, topic)
+}
+
+func (h *Hub) close(c *Client) {
+	if c.closed {
+		return
+	}
+
+	for topic := range c.topics {
+		h.unsubscribe(c, topic)
+	}
+
+	c.closed = true
+	close(c.send)
+}
+
+// Send writes the message to the client only, as the replies to its requests.
+func (h *Hub) Send(c *Client, msg domain.PushMessage) {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		logrus.WithError(err).Error("push message marshal error")
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.write(c, b)
+}
+
+// Dispatch writes the message to the clients of this replica subscribed to its topic, the checks are run
+// outside the lock; a client that fails its check is unsubscribed and told why.
+func (h *Hub) Dispatch(msg domain.PushMessage) {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		logrus.WithError(err).Error("push message marshal error")
+		return
+	}
+
+	h.lock.Lock()
+	subscribers := make(map[*Client]Check, len(h.topics[msg.Topic]))
+	for c, check := range h.topics[msg.Topic] {
+		subscribers[c] = check
+	}
+	h.lock.Unlock()
+
+	denied := map[*Client]error{}
+	for c, check := range subscribers {
+		if check == nil {
+			continue
+		}
+
+		if err := check(); err != nil {
+			denied[c] = err
+		}
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for c := range subscribers {
+		if _, ok := h.topics[msg.Topic][c]; !ok {
+			continue
+		}
+
+		if err, ok := denied[c]; ok {
+			h.revoke(c, msg.Topic, err)
+			continue
+		}
+
+		h.write(c, b)
+	}
+}
+
+func (h *Hub) revoke(c *Client, topic string, reason error) {
+	h.unsubscribe(c, topic)
+
+	logrus.WithField("user", c.Email).WithField("topic", topic).WithError(reason).Debug("push subscription revoked")
+
+	msg, _ := domain.NewPushMessage(domain.PushUnsubscribed, topic, map[string]string{"message": reason.Error()})
+	if b, err := json.Marshal(msg); err == nil {
+		h.write(c, b)
+	}
+}
+
+// write never blocks: a client with a full buffer is disconnected, it resubscribes on reconnect.
+func (h *Hub) write(c *Client, b []byte) {
+	if c.closed {
+		return
+	}
+
+	select {
+	case c.send <- b:
+	default:
+		logrus.WithField("user", c.Email).Warn("push client is too slow, disconnected")
+		h.close(c)
+	}
+}
+
+// Publish sends the messages to the clients of every replica through redis.
+func (h *Hub) Publish(ctx context.Context, msgs ...domain.PushMessage) error {
+	for _, msg := range msgs {
+		b, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		err = h.rds.Publish(ctx, channel, string(b))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Listen dispatches the messages published by every replica to the clients of this one,
+// once however many times it is called.
+func (h *Hub) Listen(ctx context.Context) {
+	h.listenOnce.Do(func() {
+		h.listen(ctx)
+	})
+}
+
+func (h *Hub) listen(ctx context.Context) {
+	pubsub := h.rds.Subscribe(ctx, channel)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(time.Second * 5)
+				h.listen(ctx)
+			}
+		}()
+		defer pubsub.Close()
+
+		for {
+			msg, err := pubsub.ReceiveMessage(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				logrus.WithError(err).Error("push receive error")
+				time.Sleep(time.Second)
+				continue
+			}
+
+			m := domain.PushMessage{}
+			if err := json.Unmarshal([]byte(msg.Payload), &m); err != nil {
+				logrus.WithError(err).Error("push message unmarshal error")
+				continue
+			}
+
+			h.Dispatch(m)
+		}
+	}()
+}
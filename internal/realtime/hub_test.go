@@ -0,0 +1,120 @@
+package realtime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func received(c *Client) []domain.PushMessage {
+	msgs := []domain.PushMessage{}
+	for {
+		select {
+		case b, ok := <-c.Send():
+			if !ok {
+				return msgs
+			}
+
+			m := domain.PushMessage{}
+			_ = json.Unmarshal(b, &m)
+			msgs = append(msgs, m)
+		default:
+			return msgs
+		}
+	}
+}
+
+func TestHubDispatch(t *testing.T) {
+	hub := New(nil)
+	task := domain.PushTaskTopic(uuid.New())
+
+	alice := NewClient("alice@mail.ru", uuid.New())
+	bob := NewClient("bob@mail.ru", uuid.New())
+
+	hub.Subscribe(alice, task, nil)
+	hub.Subscribe(alice, domain.PushNotificationsTopic(alice.Email), nil)
+	hub.Subscribe(bob, task, nil)
+
+	msg, _ := domain.NewPushMessage(domain.EventTaskUpdated, task, map[string]string{"name": "new"})
+	hub.Dispatch(msg)
+
+	count, _ := domain.NewPushMessage(domain.PushNotificationsCount, domain.PushNotificationsTopic(alice.Email), map[string]int{"count": 1})
+	hub.Dispatch(count)
+
+	if got := received(alice); len(got) != 2 || got[0].Type != domain.EventTaskUpdated || got[1].Type != domain.PushNotificationsCount {
+		t.Errorf("alice got %+v", got)
+	}
+
+	if got := received(bob); len(got) != 1 || string(got[0].Data) != `{"name":"new"}` {
+		t.Errorf("bob got %+v", got)
+	}
+
+	hub.Unsubscribe(bob, task)
+	hub.Dispatch(msg)
+
+	if got := received(bob); len(got) != 0 {
+		t.Errorf("bob got %+v after unsubscribe", got)
+	}
+
+	hub.Unregister(alice)
+	hub.Unregister(alice)
+	hub.Dispatch(msg)
+
+	// the message of before the unregistration stays readable
+	if got := received(alice); len(got) != 1 || !alice.closed || len(hub.topics) != 0 {
+		t.Errorf("alice should be closed, got %+v, topics %v", got, hub.topics)
+	}
+}
+
+func TestHubSlowClient(t *testing.T) {
+	hub := New(nil)
+	task := domain.PushTaskTopic(uuid.New())
+
+	slow := NewClient("slow@mail.ru", uuid.New())
+	hub.Subscribe(slow, task, nil)
+
+	msg, _ := domain.NewPushMessage(domain.EventTaskUpdated, task, nil)
+	for i := 0; i <= sendBuffer; i++ {
+		hub.Dispatch(msg)
+	}
+
+	if got := received(slow); len(got) != sendBuffer || !slow.closed {
+		t.Errorf("slow client got %d messages, closed %v", len(got), slow.closed)
+	}
+
+	hub.Subscribe(slow, task, nil)
+	if len(hub.topics) != 0 {
+		t.Errorf("a closed client should not subscribe")
+	}
+}
+
+func TestHubDispatchCheck(t *testing.T) {
+	hub := New(nil)
+	task := domain.PushTaskTopic(uuid.New())
+
+	var revoked error
+	alice := NewClient("alice@mail.ru", uuid.New())
+	hub.Subscribe(alice, task, func() error { return revoked })
+
+	msg, _ := domain.NewPushMessage(domain.EventTaskUpdated, task, nil)
+	hub.Dispatch(msg)
+
+	if got := received(alice); len(got) != 1 || got[0].Type != domain.EventTaskUpdated {
+		t.Errorf("alice got %+v", got)
+	}
+
+	revoked = errors.New("нет доступа")
+	hub.Dispatch(msg)
+	hub.Dispatch(msg)
+
+	if got := received(alice); len(got) != 1 || got[0].Type != domain.PushUnsubscribed || got[0].Topic != task {
+		t.Errorf("alice got %+v after the access was revoked", got)
+	}
+
+	if len(hub.topics) != 0 || alice.closed {
+		t.Errorf("alice should be unsubscribed only, topics %v, closed %v", hub.topics, alice.closed)
+	}
+}
@@ -3,43 +3,61 @@ package reminders
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/events"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	maxOccurrences       = 100
+	maxOccurrencesWindow = time.Hour * 24 * 366
+)
+
 type Service struct {
 	repo *Repository
 	dict *dictionary.Service
-
-	onReminderWasUpdatedOrCreated func(uuid.UUID, uuid.UUID, []string) error
+	bus  *events.Bus
 }
 
-func New(repo *Repository, dict *dictionary.Service) *Service {
+func New(repo *Repository, dict *dictionary.Service, bus *events.Bus) *Service {
 	// @todo: rm task service mv to cache service
 	return &Service{
 		repo: repo,
 		dict: dict,
+		bus:  bus,
 	}
 }
 
-func (s *Service) ReminderWasUpdatedOrCreated(uid, taskUUID uuid.UUID, people []string) error {
-	if s.onReminderWasUpdatedOrCreated != nil {
-		return s.onReminderWasUpdatedOrCreated(uid, taskUUID, people)
+func (s *Service) validate(r domain.Reminder) error {
+	if r.DateFrom != nil && r.DateTo != nil && !helpers.IsTheSameDay(*r.DateFrom, *r.DateTo) {
+		return fmt.Errorf("даты должны быть в один день")
 	}
 
-	logrus.Error("onTaskUpdatedOrCreated is nil")
+	if r.Recurrence != nil {
+		if r.DateFrom == nil {
+			return domain.ErrRecurrenceWithoutDate
+		}
+
+		return r.Recurrence.Validate()
+	}
 
 	return nil
 }
 
 func (s *Service) Create(r domain.Reminder) (err error) {
-	if r.DateFrom != nil && r.DateTo != nil && !helpers.IsTheSameDay(*r.DateFrom, *r.DateTo) {
-		return fmt.Errorf("даты должны быть в один день")
+	if err := s.validate(r); err != nil {
+		return err
+	}
+
+	if r.Recurrence != nil {
+		r.Recurrence.Anchor(*r.DateFrom)
 	}
 
 	err = s.repo.Create(r)
@@ -50,18 +68,22 @@ func (s *Service) Create(r domain.Reminder) (err error) {
 			return fmt.Errorf("reminder user not found by uuid: %s", r.UserUUID.String())
 		}
 
-		err := s.ReminderWasUpdatedOrCreated(r.UUID, r.TaskUUID, []string{cuser.Email})
-		if err != nil {
-			logrus.WithError(err).Error("ReminderWasUpdatedOrCreated error")
-		}
+		s.bus.Publish(context.Background(), domain.ReminderCreated{
+			EventMeta:    domain.NewEventMeta(r.CreatedBy, r.TaskUUID, []string{cuser.Email}),
+			ReminderUUID: r.UUID,
+		})
 	}
 
 	return err
 }
 
 func (s *Service) Put(userEmail string, r domain.Reminder) (err error) {
-	if r.DateFrom != nil && r.DateTo != nil && !helpers.IsTheSameDay(*r.DateFrom, *r.DateTo) {
-		return fmt.Errorf("даты должны быть в один день")
+	if err := s.validate(r); err != nil {
+		return err
+	}
+
+	if r.Recurrence != nil {
+		r.Recurrence.Anchor(*r.DateFrom)
 	}
 
 	err = s.repo.Put(r)
@@ -76,10 +98,10 @@ func (s *Service) Put(userEmail string, r domain.Reminder) (err error) {
 			return err
 		}
 
-		err = s.ReminderWasUpdatedOrCreated(r.UUID, r.TaskUUID, people)
-		if err != nil {
-			logrus.WithError(err).Error("ReminderWasUpdatedOrCreated error")
-		}
+		s.bus.Publish(context.Background(), domain.ReminderUpdated{
+			EventMeta:    domain.NewEventMeta(userEmail, r.TaskUUID, people),
+			ReminderUUID: r.UUID,
+		})
 	}
 
 	return err
@@ -99,10 +121,12 @@ func (s *Service) PatchStatus(userEmail string, r domain.Reminder, status int) (
 			return err
 		}
 
-		err = s.ReminderWasUpdatedOrCreated(r.UUID, r.TaskUUID, people)
-		if err != nil {
-			logrus.WithError(err).Error("ReminderWasUpdatedOrCreated error")
-		}
+		s.bus.Publish(context.Background(), domain.ReminderStatusChanged{
+			EventMeta:    domain.NewEventMeta(userEmail, r.TaskUUID, people),
+			ReminderUUID: r.UUID,
+			Before:       r.Status,
+			After:        status,
+		})
 	}
 
 	return err
@@ -123,19 +147,36 @@ func (s *Service) DeleteByUUID(uid uuid.UUID) (err error) {
 			return err
 		}
 
-		err = s.ReminderWasUpdatedOrCreated(r.UUID, r.TaskUUID, people)
-		if err != nil {
-			logrus.WithError(err).Error("ReminderWasUpdatedOrCreated error")
-		}
+		s.bus.Publish(context.Background(), domain.ReminderDeleted{
+			EventMeta:    domain.NewEventMeta("", r.TaskUUID, people),
+			ReminderUUID: r.UUID,
+		})
 	}
 
 	return err
 }
 
-func (s *Service) GetByUser(uid uuid.UUID) (dms []domain.Reminder, err error) {
+// GetByUser returns user reminders, with a window recurring reminders are expanded into occurrences.
+func (s *Service) GetByUser(uid uuid.UUID, from, to *time.Time) (dms []domain.Reminder, err error) {
 	dms, err = s.repo.GetByUser(uid)
+	if err != nil || from == nil || to == nil {
+		return dms, err
+	}
 
-	return dms, err
+	if to.Before(*from) || to.Sub(*from) > maxOccurrencesWindow {
+		return dms, fmt.Errorf("период должен быть не больше года")
+	}
+
+	occurrences := []domain.Reminder{}
+	for _, dm := range dms {
+		occurrences = append(occurrences, dm.Occurrences(*from, *to, maxOccurrences)...)
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].DateFrom.Before(*occurrences[j].DateFrom)
+	})
+
+	return occurrences, nil
 }
 
 func (s *Service) GetByTask(uid uuid.UUID) (dms []domain.Reminder, err error) {
@@ -148,6 +189,20 @@ func (s *Service) Get(uid uuid.UUID) (dm domain.Reminder, err error) {
 	return s.repo.Get(uid)
 }
 
+func (s *Service) GetDue(now time.Time, limit int) (dms []domain.Reminder, err error) {
+	return s.repo.GetDue(now, limit)
+}
+
+// MarkFired marks a one-time reminder as fired or moves a recurring one to its next occurrence.
+func (s *Service) MarkFired(r domain.Reminder) error {
+	prev := r.DateFrom
+	if prev != nil && r.Fire(time.Now()) {
+		return s.repo.Reschedule(*prev, r)
+	}
+
+	return s.repo.MarkFired(r.UUID)
+}
+
 func (s *Service) GetRemindersNames(ctx context.Context, uids []uuid.UUID) (withName []domain.Reminder, err error) {
 	if len(uids) == 0 {
 		return withName, nil
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type Reminder struct {
@@ -18,6 +19,10 @@ type Reminder struct {
 	DateTo        *time.Time `gorm:"type:timestamp"`
 	Type          string     `gorm:"type:varchar(50)"`
 	Status        int        `gorm:"type:integer"`
+	FiredAt       *time.Time `gorm:"type:timestamptz"`
+
+	Recurrence *domain.ReminderRecurrence `gorm:"type:jsonb"`
+	Occurrence int                        `gorm:"type:integer"`
 
 	CreatedAt time.Time `gorm:"->;type:timestamp"`
 	UpdatedAt time.Time
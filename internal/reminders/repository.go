@@ -2,12 +2,14 @@ package reminders
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -32,6 +34,7 @@ func (r *Repository) Create(dm domain.Reminder) (err error) {
 		Description:   dm.Description,
 		Type:          dm.Type,
 		UserUUID:      dm.UserUUID,
+		Recurrence:    dm.Recurrence,
 	}
 
 	err = r.gorm.DB.Create(&orm).Error
@@ -39,23 +42,34 @@ func (r *Repository) Create(dm domain.Reminder) (err error) {
 	return err
 }
 
+// Put updates what the user edits; moved to another date the reminder is to be fired again.
 func (r *Repository) Put(dm domain.Reminder) (err error) {
-	orm := &Reminder{
-		UUID:        dm.UUID,
-		DateFrom:    dm.DateFrom,
-		DateTo:      dm.DateTo,
-		Description: dm.Description,
-		Comment:     dm.Comment,
-		Type:        dm.Type,
-		UserUUID:    dm.UserUUID,
-	}
-
-	res := r.gorm.DB.Save(&orm)
+	res := r.gorm.DB.
+		Model(&Reminder{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"fired_at":    gorm.Expr("CASE WHEN date_from IS DISTINCT FROM ? THEN NULL ELSE fired_at END", dm.DateFrom),
+			"date_from":   dm.DateFrom,
+			"date_to":     dm.DateTo,
+			"description": dm.Description,
+			"comment":     dm.Comment,
+			"type":        dm.Type,
+			"user_uuid":   dm.UserUUID,
+			"status":      dm.Status,
+			"recurrence":  dm.Recurrence,
+			"occurrence":  dm.Occurrence,
+			"updated_at":  "now()",
+		})
 
 	if res.Error != nil {
 		return res.Error
 	}
 
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("нельзя обновлять удаленное дело")
+	}
+
 	return res.Error
 }
 
@@ -109,6 +123,9 @@ func (r *Repository) GetByUser(uid uuid.UUID) (dms []domain.Reminder, err error)
 			Type:          item.Type,
 			UserUUID:      item.UserUUID,
 			Status:        item.Status,
+			FiredAt:       item.FiredAt,
+			Recurrence:    item.Recurrence,
+			Occurrence:    item.Occurrence,
 		}
 	})
 
@@ -161,6 +178,10 @@ func (r *Repository) GetByTask(taskUUID uuid.UUID) (dms []domain.Reminder, err e
 			Description:   item.Description,
 			Comment:       item.Comment,
 			Type:          item.Type,
+			Status:        item.Status,
+			FiredAt:       item.FiredAt,
+			Recurrence:    item.Recurrence,
+			Occurrence:    item.Occurrence,
 		}
 	})
 
@@ -191,6 +212,12 @@ func (r *Repository) Get(uid uuid.UUID) (dms domain.Reminder, err error) {
 		CreatedAt:     orm.CreatedAt,
 		UpdatedAt:     orm.UpdatedAt,
 		Description:   orm.Description,
+		Comment:       orm.Comment,
+		Type:          orm.Type,
+		Status:        orm.Status,
+		FiredAt:       orm.FiredAt,
+		Recurrence:    orm.Recurrence,
+		Occurrence:    orm.Occurrence,
 	}, nil
 }
 
@@ -209,3 +236,89 @@ func (r *Repository) GetRemindersNames(_ context.Context, uids []uuid.UUID) (wit
 
 	return withName, nil
 }
+
+func (r *Repository) GetDue(now time.Time, limit int) (dms []domain.Reminder, err error) {
+	orm := []Reminder{}
+
+	err = r.gorm.DB.
+		Where("date_from <= ?", now).
+		Where("fired_at IS NULL").
+		Where("status NOT IN ?", []int{domain.StatusDone, domain.StatusCancel}).
+		Where("deleted_at IS NULL").
+		Order("date_from ASC").
+		Limit(limit).
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	dms = lo.Map(orm, func(item Reminder, i int) domain.Reminder {
+		return domain.Reminder{
+			UUID:          item.UUID,
+			CreatedBy:     item.CreatedBy,
+			CreatedByUUID: item.CreatedByUUID,
+			TaskUUID:      item.TaskUUID,
+			UserUUID:      item.UserUUID,
+			DateFrom:      item.DateFrom,
+			DateTo:        item.DateTo,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+			Description:   item.Description,
+			Comment:       item.Comment,
+			Type:          item.Type,
+			Status:        item.Status,
+			Recurrence:    item.Recurrence,
+			Occurrence:    item.Occurrence,
+		}
+	})
+
+	return dms, nil
+}
+
+func (r *Repository) MarkFired(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Reminder{}).
+		Where("uuid = ?", uid).
+		Where("fired_at IS NULL").
+		Where("deleted_at IS NULL").
+		Update("fired_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("дело уже отправлено или удалено")
+	}
+
+	return res.Error
+}
+
+// Reschedule moves a recurring reminder to the next occurrence if it was not moved by someone else.
+func (r *Repository) Reschedule(prevDateFrom time.Time, dm domain.Reminder) error {
+	res := r.gorm.DB.
+		Model(&Reminder{}).
+		Where("uuid = ?", dm.UUID).
+		Where("date_from = ?", prevDateFrom).
+		Where("fired_at IS NULL").
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"date_from":  dm.DateFrom,
+			"date_to":    dm.DateTo,
+			"occurrence": dm.Occurrence,
+			"fired_at":   dm.FiredAt,
+			"updated_at": "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("дело уже отправлено или удалено")
+	}
+
+	return res.Error
+}
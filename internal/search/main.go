@@ -0,0 +1,19 @@
+package search
+
+import (
+	"github.com/krisch/crm-backend/domain"
+)
+
+type Service struct {
+	repo *Repository
+}
+
+func New(repo *Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+func (s *Service) Search(q domain.SearchQuery) ([]domain.SearchHit, int64, error) {
+	return s.repo.Search(q)
+}
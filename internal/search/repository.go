@@ -0,0 +1,146 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/pkg/postgres"
+)
+
+// the matches are marked by control characters, the text is escaped before they become <mark> tags, see headline
+const (
+	markStart = "\x02"
+	markStop  = "\x03"
+
+	headlineOptions = "StartSel=" + markStart + ", StopSel=" + markStop + ", MaxFragments=2, MaxWords=30, MinWords=10"
+)
+
+var marks = strings.NewReplacer(markStart, "<mark>", markStop, "</mark>")
+
+// headline - the fragments of ts_headline as html: the text is escaped, only the matches are tagged.
+func headline(s string) string {
+	return marks.Replace(html.EscapeString(s))
+}
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+type hit struct {
+	Type        string
+	UUID        uuid.UUID
+	TaskUUID    *uuid.UUID
+	CatalogUUID *uuid.UUID
+	ProjectUUID *uuid.UUID
+	CompanyUUID uuid.UUID
+	Title       string
+	Headline    string
+	Rank        float64
+	CreatedAt   time.Time
+
+	Total int64
+}
+
+// taskVisible mirrors gates.TaskView: private projects show their tasks to members and participants only.
+const taskVisible = `(? or not coalesce((p.options->>'private')::boolean, false)
+	or ? = any(t.all_people) or t.created_by = ?
+	or exists(select 1 from project_users pu where pu.project_uuid = t.project_uuid and pu.user_uuid = ? and pu.deleted_at is null))`
+
+// Search ranks the tasks, comments and catalog records matching the query, the search columns are kept by postgres.
+func (r *Repository) Search(q domain.SearchQuery) (dms []domain.SearchHit, total int64, err error) {
+	if len(q.CompanyUUIDs) == 0 {
+		return dms, 0, nil
+	}
+
+	parts := []string{}
+	args := []interface{}{q.Query}
+
+	visibleArgs := []interface{}{q.Superuser, q.UserEmail, q.UserEmail, q.UserUUID}
+
+	if q.Has(domain.SearchTypeTask) {
+		parts = append(parts, `select 'task' as type, t.uuid, t.uuid as task_uuid, null::uuid as catalog_uuid,
+			t.project_uuid, t.company_uuid, t.name as title,
+			ts_headline('public.ru_en', t.name || ' ' || coalesce(t.description, ''), q.query, ?) as headline,
+			ts_rank_cd(t.search, q.query) as rank, t.created_at
+		from tasks t
+		join projects p on p.uuid = t.project_uuid
+		cross join q
+		where t.search @@ q.query and t.deleted_at is null
+			and t.federation_uuid = ? and t.company_uuid in ? and `+taskVisible)
+		args = append(args, headlineOptions, q.FederationUUID, q.CompanyUUIDs)
+		args = append(args, visibleArgs...)
+	}
+
+	if q.Has(domain.SearchTypeComment) {
+		parts = append(parts, `select 'comment' as type, c.uuid, c.task_uuid, null::uuid as catalog_uuid,
+			t.project_uuid, t.company_uuid, t.name as title,
+			ts_headline('public.ru_en', coalesce(c.comment, ''), q.query, ?) as headline,
+			ts_rank_cd(c.search, q.query) as rank, c.created_at
+		from comments c
+		join tasks t on t.uuid = c.task_uuid
+		join projects p on p.uuid = t.project_uuid
+		cross join q
+		where c.search @@ q.query and c.deleted_at is null and t.deleted_at is null
+			and t.federation_uuid = ? and t.company_uuid in ? and `+taskVisible)
+		args = append(args, headlineOptions, q.FederationUUID, q.CompanyUUIDs)
+		args = append(args, visibleArgs...)
+	}
+
+	if q.Has(domain.SearchTypeCatalog) {
+		parts = append(parts, `select 'catalog' as type, d.uuid, null::uuid as task_uuid, d.catalog_uuid,
+			null::uuid as project_uuid, d.company_uuid, cat.name as title,
+			ts_headline('public.ru_en', (select coalesce(string_agg(value, ' '), '') from jsonb_each_text(d.fields)) || ' ' || coalesce(d.description, ''), q.query, ?) as headline,
+			ts_rank_cd(d.search, q.query) as rank, d.created_at
+		from catalog_data d
+		join catalogs cat on cat.uuid = d.catalog_uuid
+		cross join q
+		where d.search @@ q.query and d.deleted_at is null
+			and d.federation_uuid = ? and d.company_uuid in ?`)
+		args = append(args, headlineOptions, q.FederationUUID, q.CompanyUUIDs)
+	}
+
+	args = append(args, q.Limit, q.Offset)
+
+	orms := []hit{}
+
+	err = r.gorm.DB.Raw(`with q as (select websearch_to_tsquery('public.ru_en', ?) as query)
+		select hits.*, count(*) over() as total from (`+strings.Join(parts, "\nunion all\n")+`) hits
+		order by rank desc, created_at desc
+		limit ? offset ?`, args...).
+		Scan(&orms).
+		Error
+
+	if err != nil {
+		return dms, 0, err
+	}
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	for _, item := range orms {
+		dms = append(dms, domain.SearchHit{
+			Type:        item.Type,
+			UUID:        item.UUID,
+			TaskUUID:    item.TaskUUID,
+			CatalogUUID: item.CatalogUUID,
+			ProjectUUID: item.ProjectUUID,
+			CompanyUUID: item.CompanyUUID,
+			Title:       item.Title,
+			Headline:    headline(item.Headline),
+			Rank:        item.Rank,
+			CreatedAt:   item.CreatedAt,
+		})
+	}
+
+	return dms, total, nil
+}
@@ -0,0 +1,23 @@
+package search
+
+import "testing"
+
+func TestHeadline(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "отчет за \x02квартал\x03", "отчет за <mark>квартал</mark>"},
+		{"script in a task name", "<script>alert(1)</script> \x02квартал\x03", "&lt;script&gt;alert(1)&lt;/script&gt; <mark>квартал</mark>"},
+		{"marked tag", "\x02<b>\x03 & co", "<mark>&lt;b&gt;</mark> &amp; co"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := headline(tt.in); got != tt.want {
+				t.Errorf("headline() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -41,10 +41,31 @@ func (r *Repository) GetSms(_ context.Context, filter dto.SmsFilterDTO) (dms []d
 
 	query = query.Order("created_at desc")
 
+	if filter.FederationUUID != nil {
+		query = query.Where("federation_uuid = ?", *filter.FederationUUID)
+	}
+
 	if filter.CompanyUUID != nil {
 		query = query.Where("company_uuid = ?", *filter.CompanyUUID)
 	}
 
+	if filter.CompanyUUIDs != nil {
+		query = query.Where("company_uuid in ?", filter.CompanyUUIDs)
+	}
+
+	if filter.Phones != nil {
+		// the form of domain.NormalizePhone: 8XXXXXXXXXX and the bare ten digits are 7XXXXXXXXXX
+		query = query.Where(`regexp_replace(regexp_replace("to", '\D', '', 'g'), '^(8|)(\d{10})$', '7\2') in ?`, filter.Phones)
+	}
+
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
 	if filter.IsMy != nil && *filter.IsMy && filter.MyEmail != nil {
 		query = query.Where("created_by = ?", filter.MyEmail)
 	}
@@ -0,0 +1,97 @@
+package task
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+func (s *Service) GetChecklist(taskUUID uuid.UUID) ([]domain.TaskChecklistItem, error) {
+	return s.repo.GetChecklist(taskUUID)
+}
+
+func (s *Service) GetChecklistItem(uid uuid.UUID) (domain.TaskChecklistItem, error) {
+	return s.repo.GetChecklistItem(uid)
+}
+
+func (s *Service) CreateChecklistItem(crt domain.Creator, dm domain.TaskChecklistItem) error {
+	err := s.repo.CreateChecklistItem(dm)
+	if err != nil {
+		return err
+	}
+
+	s.ResetCache(dm.TaskUUID)
+
+	return s.as.TaskChecklistChanged(crt, domain.ChecklistItemCreated, dm, nil)
+}
+
+// UpdateChecklistItem logs a toggle of the done flag as checked or unchecked, anything else as updated.
+func (s *Service) UpdateChecklistItem(crt domain.Creator, old, dm domain.TaskChecklistItem) error {
+	err := dm.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateChecklistItem(dm)
+	if err != nil {
+		return err
+	}
+
+	s.ResetCache(dm.TaskUUID)
+
+	action := domain.ChecklistItemUpdated
+	if old.Done != dm.Done && old.Text == dm.Text && old.AssignedTo == dm.AssignedTo {
+		action = lo.Ternary(dm.Done, domain.ChecklistItemChecked, domain.ChecklistItemUnchecked)
+	}
+
+	return s.as.TaskChecklistChanged(crt, action, dm, nil)
+}
+
+func (s *Service) DeleteChecklistItem(crt domain.Creator, dm domain.TaskChecklistItem) error {
+	err := s.repo.DeleteChecklistItem(dm)
+	if err != nil {
+		return err
+	}
+
+	s.ResetCache(dm.TaskUUID)
+
+	return s.as.TaskChecklistChanged(crt, domain.ChecklistItemDeleted, dm, nil)
+}
+
+func (s *Service) ReorderChecklist(crt domain.Creator, taskUUID uuid.UUID, uuids []uuid.UUID) ([]domain.TaskChecklistItem, error) {
+	items, err := s.repo.GetChecklist(taskUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := domain.ReorderChecklist(items, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.ReorderChecklist(dms)
+	if err != nil {
+		return nil, err
+	}
+
+	return dms, s.as.TaskChecklistChanged(crt, domain.ChecklistReordered, domain.TaskChecklistItem{TaskUUID: taskUUID}, nil)
+}
+
+// ConvertChecklistItem creates the subtask through CreateTask and drops the item from the checklist.
+func (s *Service) ConvertChecklistItem(crt domain.Creator, dm domain.TaskChecklistItem, subtask domain.Task) (int, error) {
+	id, err := s.CreateTask(subtask)
+	if err != nil {
+		return id, err
+	}
+
+	err = s.repo.DeleteChecklistItem(dm)
+	if err != nil {
+		return id, err
+	}
+
+	s.ResetCache(dm.TaskUUID)
+
+	subtask.ID = id
+
+	return id, s.as.TaskChecklistChanged(crt, domain.ChecklistItemConverted, dm, &subtask)
+}
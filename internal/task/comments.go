@@ -23,10 +23,11 @@ func (s *Service) CreateComment(ctx context.Context, uid uuid.UUID, cm domain.Co
 		return email != cm.CreatedBy
 	})
 
-	err = s.TaskWasUpdatedOrCreated(uid, notify)
-	if err != nil {
-		return err
-	}
+	s.publish(domain.CommentAdded{
+		EventMeta:   domain.NewEventMeta(cm.CreatedBy, uid, notify),
+		CommentUUID: cm.UUID,
+		Mentioned:   lo.Keys(cm.People),
+	})
 
 	return nil
 }
@@ -46,10 +47,10 @@ func (s *Service) UpdateComment(ctx context.Context, uid uuid.UUID, cm domain.Co
 		return email != cm.CreatedBy
 	})
 
-	err = s.TaskWasUpdatedOrCreated(uid, notify)
-	if err != nil {
-		return err
-	}
+	s.publish(domain.CommentUpdated{
+		EventMeta:   domain.NewEventMeta(cm.CreatedBy, uid, notify),
+		CommentUUID: cm.UUID,
+	})
 
 	return nil
 }
@@ -69,10 +70,10 @@ func (s *Service) DeleteComment(ctx context.Context, taskUID, comentUID uuid.UUI
 		return email != deletedBy
 	})
 
-	err = s.TaskWasUpdatedOrCreated(taskUID, notify)
-	if err != nil {
-		return err
-	}
+	s.publish(domain.CommentDeleted{
+		EventMeta:   domain.NewEventMeta(deletedBy, taskUID, notify),
+		CommentUUID: comentUID,
+	})
 
 	return nil
 }
@@ -0,0 +1,16 @@
+package task
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func (s *Service) GetDeadlineTasks(now time.Time, limit int) ([]domain.DeadlineTask, error) {
+	return s.repo.GetDeadlineTasks(now, limit)
+}
+
+func (s *Service) MarkDeadline(uid uuid.UUID, stage int) (bool, error) {
+	return s.repo.MarkDeadline(uid, stage)
+}
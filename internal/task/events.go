@@ -1,11 +1,37 @@
 package task
 
-import "github.com/google/uuid"
+import (
+	"context"
+	"reflect"
+	"strings"
 
-func (s *Service) OnTaskUpdatedOrCreated(fn func(uuid.UUID, []string) error) {
-	s.onTaskUpdatedOrCreated = fn
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+// publish hands the events to the bus subscribers, their failures are logged by the bus.
+func (s *Service) publish(events ...domain.Event) {
+	s.bus.Publish(context.Background(), events...)
+}
+
+// taskChanges - the values of the updated fields before and after, the fields are named as in UpdateTask.
+func taskChanges(before, after domain.Task, fields []string) (map[string]interface{}, map[string]interface{}) {
+	was, is := map[string]interface{}{}, map[string]interface{}{}
+
+	tp := reflect.TypeOf(after)
+	for _, field := range fields {
+		for i := 0; i < tp.NumField(); i++ {
+			if strings.EqualFold(tp.Field(i).Name, field) {
+				was[field] = reflect.ValueOf(before).Field(i).Interface()
+				is[field] = reflect.ValueOf(after).Field(i).Interface()
+			}
+		}
+	}
+
+	return was, is
 }
 
-func (s *Service) OnOpenTask(fn func(uuid.UUID, string) error) {
-	s.onOpenTask = fn
+// assignees - the implementer and the responsible of the task.
+func assignees(task domain.Task) []string {
+	return lo.Uniq(lo.Compact([]string{task.ImplementBy, task.ResponsibleBy}))
 }
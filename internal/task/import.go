@@ -0,0 +1,90 @@
+package task
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+// Import creates a root task of the target project per row, rows with errors are reported and skipped.
+// Custom fields are filtered by the project as in CreateTask. In dry-run mode nothing is stored.
+func (s *Service) Import(target domain.TaskImportTarget, rows [][]string, mapping map[string]string, dryRun bool) (res domain.TaskImportResult, err error) {
+	res.DryRun = dryRun
+	res.Errors = []domain.TaskImportError{}
+
+	if len(rows) == 0 {
+		return res, domain.ErrTaskImportNoHeader
+	}
+
+	if len(rows)-1 > domain.TaskImportMaxRows {
+		return res, domain.ErrTaskImportTooLarge
+	}
+
+	projectFields, err := s.repo.GetProjectFields(target.ProjectUUID)
+	if err != nil {
+		return res, err
+	}
+
+	tags, err := s.repo.GetCompanyTags(target.CompanyUUID)
+	if err != nil {
+		return res, err
+	}
+
+	emails, err := s.repo.GetCompanyEmails(target.CompanyUUID)
+	if err != nil {
+		return res, err
+	}
+
+	target.Fields = lo.Map(projectFields, func(orm CompanyFields, _ int) domain.TaskImportField {
+		return domain.TaskImportField{Hash: orm.Hash, Name: orm.Name, DataType: domain.FieldDataType(orm.DataType)}
+	})
+	target.Tags = lo.SliceToMap(tags, func(orm CompanyTags) (string, uuid.UUID) {
+		return strings.ToLower(orm.Name), orm.UUID
+	})
+	target.Members = lo.SliceToMap(emails, func(email string) (string, bool) {
+		return email, true
+	})
+
+	columns, err := domain.NewTaskColumns(rows[0], mapping, target.Fields)
+	if err != nil {
+		return res, err
+	}
+
+	tasks := []domain.Task{}
+
+	for n, row := range rows[1:] {
+		if len(lo.WithoutEmpty(lo.Map(row, func(cell string, _ int) string { return strings.TrimSpace(cell) }))) == 0 {
+			continue
+		}
+
+		res.Total++
+
+		task, err := domain.NewTaskFromRow(target, row, columns)
+		if err == nil {
+			task.Fields, err = filterTaskFields(task, projectFields)
+		}
+
+		if err != nil {
+			res.Errors = append(res.Errors, domain.TaskImportError{
+				Row:     n + 2,
+				Message: err.Error(),
+			})
+
+			continue
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	res.Created = len(tasks)
+
+	if dryRun || len(tasks) == 0 {
+		return res, nil
+	}
+
+	err = s.CreateTaskBatch(target.CreatedBy, tasks)
+
+	return res, err
+}
@@ -0,0 +1,90 @@
+package task
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+func (s *Service) CreateLink(crt domain.Creator, task, other domain.Task, linkType string) (*domain.TaskLink, error) {
+	link, err := domain.NewTaskLink(task, other, linkType, crt)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.Directed() {
+		cycle, err := s.repo.HasLinkPath(link.Type, link.ToUUID, link.FromUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if cycle {
+			return nil, domain.ErrTaskLinkCycle
+		}
+	}
+
+	err = s.repo.CreateLink(*link)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := lo.Ternary(link.FromUUID == task.UUID, task, other), lo.Ternary(link.FromUUID == task.UUID, other, task)
+
+	err = s.as.TaskWasLinked(crt, *link, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+func (s *Service) DeleteLink(ctx context.Context, crt domain.Creator, link domain.TaskLink) error {
+	from, err := s.GetTaskGetTaskWithDeleted(ctx, link.FromUUID)
+	if err != nil {
+		return err
+	}
+
+	to, err := s.GetTaskGetTaskWithDeleted(ctx, link.ToUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteLink(link.UUID)
+	if err != nil {
+		return err
+	}
+
+	return s.as.TaskWasUnlinked(crt, link, from, to)
+}
+
+func (s *Service) GetLink(uid uuid.UUID) (domain.TaskLink, error) {
+	return s.repo.GetLink(uid)
+}
+
+// GetLinks returns the links of the task and the linked tasks by uuid, the links to deleted tasks are dropped.
+func (s *Service) GetLinks(taskUUID uuid.UUID) (links []domain.TaskLink, tasks map[uuid.UUID]domain.Task, err error) {
+	links, err = s.repo.GetLinks(taskUUID)
+	if err != nil || len(links) == 0 {
+		return links, tasks, err
+	}
+
+	dms, err := s.repo.GetLinkedTasks(lo.Map(links, func(l domain.TaskLink, _ int) uuid.UUID {
+		return l.Other(taskUUID)
+	}))
+	if err != nil {
+		return links, tasks, err
+	}
+
+	tasks = lo.KeyBy(dms, func(t domain.Task) uuid.UUID {
+		return t.UUID
+	})
+
+	links = lo.Filter(links, func(l domain.TaskLink, _ int) bool {
+		_, ok := tasks[l.Other(taskUUID)]
+		return ok
+	})
+
+	return links, tasks, nil
+}
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"regexp"
 	"strings"
 	"time"
 
@@ -17,6 +16,7 @@ import (
 	"github.com/krisch/crm-backend/internal/activities"
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/events"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/profile"
 	"github.com/krisch/crm-backend/internal/s3"
@@ -35,11 +35,10 @@ type Service struct {
 
 	ttlCache *ttlcache.Cache[string, []dto.TaskDTO]
 
-	onTaskUpdatedOrCreated func(uuid.UUID, []string) error
-	onOpenTask             func(uuid.UUID, string) error
+	bus *events.Bus
 }
 
-func New(repo *Repository, dict *dictionary.Service, as *activities.Service, ps *profile.Service, cs *comments.Service, storage *s3.ServicePrivate) *Service {
+func New(repo *Repository, dict *dictionary.Service, as *activities.Service, ps *profile.Service, cs *comments.Service, storage *s3.ServicePrivate, bus *events.Bus) *Service {
 	// @todo: rm profile service from here
 	ttlCache := ttlcache.New[string, []dto.TaskDTO](
 		ttlcache.WithTTL[string, []dto.TaskDTO](5 * time.Second),
@@ -55,27 +54,9 @@ func New(repo *Repository, dict *dictionary.Service, as *activities.Service, ps
 		storage:        storage,
 
 		ttlCache: ttlCache,
-	}
-}
 
-func (s *Service) TaskWasUpdatedOrCreated(uid uuid.UUID, people []string) error {
-	if s.onTaskUpdatedOrCreated != nil {
-		return s.onTaskUpdatedOrCreated(uid, people)
+		bus: bus,
 	}
-
-	logrus.Error("onTaskUpdatedOrCreated is nil")
-
-	return nil
-}
-
-func (s *Service) TaskWasOpen(uid uuid.UUID, email string) error {
-	if s.onOpenTask != nil {
-		return s.onOpenTask(uid, email)
-	}
-
-	logrus.Error("onOpenTask is nil")
-
-	return nil
 }
 
 func (s *Service) CreateTask(task domain.Task) (id int, err error) {
@@ -106,10 +87,12 @@ func (s *Service) CreateTask(task domain.Task) (id int, err error) {
 			return email != task.CreatedBy
 		})
 
-		err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
-		if err != nil {
-			logrus.Error("TaskWasUpdatedOrCreated error: ", err)
-		}
+		s.publish(domain.TaskCreated{
+			EventMeta:   domain.NewEventMeta(task.CreatedBy, task.UUID, notify),
+			ProjectUUID: task.ProjectUUID,
+			Name:        task.Name,
+			Assignees:   assignees(task),
+		})
 	}
 
 	return orm.ID, err
@@ -147,10 +130,14 @@ func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate
 			return email != crtr.Email
 		})
 
-		err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
-		if err != nil {
-			logrus.Error("TaskWasUpdatedOrCreated error: ", err)
-		}
+		before, after := taskChanges(oldTask, task, shouldUpdate)
+
+		s.publish(domain.TaskUpdated{
+			EventMeta: domain.NewEventMeta(crtr.Email, task.UUID, notify),
+			Fields:    shouldUpdate,
+			Before:    before,
+			After:     after,
+		})
 	}
 
 	for _, field := range shouldUpdate {
@@ -173,14 +160,23 @@ func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate
 }
 
 func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]interface{}, err error) {
+	if len(task.RawFields) == 0 {
+		return make(map[string]interface{}, 0), nil
+	}
+
+	projectFields, err := s.repo.GetProjectFields(task.ProjectUUID)
+	if err != nil {
+		return make(map[string]interface{}, 0), err
+	}
+
+	return filterTaskFields(task, projectFields)
+}
+
+// filterTaskFields checks the raw fields of the task against the fields of its project.
+func filterTaskFields(task domain.Task, projectFields []CompanyFields) (filteredFields map[string]interface{}, err error) {
 	filteredFields = make(map[string]interface{}, 0)
 
 	if len(task.RawFields) > 0 {
-		projectFields, err := s.repo.GetProjectFields(task.ProjectUUID)
-		if err != nil {
-			return filteredFields, err
-		}
-
 		addedFieldsHash := []string{}
 		for _, pfield := range projectFields {
 			if value, ok := task.RawFields[pfield.Hash]; ok {
@@ -190,174 +186,13 @@ func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]
 					continue
 				}
 
-				switch domain.FieldDataType(pfield.DataType) {
-				case domain.Integer:
-					if v, ok := value.(int); ok {
-						filteredFields[pfield.Hash] = v
-						continue
-					}
-
-					if v, ok := value.(float64); ok {
-						filteredFields[pfield.Hash] = int(v)
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be integer", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Float:
-					if v, ok := value.(float64); ok {
-						filteredFields[pfield.Hash] = v
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be float", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.String:
-					if v, ok := value.(string); ok {
-						filteredFields[pfield.Hash] = v
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be string", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Text:
-					if v, ok := value.(string); ok {
-						filteredFields[pfield.Hash] = v
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be text", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Bool:
-					if v, ok := value.(bool); ok {
-						filteredFields[pfield.Hash] = v
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be bool", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Switch:
-					if v, ok := value.(float64); ok {
-						if v == 0 || v == 1 || v == 2 {
-							filteredFields[pfield.Hash] = int(v)
-						} else {
-							msg := fmt.Sprintf("field %s (%s) must be switch (0|1|2)", pfield.Name, pfield.Hash)
-							return filteredFields, errors.New(msg)
-						}
-						continue
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be switch (0|1|2)", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Array:
-					rt := reflect.TypeOf(value)
-					if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array {
-						arrWithStrings := []string{}
-						for _, i := range value.([]interface{}) {
-							arrWithStrings = append(arrWithStrings, fmt.Sprintf("%v", i))
-						}
-
-						filteredFields[pfield.Hash] = arrWithStrings
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be array", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Phone:
-					if v, ok := value.(int); ok {
-						filteredFields[pfield.Hash] = v
-						continue
-					}
-
-					if v, ok := value.(float64); ok {
-						filteredFields[pfield.Hash] = int(v)
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be integer (phone)", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Link:
-					if v, ok := value.(string); ok {
-						// url: [text](url)
-						exp := `^\[.*]\((http:\/\/www\.|https:\/\/www\.|http:\/\/|https:\/\/|\/|\/\/)?[A-z0-9_-]*?[:]?[A-z0-9_-]*?[@]?[A-z0-9]+([\-\.]{1}[a-z0-9]+)*\.[a-z]{2,5}(:[0-9]{1,5})?(\/.*)?\)$`
-						//nolint
-						match, err := regexp.MatchString(exp, v)
-						if err != nil {
-							return filteredFields, err
-						}
-
-						if !match {
-							msg := fmt.Sprintf("field %s (%s) should be link [text](url)", pfield.Name, pfield.Hash)
-							return filteredFields, errors.New(msg)
-						}
-
-						filteredFields[pfield.Hash] = v
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be string", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Email:
-					if v, ok := value.(string); ok {
-						exp := `^[a-z0-9._%+\-]+@[a-z0-9.\-]+\.[a-z]{2,4}$`
-						//nolint
-						match, err := regexp.MatchString(exp, v)
-						if err != nil {
-							return filteredFields, err
-						}
-
-						if !match {
-							msg := fmt.Sprintf("field %s (%s) should be email", pfield.Name, pfield.Hash)
-							return filteredFields, errors.New(msg)
-						}
-
-						filteredFields[pfield.Hash] = v
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be string", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.Time:
-					if v, ok := value.(string); ok {
-						_, err := time.Parse(time.RFC3339, v)
-						if err != nil {
-							return filteredFields, err
-						}
-
-						filteredFields[pfield.Hash] = v[11:]
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be string", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.DateTime:
-					if v, ok := value.(string); ok {
-						_, err := time.Parse(time.RFC3339, v)
-						if err != nil {
-							return filteredFields, err
-						}
-
-						filteredFields[pfield.Hash] = v
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be string", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
-				case domain.People:
-					rt := reflect.TypeOf(value)
-					if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array {
-						arrWithStrings := []string{}
-						for _, i := range value.([]interface{}) {
-
-							exp := `^[a-z0-9._%+\-]+@[a-z0-9.\-]+\.[a-z]{2,4}$`
-							//nolint
-							match, err := regexp.MatchString(exp, fmt.Sprintf("%v", i))
-							if err != nil {
-								return filteredFields, err
-							}
-
-							if !match {
-								msg := fmt.Sprintf("field %s (%s) - %s should be email", pfield.Name, pfield.Hash, fmt.Sprintf("%v", i))
-								return filteredFields, errors.New(msg)
-							}
-
-							arrWithStrings = append(arrWithStrings, fmt.Sprintf("%v", i))
-						}
-
-						filteredFields[pfield.Hash] = arrWithStrings
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be array", pfield.Name, pfield.Hash)
-						return filteredFields, errors.New(msg)
-					}
+				v, err := domain.FieldValue(pfield.Name, pfield.Hash, domain.FieldDataType(pfield.DataType), value)
+				if err != nil {
+					return filteredFields, err
+				}
+
+				if v != nil {
+					filteredFields[pfield.Hash] = v
 				}
 			}
 		}
@@ -388,17 +223,17 @@ func (s *Service) CreateTaskBatch(updaterEmail string, tasks []domain.Task) (err
 	}
 
 	for _, task := range tasks {
-		if err == nil {
-			notify := lo.Filter(task.People, func(email string, _ int) bool {
-				// @todo: delete me from notifications
-				return email != updaterEmail
-			})
+		notify := lo.Filter(task.People, func(email string, _ int) bool {
+			// @todo: delete me from notifications
+			return email != updaterEmail
+		})
 
-			err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
-			if err != nil {
-				return err
-			}
-		}
+		s.publish(domain.TaskCreated{
+			EventMeta:   domain.NewEventMeta(updaterEmail, task.UUID, notify),
+			ProjectUUID: task.ProjectUUID,
+			Name:        task.Name,
+			Assignees:   assignees(task),
+		})
 	}
 
 	return err
@@ -471,10 +306,9 @@ func (s *Service) GetTask(ctx context.Context, uid uuid.UUID, fields []string) (
 	}
 
 	if email, ok := ctx.Value("userEmail").(string); ok {
-		err := s.TaskWasOpen(uid, email)
-		if err != nil {
-			logrus.Error("TaskWasOpen error: ", err)
-		}
+		s.publish(domain.TaskOpened{
+			EventMeta: domain.NewEventMeta(email, uid, []string{email}),
+		})
 	}
 
 	if len(fields) > 0 {
@@ -507,7 +341,39 @@ func (s *Service) GetTasksNames(ctx context.Context, uid []uuid.UUID) (taskWithN
 	return s.repo.GetTaskNames(ctx, uid)
 }
 
+func (s *Service) GetAgentTasks(ctx context.Context, agentUUID uuid.UUID, companyUUIDs []uuid.UUID, to *time.Time, limit int) (dms []domain.Task, err error) {
+	return s.repo.GetAgentTasks(ctx, agentUUID, companyUUIDs, to, limit)
+}
+
+// filterColumns - core columns the fields filter accepts next to the custom fields.
+var filterColumns = map[string]domain.FieldDataType{
+	"id":             domain.Integer,
+	"name":           domain.String,
+	"status":         domain.Integer,
+	"priority":       domain.Integer,
+	"is_epic":        domain.Bool,
+	"created_by":     domain.Email,
+	"responsible_by": domain.Email,
+	"implement_by":   domain.Email,
+	"managed_by":     domain.Email,
+	"tags":           domain.Array,
+	"created_at":     domain.DateTime,
+	"updated_at":     domain.DateTime,
+	"activity_at":    domain.DateTime,
+	"finish_to":      domain.DateTime,
+	"finished_at":    domain.DateTime,
+}
+
 func (s *Service) GetTasks(ctx context.Context, filter dto.TaskSearchDTO) (dm []domain.Task, total int64, err error) {
+	projectFields, _ := s.dict.FindProjectFields(filter.ProjectUUID)
+
+	err = dto.CheckFilters(filter.Fields, filterColumns, lo.SliceToMap(projectFields, func(f dto.ProjectFieldDTO) (string, domain.FieldDataType) {
+		return f.Hash, domain.FieldDataType(f.DataType)
+	}), time.Now())
+	if err != nil {
+		return dm, -1, err
+	}
+
 	allowSort := s.GetSortFields(filter.ProjectUUID)
 
 	dm, total, err = s.repo.GetTasks(ctx, filter, allowSort)
@@ -676,10 +542,12 @@ func (s *Service) PatchName(crt domain.Creator, uid uuid.UUID, name string) (err
 			return email != crt.Email
 		})
 
-		err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
-		if err != nil {
-			return err
-		}
+		s.publish(domain.TaskUpdated{
+			EventMeta: domain.NewEventMeta(crt.Email, task.UUID, notify),
+			Fields:    []string{"name"},
+			Before:    map[string]interface{}{"name": task.Dirty["name"]},
+			After:     map[string]interface{}{"name": task.Name},
+		})
 	}
 
 	_, err = s.as.TaskWasChangedActivity(crt, task.UUID, "name", task.Name, task.Dirty["name"])
@@ -705,6 +573,18 @@ func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task
 		}
 	}
 
+	if status == domain.StatusInWork || status == domain.StatusDone {
+		blockers, err := s.repo.GetBlockers(task.UUID)
+		if err != nil {
+			return stopUUID, path, err
+		}
+
+		err = domain.CheckBlockers(status, blockers)
+		if err != nil {
+			return stopUUID, path, err
+		}
+	}
+
 	sg, err := domain.NewStatusGraphFromMap(*project.StatusGraph)
 	if err != nil {
 		return stopUUID, path, err
@@ -754,10 +634,14 @@ func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task
 			return email != crtr.Email
 		})
 
-		err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
-		if err != nil {
-			return stopUUID, path, err
-		}
+		before, _ := task.Dirty["status"].(int)
+
+		s.publish(domain.TaskStatusChanged{
+			EventMeta: domain.NewEventMeta(crtr.Email, task.UUID, notify),
+			Before:    before,
+			After:     task.Status,
+			Comment:   comment,
+		})
 	}
 
 	//
@@ -970,11 +854,37 @@ func (s *Service) PatchTeam(ctx context.Context, crtr domain.Creator, uid uuid.U
 		return email != crtr.Email
 	})
 
-	err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
-	if err != nil {
-		return err
+	e := domain.TaskUpdated{
+		EventMeta: domain.NewEventMeta(crtr.Email, task.UUID, notify),
+		Fields:    []string{},
+		Before:    map[string]interface{}{},
+		After:     map[string]interface{}{},
+	}
+
+	change := func(field string, before, after interface{}) {
+		e.Fields = append(e.Fields, field)
+		e.Before[field] = before
+		e.After[field] = after
+	}
+
+	if implementedBy != nil {
+		change("implement_by", task.ImplementBy, *implementedBy)
+	}
+	if responsibleBy != nil {
+		change("responsible_by", task.ResponsibleBy, *responsibleBy)
+	}
+	if managedBy != nil {
+		change("managed_by", task.ManagedBy, *managedBy)
+	}
+	if coworkersBy != nil {
+		change("co_workers_by", task.CoWorkersBy, *coworkersBy)
+	}
+	if watchedBy != nil {
+		change("watch_by", task.WatchBy, *watchedBy)
 	}
 
+	s.publish(e)
+
 	return err
 }
 
@@ -1005,10 +915,10 @@ func (s *Service) DeleteTask(crt domain.Creator, uid uuid.UUID) (err error) {
 		return err
 	}
 
-	err = s.TaskWasUpdatedOrCreated(uid, t.People)
-	if err != nil {
-		return err
-	}
+	s.publish(domain.TaskDeleted{
+		EventMeta: domain.NewEventMeta(crt.Email, uid, t.People),
+		Name:      t.Name,
+	})
 
 	for _, file := range files {
 		err := s.storage.Delete(file.UUID)
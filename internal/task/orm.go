@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/lib/pq"
 	"gorm.io/datatypes"
 )
@@ -42,11 +43,18 @@ type Task struct {
 
 	CommentsTotal int `gorm:"type:int;default:0;not null;" order:""`
 
+	ChecklistTotal int `gorm:"type:int;default:0;not null;"`
+	ChecklistDone  int `gorm:"type:int;default:0;not null;"`
+
 	CreatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
 	FinishedAt *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
 	FinishTo   *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
 	ActivityAt time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
 
+	DeadlineStage int `gorm:"type:smallint;default:0;not null"`
+
+	RecurrenceUUID *uuid.UUID `gorm:"type:uuid;default:NULL"`
+
 	Duration int `gorm:"type:int;default:0;not null"`
 
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
@@ -63,6 +71,8 @@ type Task struct {
 	TaskEntities TE    `gorm:"type:jsonb;default:'{}';not null;"`
 	Stops        Stops `gorm:"type:jsonb;default:'[]';not null;"`
 
+	Agents pq.StringArray `gorm:"type:uuid[];default:'{}';not null;"`
+
 	FirstOpen FirstOpen `gorm:"->update;type:jsonb;default:'{}';not null;"`
 
 	Description string `gorm:"type:text;default:'';not null" order:""`
@@ -139,3 +149,126 @@ type CompanyFields struct {
 	DataType    int    `gorm:"type:int;not null;default:0"`
 	CompanyUUID string `gorm:"type:uuid;not null"`
 }
+
+type CompanyTags struct {
+	UUID        uuid.UUID `gorm:"type:uuid;not null"`
+	Name        string    `gorm:"type:varchar(100);not null"`
+	CompanyUUID uuid.UUID `gorm:"type:uuid;not null"`
+}
+
+type TaskView struct {
+	UUID           uuid.UUID             `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FederationUUID uuid.UUID             `gorm:"<-:create;type:uuid;not null"`
+	ProjectUUID    uuid.UUID             `gorm:"<-:create;type:uuid;not null"`
+	UserUUID       uuid.UUID             `gorm:"<-:create;type:uuid;not null"`
+	Name           string                `gorm:"type:varchar(100);not null"`
+	Shared         bool                  `gorm:"type:bool;default:false;not null"`
+	Filter         domain.TaskViewFilter `gorm:"type:jsonb;default:'{}';not null"`
+	Order          *string               `gorm:"type:varchar(100)"`
+	By             *string               `gorm:"type:varchar(4)"`
+	Columns        pq.StringArray        `gorm:"type:text[];default:'{}';not null"`
+	GroupBy        *string               `gorm:"type:varchar(100)"`
+
+	CreatedBy string     `gorm:"<-:create;type:varchar(255)"`
+	CreatedAt time.Time  `gorm:"<-:create;type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+type TaskLink struct {
+	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FederationUUID uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	FromUUID       uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	ToUUID         uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	Type           string    `gorm:"<-:create;type:varchar(20);not null"`
+
+	CreatedBy     string     `gorm:"<-:create;type:varchar(255)"`
+	CreatedByUUID uuid.UUID  `gorm:"<-:create;type:uuid"`
+	CreatedAt     time.Time  `gorm:"<-:create;type:timestamptz;default:now();not null"`
+	DeletedAt     *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+type TaskWorklog struct {
+	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FederationUUID uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	ProjectUUID    uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	TaskUUID       uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	UserUUID       uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+
+	StartedAt time.Time `gorm:"type:timestamptz;not null"`
+	Duration  int       `gorm:"type:integer;not null"`
+	Comment   string    `gorm:"type:text;default:'';not null"`
+	Billable  bool      `gorm:"type:bool;default:true;not null"`
+
+	CreatedBy string     `gorm:"<-:create;type:varchar(255)"`
+	CreatedAt time.Time  `gorm:"<-:create;type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+type TaskTimer struct {
+	UserUUID       uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null"`
+	ProjectUUID    uuid.UUID `gorm:"type:uuid;not null"`
+	TaskUUID       uuid.UUID `gorm:"type:uuid;not null"`
+	StartedAt      time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+// DeadlineTask - a task with the options of its project for the deadline scan.
+type DeadlineTask struct {
+	Task
+
+	ProjectOptions       domain.ProjectOptions `gorm:"->"`
+	ProjectResponsibleBy string                `gorm:"->"`
+}
+
+type TaskChecklistItem struct {
+	UUID     uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	TaskUUID uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	Position int       `gorm:"type:integer;default:0;not null"`
+
+	Text       string     `gorm:"type:varchar(500);not null"`
+	Done       bool       `gorm:"type:bool;default:false;not null"`
+	AssignedTo string     `gorm:"type:varchar(100);default:'';not null"`
+	DueAt      *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	CreatedBy string     `gorm:"<-:create;type:varchar(255)"`
+	CreatedAt time.Time  `gorm:"<-:create;type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+type TaskTemplate struct {
+	UUID        uuid.UUID               `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	CompanyUUID uuid.UUID               `gorm:"<-:create;type:uuid;not null"`
+	ProjectUUID *uuid.UUID              `gorm:"<-:create;type:uuid"`
+	Name        string                  `gorm:"type:varchar(100);not null"`
+	Root        domain.TaskTemplateNode `gorm:"type:jsonb;default:'{}';not null"`
+
+	CreatedBy string     `gorm:"<-:create;type:varchar(255)"`
+	CreatedAt time.Time  `gorm:"<-:create;type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+type TaskRecurrence struct {
+	UUID           uuid.UUID           `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FederationUUID uuid.UUID           `gorm:"<-:create;type:uuid;not null"`
+	CompanyUUID    uuid.UUID           `gorm:"<-:create;type:uuid;not null"`
+	ProjectUUID    uuid.UUID           `gorm:"<-:create;type:uuid;not null"`
+	TaskUUID       *uuid.UUID          `gorm:"<-:create;type:uuid"`
+	TemplateUUID   *uuid.UUID          `gorm:"<-:create;type:uuid"`
+	Vars           domain.TemplateVars `gorm:"type:jsonb;default:'{}';not null"`
+
+	Schedule     domain.TaskSchedule `gorm:"type:jsonb;default:'{}';not null"`
+	StartAt      time.Time           `gorm:"type:timestamptz;not null"`
+	NextAt       *time.Time          `gorm:"type:timestamptz;default:NULL;"`
+	Occurrence   int                 `gorm:"type:int;default:0;not null"`
+	Paused       bool                `gorm:"type:bool;default:false;not null"`
+	FinishOffset *int                `gorm:"type:int;default:NULL;"`
+
+	CreatedBy string     `gorm:"<-:create;type:varchar(255)"`
+	CreatedAt time.Time  `gorm:"<-:create;type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
@@ -0,0 +1,98 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func (s *Service) CreateRecurrence(dm domain.TaskRecurrence) error {
+	err := s.repo.CreateRecurrence(dm)
+	if err != nil {
+		return err
+	}
+
+	if dm.TaskUUID != nil {
+		s.ResetCache(*dm.TaskUUID)
+	}
+
+	return nil
+}
+
+func (s *Service) UpdateRecurrence(dm domain.TaskRecurrence) error {
+	err := dm.Validate()
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateRecurrence(dm)
+}
+
+func (s *Service) DeleteRecurrence(uid uuid.UUID) error {
+	return s.repo.DeleteRecurrence(uid)
+}
+
+func (s *Service) GetRecurrence(uid uuid.UUID) (domain.TaskRecurrence, error) {
+	return s.repo.GetRecurrence(uid)
+}
+
+func (s *Service) GetRecurrences(projectUUID uuid.UUID) ([]domain.TaskRecurrence, error) {
+	return s.repo.GetRecurrences(projectUUID)
+}
+
+func (s *Service) GetDueRecurrences(now time.Time, limit int) ([]domain.TaskRecurrence, error) {
+	return s.repo.GetDueRecurrences(now, limit)
+}
+
+// SpawnRecurrence creates the task of the due occurrence, nil when there is none or another replica took it.
+// The series is advanced first: a failed spawn skips the occurrence instead of repeating it on every scan.
+func (s *Service) SpawnRecurrence(dm domain.TaskRecurrence, now time.Time) (*domain.Task, error) {
+	if dm.NextAt == nil {
+		return nil, nil
+	}
+
+	due := *dm.NextAt
+
+	occurrence, ok := dm.Advance(now)
+	if !ok {
+		return nil, nil
+	}
+
+	advanced, err := s.repo.AdvanceRecurrence(dm, due)
+	if err != nil || !advanced {
+		return nil, err
+	}
+
+	if dm.TemplateUUID != nil {
+		tmpl, err := s.GetTemplate(*dm.TemplateUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks, err := s.InstantiateTemplate(tmpl, dm.Target(), occurrence)
+		if err != nil {
+			return nil, err
+		}
+
+		return &tasks[0], nil
+	}
+
+	source, err := s.GetTask(context.Background(), *dm.TaskUUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := dm.Spawn(source, occurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	task.ID, err = s.CreateTask(task)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
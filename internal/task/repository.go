@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -16,6 +17,7 @@ import (
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -71,10 +73,16 @@ func (r *Repository) CreateTask(task domain.Task, batch bool) (orm *Task, err er
 
 		TaskEntities: task.TaskEntities,
 
+		Agents: lo.Map(task.Agents, func(item uuid.UUID, _ int) string {
+			return item.String()
+		}),
+
 		FinishTo: task.FinishTo,
 
 		FirstOpen: task.FirstOpen,
 
+		RecurrenceUUID: task.RecurrenceUUID,
+
 		Description: task.Description,
 	}
 
@@ -174,6 +182,32 @@ func (r *Repository) GetProjectFields(projectUUID uuid.UUID) (orm []CompanyField
 	return orm, err
 }
 
+func (r *Repository) GetCompanyTags(companyUUID uuid.UUID) (orm []CompanyTags, err error) {
+	orm = []CompanyTags{}
+
+	err = r.gorm.DB.Model(&orm).
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Find(&orm).
+		Error
+
+	return orm, err
+}
+
+// GetCompanyEmails - emails of the company members in lower case.
+func (r *Repository) GetCompanyEmails(companyUUID uuid.UUID) (emails []string, err error) {
+	emails = []string{}
+
+	err = r.gorm.DB.Table("company_users cu").
+		Joins("join users on users.uuid = cu.user_uuid").
+		Where("cu.company_uuid = ?", companyUUID).
+		Where("cu.deleted_at is null").
+		Pluck("lower(users.email)", &emails).
+		Error
+
+	return emails, err
+}
+
 type JSONB map[string]interface{}
 
 func (j JSONB) Value() (driver.Value, error) {
@@ -311,6 +345,7 @@ func (r *Repository) GetTask(_ context.Context, uid uuid.UUID) (dm domain.Task,
 		Fields: orm.Fields,
 
 		CommentsTotal: orm.CommentsTotal,
+		Checklist:     domain.ChecklistProgress{Done: orm.ChecklistDone, Total: orm.ChecklistTotal},
 
 		CreatedAt:  orm.CreatedAt,
 		UpdatedAt:  orm.UpdatedAt,
@@ -319,6 +354,10 @@ func (r *Repository) GetTask(_ context.Context, uid uuid.UUID) (dm domain.Task,
 
 		TaskEntities: orm.TaskEntities,
 
+		Agents: lo.Map(orm.Agents, func(item string, _ int) uuid.UUID {
+			return uuid.MustParse(item)
+		}),
+
 		Stops: lo.Map(orm.Stops, func(item Stop, _ int) domain.Stop {
 			return domain.Stop{
 				UUID:          item.UUID,
@@ -340,6 +379,8 @@ func (r *Repository) GetTask(_ context.Context, uid uuid.UUID) (dm domain.Task,
 		ChildrensUUID: lo.Map(orm.ChildrensUUID, func(item string, _ int) uuid.UUID {
 			return uuid.MustParse(item)
 		}),
+
+		RecurrenceUUID: orm.RecurrenceUUID,
 	}
 
 	return dm, nil
@@ -392,6 +433,7 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 		Fields: orm.Fields,
 
 		CommentsTotal: orm.CommentsTotal,
+		Checklist:     domain.ChecklistProgress{Done: orm.ChecklistDone, Total: orm.ChecklistTotal},
 
 		CreatedAt:  orm.CreatedAt,
 		UpdatedAt:  orm.UpdatedAt,
@@ -400,6 +442,10 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 
 		TaskEntities: orm.TaskEntities,
 
+		Agents: lo.Map(orm.Agents, func(item string, _ int) uuid.UUID {
+			return uuid.MustParse(item)
+		}),
+
 		Stops: lo.Map(orm.Stops, func(item Stop, _ int) domain.Stop {
 			return domain.Stop{
 				UUID:          item.UUID,
@@ -416,6 +462,8 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 		FinishedAt: orm.FinishedAt,
 
 		FirstOpen: orm.FirstOpen,
+
+		RecurrenceUUID: orm.RecurrenceUUID,
 	}
 
 	return dm, nil
@@ -439,6 +487,46 @@ func (r *Repository) GetTaskNames(_ context.Context, uids []uuid.UUID) (taskWith
 	return taskWithName, nil
 }
 
+// GetAgentTasks returns up to limit tasks linked to the agent, created before to when it is set, newest first.
+// GetAgentTasks - the newest tasks linked to the agent in the given companies.
+func (r *Repository) GetAgentTasks(_ context.Context, agentUUID uuid.UUID, companyUUIDs []uuid.UUID, to *time.Time, limit int) (dms []domain.Task, err error) {
+	defer r.storeTime("GetAgentTasks", tm())
+
+	orms := []Task{}
+
+	query := r.gorm.DB.
+		Select("uuid, id, name, status, project_uuid, created_by, all_people, created_at, finished_at").
+		Where("agents @> ARRAY[?]::uuid[]", agentUUID).
+		Where("company_uuid in ?", companyUUIDs).
+		Where("deleted_at is null")
+
+	if to != nil {
+		query = query.Where("created_at < ?", *to)
+	}
+
+	err = query.
+		Order("created_at desc").
+		Limit(limit).
+		Find(&orms).
+		Error
+
+	dms = lo.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:        item.UUID,
+			ID:          item.ID,
+			Name:        item.Name,
+			Status:      item.Status,
+			ProjectUUID: item.ProjectUUID,
+			CreatedBy:   item.CreatedBy,
+			People:      item.AllPeople,
+			CreatedAt:   item.CreatedAt,
+			FinishedAt:  item.FinishedAt,
+		}
+	})
+
+	return dms, err
+}
+
 func (r *Repository) GetSortFields() []string {
 	st := reflect.TypeOf(Task{})
 
@@ -505,6 +593,10 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 		query = query.Where("name iLIKE ? OR name iLIKE ?", *filter.Name+"%", "% "+*filter.Name+"%")
 	}
 
+	if filter.VisibleTo != nil {
+		query = query.Where("(? = ANY (all_people) OR created_by = ?)", *filter.VisibleTo, *filter.VisibleTo)
+	}
+
 	if filter.IsMy != nil && *filter.IsMy && filter.MyEmail != nil {
 		query = query.Where("? = ANY (all_people)", filter.MyEmail)
 	}
@@ -515,24 +607,32 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 		}
 	}
 
-	if len(filter.Fields) > 0 {
-		for _, item := range filter.Fields {
-			logrus.Warn(item.Value)
-			logrus.Warn(item.Value)
-			// @todo: add regular to check array
-			if strings.HasPrefix(fmt.Sprintf("%v", item.Value), "@> [") && strings.HasSuffix(fmt.Sprintf("%v", item.Value), "]") {
-				v := strings.TrimPrefix(item.Value.(string), "@> ")
-				query = query.Where(" fields->? @> ?", item.Name, v)
-			} else {
-				query = query.Where("? = fields->>? ", item.Value, item.Name)
-			}
-		}
+	for _, item := range filter.Fields {
+		where, args := item.Where()
+		query = query.Where(where, args...)
 	}
 
 	if filter.Path != nil {
 		query = query.Where("path ~ ?", *filter.Path)
 	}
 
+	if filter.Overdue != nil {
+		closed := []int{domain.StatusDone, domain.StatusCancel}
+		if *filter.Overdue {
+			query = query.Where("finish_to < now() and status not in ?", closed)
+		} else {
+			query = query.Where("(finish_to is null or finish_to >= now() or status in ?)", closed)
+		}
+	}
+
+	if filter.DueBefore != nil {
+		query = query.Where("finish_to < ?", *filter.DueBefore)
+	}
+
+	if filter.DueAfter != nil {
+		query = query.Where("finish_to >= ?", *filter.DueAfter)
+	}
+
 	if filter.Limit != nil {
 		query = query.Limit(*filter.Limit)
 	} else {
@@ -585,8 +685,10 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 
 			ActivityAt:     item.ActivityAt,
 			ChildrensTotal: item.ChildrensTotal,
+			Checklist:      domain.ChecklistProgress{Done: item.ChecklistDone, Total: item.ChecklistTotal},
 			FinishTo:       item.FinishTo,
 			FinishedAt:     item.FinishedAt,
+			RecurrenceUUID: item.RecurrenceUUID,
 
 			CreatedAt: item.CreatedAt,
 			UpdatedAt: item.UpdatedAt,
@@ -751,8 +853,15 @@ func (r *Repository) UpdateTask(task domain.Task, shouldUpdate []string) (err er
 			err = r.ChangeField(task.UUID, "fields", task.Fields)
 		case "finish_to":
 			err = r.ChangeField(task.UUID, "finish_to", task.FinishTo)
+			if err == nil {
+				err = r.ChangeField(task.UUID, "deadline_stage", domain.DeadlineNone)
+			}
 		case "description":
 			err = r.ChangeField(task.UUID, "description", task.Description)
+		case "agents":
+			err = r.ChangeField(task.UUID, "agents", "{"+strings.Join(lo.Map(task.Agents, func(item uuid.UUID, _ int) string {
+				return item.String()
+			}), ",")+"}")
 		}
 	}
 
@@ -790,3 +899,1027 @@ func (r *Repository) DeleteTask(uid uuid.UUID) (err error) {
 func (r *Repository) ResetCache(uid uuid.UUID) {
 	r.cache.ClearTask(context.TODO(), uid)
 }
+
+func (r *Repository) CreateView(dm domain.TaskView) error {
+	orm := toTaskViewOrm(dm)
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) UpdateView(dm domain.TaskView) error {
+	res := r.gorm.DB.
+		Model(&TaskView{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"name":       dm.Name,
+			"shared":     dm.Shared,
+			"filter":     dm.Filter,
+			"order":      dm.Order,
+			"by":         dm.By,
+			"columns":    pq.StringArray(dm.Columns),
+			"group_by":   dm.GroupBy,
+			"updated_at": "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("представление не найдено")
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteView(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&TaskView{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("представление не найдено")
+	}
+
+	return nil
+}
+
+func (r *Repository) GetView(uid uuid.UUID) (dm domain.TaskView, err error) {
+	orm := TaskView{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("представление не найдено")
+	}
+
+	return fromTaskViewOrm(orm), err
+}
+
+// GetViews - the views of the user and the shared views of the project.
+func (r *Repository) GetViews(projectUUID, userUUID uuid.UUID) (dms []domain.TaskView, err error) {
+	orms := []TaskView{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("(user_uuid = ? or shared)", userUUID).
+		Where("deleted_at is null").
+		Order("shared, name").
+		Find(&orms).Error
+
+	return lo.Map(orms, func(orm TaskView, _ int) domain.TaskView {
+		return fromTaskViewOrm(orm)
+	}), err
+}
+
+func toTaskViewOrm(dm domain.TaskView) TaskView {
+	return TaskView{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		UserUUID:       dm.UserUUID,
+		Name:           dm.Name,
+		Shared:         dm.Shared,
+		Filter:         dm.Filter,
+		Order:          dm.Order,
+		By:             dm.By,
+		Columns:        dm.Columns,
+		GroupBy:        dm.GroupBy,
+		CreatedBy:      dm.CreatedBy,
+	}
+}
+
+func fromTaskViewOrm(orm TaskView) domain.TaskView {
+	return domain.TaskView{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		UserUUID:       orm.UserUUID,
+		Name:           orm.Name,
+		Shared:         orm.Shared,
+
+		TaskViewSettings: domain.TaskViewSettings{
+			Filter:  orm.Filter,
+			Order:   orm.Order,
+			By:      orm.By,
+			Columns: orm.Columns,
+			GroupBy: orm.GroupBy,
+		},
+
+		CreatedBy: orm.CreatedBy,
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+	}
+}
+
+func (r *Repository) CreateLink(dm domain.TaskLink) error {
+	orm := TaskLink{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		FromUUID:       dm.FromUUID,
+		ToUUID:         dm.ToUUID,
+		Type:           dm.Type,
+		CreatedBy:      dm.CreatedBy,
+		CreatedByUUID:  dm.CreatedByUUID,
+	}
+
+	err := r.gorm.DB.Create(&orm).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errors.New("задачи уже связаны")
+	}
+
+	return err
+}
+
+func (r *Repository) DeleteLink(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&TaskLink{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("связь не найдена")
+	}
+
+	return nil
+}
+
+func (r *Repository) GetLink(uid uuid.UUID) (dm domain.TaskLink, err error) {
+	orm := TaskLink{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("связь не найдена")
+	}
+
+	return fromTaskLinkOrm(orm), err
+}
+
+// GetLinks - the links of the task in both directions.
+func (r *Repository) GetLinks(taskUUID uuid.UUID) (dms []domain.TaskLink, err error) {
+	orms := []TaskLink{}
+
+	err = r.gorm.DB.
+		Where("(from_uuid = ? or to_uuid = ?)", taskUUID, taskUUID).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&orms).Error
+
+	return lo.Map(orms, func(orm TaskLink, _ int) domain.TaskLink {
+		return fromTaskLinkOrm(orm)
+	}), err
+}
+
+// HasLinkPath tells whether `to` is reachable from `from` over the links of the type.
+func (r *Repository) HasLinkPath(linkType string, from, to uuid.UUID) (found bool, err error) {
+	err = r.gorm.DB.Raw(`with recursive reach(uuid) as (
+			select ?::uuid
+			union
+			select l.to_uuid from task_links l join reach on l.from_uuid = reach.uuid
+			where l.type = ? and l.deleted_at is null
+		)
+		select exists(select 1 from reach where uuid = ?)`, from, linkType, to).
+		Scan(&found).Error
+
+	return found, err
+}
+
+// GetLinkedTasks - the short form of the linked tasks, the deleted ones are skipped.
+func (r *Repository) GetLinkedTasks(uids []uuid.UUID) (dms []domain.Task, err error) {
+	orms := []Task{}
+
+	err = r.gorm.DB.
+		Select("uuid, id, name, status, project_uuid, federation_uuid, created_by, all_people").
+		Where("uuid in ?", uids).
+		Where("deleted_at is null").
+		Find(&orms).Error
+
+	return lo.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:           item.UUID,
+			ID:             item.ID,
+			Name:           item.Name,
+			Status:         item.Status,
+			ProjectUUID:    item.ProjectUUID,
+			FederationUUID: item.FederationUUID,
+			CreatedBy:      item.CreatedBy,
+			People:         item.AllPeople,
+		}
+	}), err
+}
+
+// GetBlockers - the tasks blocking the task.
+func (r *Repository) GetBlockers(taskUUID uuid.UUID) (dms []domain.Task, err error) {
+	orms := []Task{}
+
+	err = r.gorm.DB.
+		Table("tasks").
+		Select("tasks.uuid, tasks.id, tasks.name, tasks.status").
+		Joins("join task_links l on l.from_uuid = tasks.uuid").
+		Where("l.to_uuid = ?", taskUUID).
+		Where("l.type = ?", domain.TaskLinkBlocks).
+		Where("l.deleted_at is null").
+		Where("tasks.deleted_at is null").
+		Find(&orms).Error
+
+	return lo.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:   item.UUID,
+			ID:     item.ID,
+			Name:   item.Name,
+			Status: item.Status,
+		}
+	}), err
+}
+
+func fromTaskLinkOrm(orm TaskLink) domain.TaskLink {
+	return domain.TaskLink{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		FromUUID:       orm.FromUUID,
+		ToUUID:         orm.ToUUID,
+		Type:           orm.Type,
+		CreatedBy:      orm.CreatedBy,
+		CreatedByUUID:  orm.CreatedByUUID,
+		CreatedAt:      orm.CreatedAt,
+	}
+}
+
+func (r *Repository) CreateWorklog(dm domain.TaskWorklog) error {
+	orm := toTaskWorklogOrm(dm)
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) UpdateWorklog(dm domain.TaskWorklog) error {
+	res := r.gorm.DB.
+		Model(&TaskWorklog{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"started_at": dm.StartedAt,
+			"duration":   dm.Duration,
+			"comment":    dm.Comment,
+			"billable":   dm.Billable,
+			"updated_at": "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("запись времени не найдена")
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteWorklog(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&TaskWorklog{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("запись времени не найдена")
+	}
+
+	return nil
+}
+
+func (r *Repository) GetWorklog(uid uuid.UUID) (dm domain.TaskWorklog, err error) {
+	orm := TaskWorklog{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("запись времени не найдена")
+	}
+
+	return fromTaskWorklogOrm(orm), err
+}
+
+func (r *Repository) GetWorklogs(taskUUID uuid.UUID) (dms []domain.TaskWorklog, err error) {
+	orms := []TaskWorklog{}
+
+	err = r.gorm.DB.
+		Where("task_uuid = ?", taskUUID).
+		Where("deleted_at is null").
+		Order("started_at desc").
+		Find(&orms).Error
+
+	return lo.Map(orms, func(orm TaskWorklog, _ int) domain.TaskWorklog {
+		return fromTaskWorklogOrm(orm)
+	}), err
+}
+
+const worklogTotalSelect = "coalesce(sum(task_worklogs.duration), 0) as duration, coalesce(sum(task_worklogs.duration) filter (where task_worklogs.billable), 0) as billable"
+
+// WorklogTotal - the time spent on the task itself.
+func (r *Repository) WorklogTotal(taskUUID uuid.UUID) (total domain.WorklogTotal, err error) {
+	err = r.gorm.DB.
+		Model(&TaskWorklog{}).
+		Select(worklogTotalSelect).
+		Where("task_uuid = ?", taskUUID).
+		Where("deleted_at is null").
+		Scan(&total).Error
+
+	return total, err
+}
+
+// WorklogSubtreeTotal - the time spent on the task and its descendants along the path.
+func (r *Repository) WorklogSubtreeTotal(projectUUID, taskUUID uuid.UUID) (total domain.WorklogTotal, err error) {
+	err = r.gorm.DB.
+		Model(&TaskWorklog{}).
+		Select(worklogTotalSelect).
+		Joins("join tasks on tasks.uuid = task_worklogs.task_uuid and tasks.project_uuid = task_worklogs.project_uuid").
+		Where("task_worklogs.project_uuid = ?", projectUUID).
+		Where("tasks.path ~ ?", "*."+taskUUID.String()+".*").
+		Where("tasks.deleted_at is null").
+		Where("task_worklogs.deleted_at is null").
+		Scan(&total).Error
+
+	return total, err
+}
+
+// WorklogProjectTotal - the time spent on the project tasks, optionally in [from, to).
+func (r *Repository) WorklogProjectTotal(projectUUID uuid.UUID, from, to *time.Time) (total domain.WorklogTotal, err error) {
+	query := r.gorm.DB.
+		Model(&TaskWorklog{}).
+		Select(worklogTotalSelect).
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null")
+
+	if from != nil {
+		query = query.Where("started_at >= ?", *from)
+	}
+
+	if to != nil {
+		query = query.Where("started_at < ?", *to)
+	}
+
+	err = query.Scan(&total).Error
+
+	return total, err
+}
+
+func (r *Repository) GetTimesheet(filter domain.TimesheetFilter) (dms []domain.TaskWorklog, err error) {
+	orms := []TaskWorklog{}
+
+	query := r.gorm.DB.
+		Where("federation_uuid = ?", filter.FederationUUID).
+		Where("user_uuid = ?", filter.UserUUID).
+		Where("started_at >= ?", filter.From).
+		Where("started_at < ?", filter.To).
+		Where("deleted_at is null")
+
+	if filter.Billable != nil {
+		query = query.Where("billable = ?", *filter.Billable)
+	}
+
+	err = query.Order("started_at").Find(&orms).Error
+
+	return lo.Map(orms, func(orm TaskWorklog, _ int) domain.TaskWorklog {
+		return fromTaskWorklogOrm(orm)
+	}), err
+}
+
+func (r *Repository) CreateTimer(dm domain.TaskTimer) error {
+	orm := TaskTimer{
+		UserUUID:       dm.UserUUID,
+		FederationUUID: dm.FederationUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		TaskUUID:       dm.TaskUUID,
+		StartedAt:      dm.StartedAt,
+	}
+
+	err := r.gorm.DB.Create(&orm).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errors.New("таймер уже запущен")
+	}
+
+	return err
+}
+
+func (r *Repository) GetTimer(userUUID uuid.UUID) (dm domain.TaskTimer, err error) {
+	orm := TaskTimer{}
+
+	err = r.gorm.DB.
+		Where("user_uuid = ?", userUUID).
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("таймер не запущен")
+	}
+
+	return domain.TaskTimer{
+		UserUUID:       orm.UserUUID,
+		FederationUUID: orm.FederationUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		TaskUUID:       orm.TaskUUID,
+		StartedAt:      orm.StartedAt,
+	}, err
+}
+
+// StopTimer removes the timer and stores its worklog, the timer is stopped once.
+func (r *Repository) StopTimer(dm domain.TaskTimer, worklog domain.TaskWorklog) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		res := tx.
+			Where("user_uuid = ?", dm.UserUUID).
+			Where("started_at = ?", dm.StartedAt).
+			Delete(&TaskTimer{})
+
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("таймер не запущен")
+		}
+
+		orm := toTaskWorklogOrm(worklog)
+
+		return tx.Create(&orm).Error
+	})
+}
+
+func toTaskWorklogOrm(dm domain.TaskWorklog) TaskWorklog {
+	return TaskWorklog{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		TaskUUID:       dm.TaskUUID,
+		UserUUID:       dm.UserUUID,
+
+		StartedAt: dm.StartedAt,
+		Duration:  dm.Duration,
+		Comment:   dm.Comment,
+		Billable:  dm.Billable,
+
+		CreatedBy: dm.CreatedBy,
+	}
+}
+
+func fromTaskWorklogOrm(orm TaskWorklog) domain.TaskWorklog {
+	return domain.TaskWorklog{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		TaskUUID:       orm.TaskUUID,
+		UserUUID:       orm.UserUUID,
+
+		StartedAt: orm.StartedAt,
+		Duration:  orm.Duration,
+		Comment:   orm.Comment,
+		Billable:  orm.Billable,
+
+		CreatedBy: orm.CreatedBy,
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+	}
+}
+
+// GetDeadlineTasks - open tasks whose deadline reached a stage that was not notified yet.
+func (r *Repository) GetDeadlineTasks(now time.Time, limit int) (dms []domain.DeadlineTask, err error) {
+	defer r.storeTime("GetDeadlineTasks", tm())
+
+	orms := []DeadlineTask{}
+
+	err = r.gorm.DB.
+		Table("tasks").
+		Select("tasks.*, projects.options as project_options, projects.responsible_by as project_responsible_by").
+		Joins("join projects on projects.uuid = tasks.project_uuid").
+		Where("tasks.deleted_at is null").
+		Where("projects.deleted_at is null").
+		Where("tasks.finish_to is not null").
+		Where("tasks.status not in ?", []int{domain.StatusDone, domain.StatusCancel}).
+		Where("tasks.deadline_stage < ?", domain.DeadlineEscalated).
+		Where(`(
+			(tasks.deadline_stage < @approaching and coalesce((projects.options->>'deadline_lead_hours')::int, @lead) > 0
+				and tasks.finish_to - make_interval(hours => coalesce((projects.options->>'deadline_lead_hours')::int, @lead)) <= @now)
+			or (tasks.deadline_stage < @overdue and tasks.finish_to <= @now)
+			or (projects.options->>'deadline_escalate_hours' is not null
+				and tasks.finish_to + make_interval(hours => (projects.options->>'deadline_escalate_hours')::int) <= @now)
+		)`, map[string]interface{}{
+			"approaching": domain.DeadlineApproaching,
+			"overdue":     domain.DeadlineOverdue,
+			"lead":        domain.DefaultDeadlineLeadHours,
+			"now":         now,
+		}).
+		Order("tasks.finish_to").
+		Limit(limit).
+		Find(&orms).Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	return helpers.Map(orms, func(item DeadlineTask, _ int) domain.DeadlineTask {
+		return domain.DeadlineTask{
+			Task: domain.Task{
+				UUID:           item.UUID,
+				ID:             item.ID,
+				Name:           item.Name,
+				FederationUUID: item.FederationUUID,
+				ProjectUUID:    item.ProjectUUID,
+				CompanyUUID:    item.CompanyUUID,
+				Status:         item.Status,
+				ResponsibleBy:  item.ResponsibleBy,
+				ImplementBy:    item.ImplementBy,
+				ManagedBy:      item.ManagedBy,
+				FinishTo:       item.FinishTo,
+			},
+			ProjectOptions:       item.ProjectOptions,
+			ProjectResponsibleBy: item.ProjectResponsibleBy,
+			Stage:                item.DeadlineStage,
+		}
+	}), nil
+}
+
+// MarkDeadline moves the task to the stage, false when another replica or a newer stage got it first.
+func (r *Repository) MarkDeadline(uid uuid.UUID, stage int) (bool, error) {
+	defer r.storeTime("MarkDeadline", tm())
+
+	res := r.gorm.DB.
+		Model(&Task{}).
+		Where("uuid = ?", uid).
+		Where("deadline_stage < ?", stage).
+		Update("deadline_stage", stage)
+
+	return res.RowsAffected > 0, res.Error
+}
+
+// CreateChecklistItem puts the item at the end of the checklist.
+func (r *Repository) CreateChecklistItem(dm domain.TaskChecklistItem) error {
+	orm := toTaskChecklistItemOrm(dm)
+
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Model(&TaskChecklistItem{}).
+			Select("coalesce(max(position) + 1, 0)").
+			Where("task_uuid = ?", dm.TaskUUID).
+			Where("deleted_at is null").
+			Scan(&orm.Position).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&orm).Error
+		if err != nil {
+			return err
+		}
+
+		return countChecklist(tx, dm.TaskUUID)
+	})
+}
+
+func (r *Repository) UpdateChecklistItem(dm domain.TaskChecklistItem) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		res := tx.
+			Model(&TaskChecklistItem{}).
+			Where("uuid = ?", dm.UUID).
+			Where("deleted_at is null").
+			Updates(map[string]interface{}{
+				"text":        dm.Text,
+				"done":        dm.Done,
+				"assigned_to": dm.AssignedTo,
+				"due_at":      dm.DueAt,
+				"updated_at":  "now()",
+			})
+
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("пункт чек-листа не найден")
+		}
+
+		return countChecklist(tx, dm.TaskUUID)
+	})
+}
+
+func (r *Repository) DeleteChecklistItem(dm domain.TaskChecklistItem) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		res := tx.
+			Model(&TaskChecklistItem{}).
+			Where("uuid = ?", dm.UUID).
+			Where("deleted_at is null").
+			Update("deleted_at", "now()")
+
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("пункт чек-листа не найден")
+		}
+
+		return countChecklist(tx, dm.TaskUUID)
+	})
+}
+
+func (r *Repository) ReorderChecklist(dms []domain.TaskChecklistItem) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		for _, dm := range dms {
+			err := tx.
+				Model(&TaskChecklistItem{}).
+				Where("uuid = ?", dm.UUID).
+				Update("position", dm.Position).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) GetChecklistItem(uid uuid.UUID) (dm domain.TaskChecklistItem, err error) {
+	orm := TaskChecklistItem{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("пункт чек-листа не найден")
+	}
+
+	return fromTaskChecklistItemOrm(orm), err
+}
+
+func (r *Repository) GetChecklist(taskUUID uuid.UUID) (dms []domain.TaskChecklistItem, err error) {
+	orms := []TaskChecklistItem{}
+
+	err = r.gorm.DB.
+		Where("task_uuid = ?", taskUUID).
+		Where("deleted_at is null").
+		Order("position, created_at").
+		Find(&orms).Error
+
+	return lo.Map(orms, func(orm TaskChecklistItem, _ int) domain.TaskChecklistItem {
+		return fromTaskChecklistItemOrm(orm)
+	}), err
+}
+
+// countChecklist refreshes the checklist progress kept on the task.
+func countChecklist(tx *gorm.DB, taskUUID uuid.UUID) error {
+	return tx.Exec(`update tasks set
+		checklist_total = (select count(*) from task_checklist_items where task_uuid = @uuid and deleted_at is null),
+		checklist_done = (select count(*) from task_checklist_items where task_uuid = @uuid and deleted_at is null and done)
+		where uuid = @uuid`, map[string]interface{}{"uuid": taskUUID}).Error
+}
+
+func toTaskChecklistItemOrm(dm domain.TaskChecklistItem) TaskChecklistItem {
+	return TaskChecklistItem{
+		UUID:     dm.UUID,
+		TaskUUID: dm.TaskUUID,
+		Position: dm.Position,
+
+		Text:       dm.Text,
+		Done:       dm.Done,
+		AssignedTo: dm.AssignedTo,
+		DueAt:      dm.DueAt,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+func fromTaskChecklistItemOrm(orm TaskChecklistItem) domain.TaskChecklistItem {
+	return domain.TaskChecklistItem{
+		UUID:     orm.UUID,
+		TaskUUID: orm.TaskUUID,
+		Position: orm.Position,
+
+		Text:       orm.Text,
+		Done:       orm.Done,
+		AssignedTo: orm.AssignedTo,
+		DueAt:      orm.DueAt,
+
+		CreatedBy: orm.CreatedBy,
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+	}
+}
+
+func (r *Repository) CreateTemplate(dm domain.TaskTemplate) error {
+	orm := toTaskTemplateOrm(dm)
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) UpdateTemplate(dm domain.TaskTemplate) error {
+	res := r.gorm.DB.
+		Model(&TaskTemplate{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"name":       dm.Name,
+			"root":       dm.Root,
+			"updated_at": "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("шаблон не найден")
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteTemplate(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&TaskTemplate{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("шаблон не найден")
+	}
+
+	return nil
+}
+
+func (r *Repository) GetTemplate(uid uuid.UUID) (dm domain.TaskTemplate, err error) {
+	orm := TaskTemplate{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("шаблон не найден")
+	}
+
+	return fromTaskTemplateOrm(orm), err
+}
+
+// GetTemplates - the company templates and, when the project is set, the project ones.
+func (r *Repository) GetTemplates(companyUUID uuid.UUID, projectUUID *uuid.UUID) (dms []domain.TaskTemplate, err error) {
+	orms := []TaskTemplate{}
+
+	query := r.gorm.DB.
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null")
+
+	if projectUUID != nil {
+		query = query.Where("(project_uuid is null or project_uuid = ?)", *projectUUID)
+	} else {
+		query = query.Where("project_uuid is null")
+	}
+
+	err = query.
+		Order("project_uuid nulls first, name").
+		Find(&orms).Error
+
+	return lo.Map(orms, func(orm TaskTemplate, _ int) domain.TaskTemplate {
+		return fromTaskTemplateOrm(orm)
+	}), err
+}
+
+func toTaskTemplateOrm(dm domain.TaskTemplate) TaskTemplate {
+	return TaskTemplate{
+		UUID:        dm.UUID,
+		CompanyUUID: dm.CompanyUUID,
+		ProjectUUID: dm.ProjectUUID,
+		Name:        dm.Name,
+		Root:        dm.Root,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+func fromTaskTemplateOrm(orm TaskTemplate) domain.TaskTemplate {
+	return domain.TaskTemplate{
+		UUID:        orm.UUID,
+		CompanyUUID: orm.CompanyUUID,
+		ProjectUUID: orm.ProjectUUID,
+		Name:        orm.Name,
+		Root:        orm.Root,
+
+		CreatedBy: orm.CreatedBy,
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+	}
+}
+
+// CreateRecurrence stores the series and links its source task to it.
+func (r *Repository) CreateRecurrence(dm domain.TaskRecurrence) error {
+	orm := toTaskRecurrenceOrm(dm)
+
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&orm).Error
+		if err != nil {
+			return err
+		}
+
+		if dm.TaskUUID == nil {
+			return nil
+		}
+
+		return tx.
+			Model(&Task{}).
+			Where("uuid = ?", *dm.TaskUUID).
+			Update("recurrence_uuid", dm.UUID).Error
+	})
+}
+
+func (r *Repository) UpdateRecurrence(dm domain.TaskRecurrence) error {
+	res := r.gorm.DB.
+		Model(&TaskRecurrence{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"vars":          dm.Vars,
+			"schedule":      dm.Schedule,
+			"start_at":      dm.StartAt,
+			"next_at":       dm.NextAt,
+			"occurrence":    dm.Occurrence,
+			"paused":        dm.Paused,
+			"finish_offset": dm.FinishOffset,
+			"updated_at":    "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("повторение не найдено")
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteRecurrence(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&TaskRecurrence{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("повторение не найдено")
+	}
+
+	return nil
+}
+
+func (r *Repository) GetRecurrence(uid uuid.UUID) (dm domain.TaskRecurrence, err error) {
+	orm := TaskRecurrence{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("повторение не найдено")
+	}
+
+	return fromTaskRecurrenceOrm(orm), err
+}
+
+func (r *Repository) GetRecurrences(projectUUID uuid.UUID) (dms []domain.TaskRecurrence, err error) {
+	orms := []TaskRecurrence{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&orms).Error
+
+	return lo.Map(orms, func(orm TaskRecurrence, _ int) domain.TaskRecurrence {
+		return fromTaskRecurrenceOrm(orm)
+	}), err
+}
+
+// GetDueRecurrences - running series whose next occurrence has come.
+func (r *Repository) GetDueRecurrences(now time.Time, limit int) (dms []domain.TaskRecurrence, err error) {
+	defer r.storeTime("GetDueRecurrences", tm())
+
+	orms := []TaskRecurrence{}
+
+	err = r.gorm.DB.
+		Where("deleted_at is null").
+		Where("paused = false").
+		Where("next_at <= ?", now).
+		Order("next_at").
+		Limit(limit).
+		Find(&orms).Error
+
+	return lo.Map(orms, func(orm TaskRecurrence, _ int) domain.TaskRecurrence {
+		return fromTaskRecurrenceOrm(orm)
+	}), err
+}
+
+// AdvanceRecurrence moves the series from the occurrence, false when another replica, a pause or an edit got it first.
+func (r *Repository) AdvanceRecurrence(dm domain.TaskRecurrence, occurrence time.Time) (bool, error) {
+	defer r.storeTime("AdvanceRecurrence", tm())
+
+	res := r.gorm.DB.
+		Model(&TaskRecurrence{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Where("paused = false").
+		Where("next_at = ?", occurrence).
+		Updates(map[string]interface{}{
+			"next_at":    dm.NextAt,
+			"occurrence": dm.Occurrence,
+		})
+
+	return res.RowsAffected > 0, res.Error
+}
+
+func toTaskRecurrenceOrm(dm domain.TaskRecurrence) TaskRecurrence {
+	return TaskRecurrence{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		TaskUUID:       dm.TaskUUID,
+		TemplateUUID:   dm.TemplateUUID,
+		Vars:           dm.Vars,
+
+		Schedule:     dm.Schedule,
+		StartAt:      dm.StartAt,
+		NextAt:       dm.NextAt,
+		Occurrence:   dm.Occurrence,
+		Paused:       dm.Paused,
+		FinishOffset: dm.FinishOffset,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+func fromTaskRecurrenceOrm(orm TaskRecurrence) domain.TaskRecurrence {
+	return domain.TaskRecurrence{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		TaskUUID:       orm.TaskUUID,
+		TemplateUUID:   orm.TemplateUUID,
+		Vars:           orm.Vars,
+
+		Schedule:     orm.Schedule,
+		StartAt:      orm.StartAt,
+		NextAt:       orm.NextAt,
+		Occurrence:   orm.Occurrence,
+		Paused:       orm.Paused,
+		FinishOffset: orm.FinishOffset,
+
+		CreatedBy: orm.CreatedBy,
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+	}
+}
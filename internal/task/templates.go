@@ -0,0 +1,62 @@
+package task
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func (s *Service) CreateTemplate(dm domain.TaskTemplate) error {
+	return s.repo.CreateTemplate(dm)
+}
+
+func (s *Service) UpdateTemplate(dm domain.TaskTemplate) error {
+	err := dm.Validate()
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateTemplate(dm)
+}
+
+func (s *Service) DeleteTemplate(uid uuid.UUID) error {
+	return s.repo.DeleteTemplate(uid)
+}
+
+func (s *Service) GetTemplate(uid uuid.UUID) (domain.TaskTemplate, error) {
+	return s.repo.GetTemplate(uid)
+}
+
+func (s *Service) GetTemplates(companyUUID uuid.UUID, projectUUID *uuid.UUID) ([]domain.TaskTemplate, error) {
+	return s.repo.GetTemplates(companyUUID, projectUUID)
+}
+
+// InstantiateTemplate creates the template tree in one batch, the fields are filtered by the project as in CreateTask.
+func (s *Service) InstantiateTemplate(dm domain.TaskTemplate, target domain.TaskTemplateTarget, now time.Time) ([]domain.Task, error) {
+	tasks, err := dm.Instantiate(target, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range tasks {
+		tasks[i].Fields, err = s.FilterTaskFields(tasks[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.CreateTaskBatch(target.CreatedBy, tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	if path := tasks[0].Path; len(path) >= 2 || len(tasks) > 1 {
+		_, err = s.repo.UpdateChildTotal(uuid.MustParse(path[0]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tasks, nil
+}
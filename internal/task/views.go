@@ -0,0 +1,71 @@
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+func (s *Service) CreateView(dm domain.TaskView, fieldsSort []string) error {
+	err := s.checkView(dm, fieldsSort)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.CreateView(dm)
+}
+
+func (s *Service) UpdateView(dm domain.TaskView, fieldsSort []string) error {
+	err := s.checkView(dm, fieldsSort)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateView(dm)
+}
+
+func (s *Service) DeleteView(uid uuid.UUID) error {
+	return s.repo.DeleteView(uid)
+}
+
+func (s *Service) GetView(uid uuid.UUID) (domain.TaskView, error) {
+	return s.repo.GetView(uid)
+}
+
+func (s *Service) GetViews(projectUUID, userUUID uuid.UUID) ([]domain.TaskView, error) {
+	return s.repo.GetViews(projectUUID, userUUID)
+}
+
+// checkView - the sort, the grouping and the columns are project sort fields, the filter is checked as in GetTasks.
+func (s *Service) checkView(dm domain.TaskView, fieldsSort []string) error {
+	allowSort := s.GetSortFields(dm.ProjectUUID)
+
+	if dm.Order != nil && !lo.Contains(allowSort, *dm.Order) {
+		return fmt.Errorf("сортировка по полю невозможна: %s", *dm.Order)
+	}
+
+	if dm.GroupBy != nil && !lo.Contains(allowSort, *dm.GroupBy) && *dm.GroupBy != "tags" {
+		return fmt.Errorf("группировка по полю невозможна: %s", *dm.GroupBy)
+	}
+
+	for _, column := range dm.Columns {
+		if !lo.Contains(fieldsSort, column) && !lo.Contains(allowSort, column) {
+			return fmt.Errorf("колонка не найдена: %s", column)
+		}
+	}
+
+	filters, err := dto.NewFilterDTO(dm.Filter.Fields)
+	if err != nil {
+		return err
+	}
+
+	projectFields, _ := s.dict.FindProjectFields(dm.ProjectUUID)
+
+	return dto.CheckFilters(filters, filterColumns, lo.SliceToMap(projectFields, func(f dto.ProjectFieldDTO) (string, domain.FieldDataType) {
+		return f.Hash, domain.FieldDataType(f.DataType)
+	}), time.Now())
+}
@@ -0,0 +1,122 @@
+package task
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+func (s *Service) CreateWorklog(dm domain.TaskWorklog) error {
+	return s.repo.CreateWorklog(dm)
+}
+
+func (s *Service) UpdateWorklog(dm domain.TaskWorklog) error {
+	err := dm.Validate()
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateWorklog(dm)
+}
+
+func (s *Service) DeleteWorklog(uid uuid.UUID) error {
+	return s.repo.DeleteWorklog(uid)
+}
+
+func (s *Service) GetWorklog(uid uuid.UUID) (domain.TaskWorklog, error) {
+	return s.repo.GetWorklog(uid)
+}
+
+// GetWorklogs returns the worklogs of the task, its own total and the total of its subtree (an epic with the descendants).
+func (s *Service) GetWorklogs(task domain.Task) (dms []domain.TaskWorklog, total, subtree domain.WorklogTotal, err error) {
+	dms, err = s.repo.GetWorklogs(task.UUID)
+	if err != nil {
+		return dms, total, subtree, err
+	}
+
+	total, err = s.repo.WorklogTotal(task.UUID)
+	if err != nil {
+		return dms, total, subtree, err
+	}
+
+	subtree, err = s.repo.WorklogSubtreeTotal(task.ProjectUUID, task.UUID)
+
+	return dms, total, subtree, err
+}
+
+func (s *Service) GetProjectWorklogTotal(projectUUID uuid.UUID, from, to *time.Time) (domain.WorklogTotal, error) {
+	return s.repo.WorklogProjectTotal(projectUUID, from, to)
+}
+
+// GetTimesheet returns the worklogs of the filter and the short form of their tasks.
+func (s *Service) GetTimesheet(filter domain.TimesheetFilter) (dms []domain.TaskWorklog, tasks map[uuid.UUID]domain.Task, err error) {
+	err = filter.Validate()
+	if err != nil {
+		return dms, tasks, err
+	}
+
+	dms, err = s.repo.GetTimesheet(filter)
+	if err != nil || len(dms) == 0 {
+		return dms, tasks, err
+	}
+
+	linked, err := s.repo.GetLinkedTasks(lo.Uniq(lo.Map(dms, func(w domain.TaskWorklog, _ int) uuid.UUID {
+		return w.TaskUUID
+	})))
+	if err != nil {
+		return dms, tasks, err
+	}
+
+	return dms, lo.KeyBy(linked, func(t domain.Task) uuid.UUID {
+		return t.UUID
+	}), nil
+}
+
+func (s *Service) GetTimer(userUUID uuid.UUID) (domain.TaskTimer, error) {
+	return s.repo.GetTimer(userUUID)
+}
+
+// StartTimer starts the timer of the user on the task, the timer running on another task is stopped first.
+func (s *Service) StartTimer(crt domain.Creator, task domain.Task) (stopped *domain.TaskWorklog, err error) {
+	running, err := s.repo.GetTimer(crt.UUID)
+
+	var notFoundErr dto.NotFoundError
+	if err != nil && !errors.As(err, &notFoundErr) {
+		return nil, err
+	}
+
+	if err == nil {
+		if running.TaskUUID == task.UUID {
+			return nil, errors.New("таймер уже запущен")
+		}
+
+		stopped, err = s.stopTimer(crt, running)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stopped, s.repo.CreateTimer(domain.NewTaskTimer(task, crt.UUID))
+}
+
+func (s *Service) StopTimer(crt domain.Creator) (*domain.TaskWorklog, error) {
+	running, err := s.repo.GetTimer(crt.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.stopTimer(crt, running)
+}
+
+func (s *Service) stopTimer(crt domain.Creator, timer domain.TaskTimer) (*domain.TaskWorklog, error) {
+	worklog, err := timer.Stop(crt, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return worklog, s.repo.StopTimer(timer, *worklog)
+}
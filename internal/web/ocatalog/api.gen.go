@@ -71,8 +71,10 @@ type Uuid = openapi_types.UUID
 
 // GetCatalogUUIDDataParams defines parameters for GetCatalogUUIDData.
 type GetCatalogUUIDDataParams struct {
-	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
-	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Fields JSON object {"<field>": value} or {"<field>": {"op": "<operator>", "value": value}}. Operators: =, !=, <, <=, >, >=, between, in, not in, contains, starts with, is null, is not null. Dates also take today, yesterday, tomorrow, this week, this month, last|next N days|weeks|months. These and dates without time are [from, to) ranges, = of a date means its whole day, between of dates excludes its end
 	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 	Order  *string `form:"order,omitempty" json:"order,omitempty"`
 	By     *string `form:"by,omitempty" json:"by,omitempty"`
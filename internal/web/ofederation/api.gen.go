@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"time"
 
@@ -40,6 +42,17 @@ type AgentCreateRequest struct {
 // AgentDTO defines model for AgentDTO.
 type AgentDTO = dto.AgentDTO
 
+// AgentDuplicatesDTO defines model for AgentDuplicatesDTO.
+type AgentDuplicatesDTO = dto.AgentDuplicatesDTO
+
+// AgentImportDTO defines model for AgentImportDTO.
+type AgentImportDTO = dto.AgentImportDTO
+
+// AgentMergeRequest defines model for AgentMergeRequest.
+type AgentMergeRequest struct {
+	Agents []openapi_types.UUID `json:"agents" validate:"min=1,max=50,dive,uuid"`
+}
+
 // AgentPatchRequest defines model for AgentPatchRequest.
 type AgentPatchRequest struct {
 	Contacts []struct {
@@ -49,6 +62,9 @@ type AgentPatchRequest struct {
 	Name string `json:"name" validate:"trim,name,min=3,max=100"`
 }
 
+// AgentTimelineItemDTO defines model for AgentTimelineItemDTO.
+type AgentTimelineItemDTO = dto.AgentTimelineItemDTO
+
 // CompanyAddUserRequest defines model for CompanyAddUserRequest.
 type CompanyAddUserRequest struct {
 	UserUuid openapi_types.UUID `json:"user_uuid" validate:"uuid"`
@@ -70,6 +86,9 @@ type CompanyPriorityCreateRequest struct {
 // CompanyPriorityDTO defines model for CompanyPriorityDTO.
 type CompanyPriorityDTO = dto.CompanyPriorityDTO
 
+// EffectivePermissionsDTO defines model for EffectivePermissionsDTO.
+type EffectivePermissionsDTO = dto.EffectivePermissionsDTO
+
 // FederationAddUserRequest defines model for FederationAddUserRequest.
 type FederationAddUserRequest struct {
 	UserUuid openapi_types.UUID `json:"user_uuid" validate:"uuid"`
@@ -124,6 +143,20 @@ type PermissionCreateRequest struct {
 	Uuid           openapi_types.UUID `json:"uuid" validate:"uuid"`
 }
 
+// PermissionRoleAssignRequest defines model for PermissionRoleAssignRequest.
+type PermissionRoleAssignRequest struct {
+	Allow      *[]string          `json:"allow,omitempty" validate:"omitempty,max=50"`
+	Deny       *[]string          `json:"deny,omitempty" validate:"omitempty,max=50"`
+	EntityUuid openapi_types.UUID `json:"entity_uuid" validate:"uuid"`
+	Role       *string            `json:"role,omitempty" validate:"omitempty,max=20"`
+}
+
+// PermissionRoleAssignmentDTO defines model for PermissionRoleAssignmentDTO.
+type PermissionRoleAssignmentDTO = dto.PermissionRoleAssignmentDTO
+
+// PermissionRoleDTO defines model for PermissionRoleDTO.
+type PermissionRoleDTO = dto.PermissionRoleDTO
+
 // PermissionRulesDTO defines model for PermissionRulesDTO.
 type PermissionRulesDTO = dto.PermissionRulesDTO
 
@@ -171,10 +204,17 @@ type ProjectFieldPutRequest struct {
 
 // ProjectRequestOptions defines model for ProjectRequestOptions.
 type ProjectRequestOptions struct {
-	Color                     *string `json:"color,omitempty" validate:"omitempty,color"`
-	RequireCancelationComment *bool   `json:"require_cancelation_comment,omitempty"`
-	RequireDoneComment        *bool   `json:"require_done_comment,omitempty"`
-	StatusEnable              *bool   `json:"status_enable,omitempty"`
+	Color *string `json:"color,omitempty" validate:"omitempty,color"`
+
+	// DeadlineEscalateHours Hours after finish_to to notify the project responsible, never when empty
+	DeadlineEscalateHours *int `json:"deadline_escalate_hours,omitempty" validate:"omitempty,min=0,max=720"`
+
+	// DeadlineLeadHours Hours before finish_to to warn the task people, 24 when empty, 0 turns it off
+	DeadlineLeadHours         *int  `json:"deadline_lead_hours,omitempty" validate:"omitempty,min=0,max=720"`
+	Private                   *bool `json:"private,omitempty"`
+	RequireCancelationComment *bool `json:"require_cancelation_comment,omitempty"`
+	RequireDoneComment        *bool `json:"require_done_comment,omitempty"`
+	StatusEnable              *bool `json:"status_enable,omitempty"`
 }
 
 // ProjectRequestParams defines model for ProjectRequestParams.
@@ -197,6 +237,9 @@ type ProjectStatusCreateRequest struct {
 // ProjectStatusDTO defines model for ProjectStatusDTO.
 type ProjectStatusDTO = dto.ProjectStatusDTO
 
+// SearchHitDTO defines model for SearchHitDTO.
+type SearchHitDTO = dto.SearchHitDTO
+
 // SearchUserRequest defines model for SearchUserRequest.
 type SearchUserRequest struct {
 	CompanyUuid    *openapi_types.UUID `json:"company_uuid" validate:"omitempty,uuid"`
@@ -226,6 +269,74 @@ type TagCreateRequest struct {
 // TagDTO defines model for TagDTO.
 type TagDTO = dto.TagDTO
 
+// TaskImportDTO defines model for TaskImportDTO.
+type TaskImportDTO = dto.TaskImportDTO
+
+// TaskRecurrenceDTO defines model for TaskRecurrenceDTO.
+type TaskRecurrenceDTO = dto.TaskRecurrenceDTO
+
+// TaskRecurrenceRequest defines model for TaskRecurrenceRequest.
+type TaskRecurrenceRequest struct {
+	Paused   *bool        `json:"paused,omitempty"`
+	Schedule TaskSchedule `json:"schedule"`
+	StartAt  *time.Time   `json:"start_at,omitempty"`
+
+	// Vars only for a template series
+	Vars *map[string]string `json:"vars,omitempty"`
+}
+
+// TaskSchedule defines model for TaskSchedule.
+type TaskSchedule = domain.TaskSchedule
+
+// TaskTemplateDTO defines model for TaskTemplateDTO.
+type TaskTemplateDTO = dto.TaskTemplateDTO
+
+// TaskTemplateInstantiateRequest defines model for TaskTemplateInstantiateRequest.
+type TaskTemplateInstantiateRequest struct {
+	ParentUuid  *openapi_types.UUID `json:"parent_uuid,omitempty"`
+	ProjectUuid openapi_types.UUID  `json:"project_uuid"`
+	Vars        *map[string]string  `json:"vars,omitempty"`
+}
+
+// TaskTemplateNode defines model for TaskTemplateNode.
+type TaskTemplateNode = domain.TaskTemplateNode
+
+// TaskTemplateRecurrenceRequest defines model for TaskTemplateRecurrenceRequest.
+type TaskTemplateRecurrenceRequest struct {
+	ProjectUuid openapi_types.UUID `json:"project_uuid"`
+	Schedule    TaskSchedule       `json:"schedule"`
+	StartAt     *time.Time         `json:"start_at,omitempty"`
+	Vars        *map[string]string `json:"vars,omitempty"`
+}
+
+// TaskTemplateRequest defines model for TaskTemplateRequest.
+type TaskTemplateRequest struct {
+	Name string           `json:"name" validate:"trim,min=3,max=100"`
+	Root TaskTemplateNode `json:"root"`
+}
+
+// TaskViewDTO defines model for TaskViewDTO.
+type TaskViewDTO = dto.TaskViewDTO
+
+// TaskViewFilter The params of GET /task, fields is the raw `fields` param
+type TaskViewFilter = domain.TaskViewFilter
+
+// TaskViewRequest defines model for TaskViewRequest.
+type TaskViewRequest struct {
+	By *string `json:"by,omitempty" validate:"omitempty,oneof=asc desc"`
+
+	// Columns Visible columns in their order, from the project fields_sort or the sort fields
+	Columns *[]string       `json:"columns,omitempty" validate:"omitempty,max=50"`
+	Filter  *TaskViewFilter `json:"filter,omitempty"`
+	GroupBy *string         `json:"group_by,omitempty" validate:"omitempty,max=100"`
+	Name    string          `json:"name" validate:"trim,min=1,max=100"`
+	Order   *string         `json:"order,omitempty" validate:"omitempty,max=100"`
+	Shared  *bool           `json:"shared,omitempty"`
+}
+
+// TimesheetRowDTO defines model for TimesheetRowDTO.
+type TimesheetRowDTO = dto.TimesheetRowDTO
+
 // UUIDResponse defines model for UUIDResponse.
 type UUIDResponse struct {
 	Uuid openapi_types.UUID `json:"uuid"`
@@ -234,6 +345,27 @@ type UUIDResponse struct {
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
+// WebhookDTO defines model for WebhookDTO.
+type WebhookDTO = dto.WebhookDTO
+
+// WebhookDeliveryDTO defines model for WebhookDeliveryDTO.
+type WebhookDeliveryDTO = dto.WebhookDeliveryDTO
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	Active *bool `json:"active,omitempty"`
+
+	// Events task.created, task.updated, task.status_changed, task.deleted, comment.added, comment.updated, comment.deleted, comment.liked, reminder.created, reminder.updated, reminder.status_changed, reminder.deleted, reminder.due
+	Events []string `json:"events" validate:"min=1,max=50"`
+
+	// Secret The HMAC-SHA256 key of the X-Webhook-Signature header, generated when empty
+	Secret *string `json:"secret,omitempty" validate:"omitempty,min=16,max=200"`
+	Url    string  `json:"url" validate:"http_url,max=2000"`
+}
+
+// WorklogTotalDTO defines model for WorklogTotalDTO.
+type WorklogTotalDTO = dto.WorklogTotalDTO
+
 // EntityName defines model for entityName.
 type EntityName = string
 
@@ -288,6 +420,35 @@ type GetFederationUUIDAgentParams struct {
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetFederationUUIDAgentExportParams defines parameters for GetFederationUUIDAgentExport.
+type GetFederationUUIDAgentExportParams struct {
+	Format      *string             `form:"format,omitempty" json:"format,omitempty"`
+	CompanyUuid *openapi_types.UUID `form:"company_uuid,omitempty" json:"company_uuid,omitempty"`
+	Name        *string             `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// PostFederationUUIDAgentImportMultipartBody defines parameters for PostFederationUUIDAgentImport.
+type PostFederationUUIDAgentImportMultipartBody struct {
+	CompanyUuid *openapi_types.UUID `json:"company_uuid,omitempty"`
+	File        openapi_types.File  `json:"file"`
+	Mapping     *string             `json:"mapping,omitempty"`
+}
+
+// PostFederationUUIDAgentImportParams defines parameters for PostFederationUUIDAgentImport.
+type PostFederationUUIDAgentImportParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetFederationUUIDAgentEntityUUIDTimelineParams defines parameters for GetFederationUUIDAgentEntityUUIDTimeline.
+type GetFederationUUIDAgentEntityUUIDTimelineParams struct {
+	Offset *int       `form:"offset,omitempty" json:"offset,omitempty" validate:"min=0,max=1000"`
+	Limit  *int       `form:"limit,omitempty" json:"limit,omitempty" validate:"min=1,max=200"`
+	From   *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Exclusive
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // GetFederationUUIDProjectParams defines parameters for GetFederationUUIDProject.
 type GetFederationUUIDProjectParams struct {
 	Limit       *int                `form:"limit,omitempty" json:"limit,omitempty"`
@@ -305,6 +466,26 @@ type PostGroupUUIDUserJSONBody struct {
 	Uuid openapi_types.UUID `json:"uuid" validate:"uuid"`
 }
 
+// DeletePermissionsUUIDParams defines parameters for DeletePermissionsUUID.
+type DeletePermissionsUUIDParams struct {
+	FederationUuid openapi_types.UUID `form:"federation_uuid" json:"federation_uuid"`
+}
+
+// GetPermissionsUUIDParams defines parameters for GetPermissionsUUID.
+type GetPermissionsUUIDParams struct {
+	FederationUuid openapi_types.UUID `form:"federation_uuid" json:"federation_uuid"`
+}
+
+// GetPermissionsUUIDEffectiveParams defines parameters for GetPermissionsUUIDEffective.
+type GetPermissionsUUIDEffectiveParams struct {
+	EntityUuid openapi_types.UUID `form:"entity_uuid" json:"entity_uuid"`
+}
+
+// GetPermissionsUUIDRolesParams defines parameters for GetPermissionsUUIDRoles.
+type GetPermissionsUUIDRolesParams struct {
+	FederationUuid openapi_types.UUID `form:"federation_uuid" json:"federation_uuid"`
+}
+
 // PostProjectUUIDCatalogJSONBody defines parameters for PostProjectUUIDCatalog.
 type PostProjectUUIDCatalogJSONBody struct {
 	CatalogName domain.ProjectCatalogType `json:"catalog_name" validate:"trim,required,eq=reasons|eq=reasons"`
@@ -334,6 +515,36 @@ type PatchProjectUUIDStatusEntityUUIDJSONBody struct {
 	Name        string `json:"name" validate:"trim,min=1,max=50"`
 }
 
+// PostProjectUUIDTaskImportMultipartBody defines parameters for PostProjectUUIDTaskImport.
+type PostProjectUUIDTaskImportMultipartBody struct {
+	File    openapi_types.File `json:"file"`
+	Mapping *string            `json:"mapping,omitempty"`
+}
+
+// PostProjectUUIDTaskImportParams defines parameters for PostProjectUUIDTaskImport.
+type PostProjectUUIDTaskImportParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetProjectUUIDWorklogParams defines parameters for GetProjectUUIDWorklog.
+type GetProjectUUIDWorklogParams struct {
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Exclusive
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetSearchParams defines parameters for GetSearch.
+type GetSearchParams struct {
+	FederationUuid openapi_types.UUID `form:"federation_uuid" json:"federation_uuid"`
+	Q              string             `form:"q" json:"q" validate:"trim,min=2,max=200"`
+
+	// Types task, comment, catalog; all of them when empty
+	Types  *[]string `form:"types,omitempty" json:"types,omitempty"`
+	Offset *int      `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int      `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetTagParams defines parameters for GetTag.
 type GetTagParams struct {
 	CompanyUuid openapi_types.UUID `form:"company_uuid" json:"company_uuid"`
@@ -345,6 +556,23 @@ type PatchTagUUIDJSONBody struct {
 	Name  string `json:"name" validate:"trim,min=1,max=100"`
 }
 
+// GetTimesheetParams defines parameters for GetTimesheet.
+type GetTimesheetParams struct {
+	FederationUuid openapi_types.UUID `form:"federation_uuid" json:"federation_uuid"`
+
+	// UserUuid The current user when empty
+	UserUuid *openapi_types.UUID `form:"user_uuid,omitempty" json:"user_uuid,omitempty"`
+	From     time.Time           `form:"from" json:"from"`
+	To       time.Time           `form:"to" json:"to"`
+	Billable *bool               `form:"billable,omitempty" json:"billable,omitempty"`
+	Format   *string             `form:"format,omitempty" json:"format,omitempty" validate:"omitempty,oneof=json xlsx"`
+}
+
+// GetWebhookUUIDDeliveryParams defines parameters for GetWebhookUUIDDelivery.
+type GetWebhookUUIDDeliveryParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // PostCompanyJSONRequestBody defines body for PostCompany for application/json ContentType.
 type PostCompanyJSONRequestBody = FederationCreateCompanyRequest
 
@@ -378,18 +606,30 @@ type PostCompanyUUIDSmsOptionsJSONRequestBody PostCompanyUUIDSmsOptionsJSONBody
 // PostCompanyUUIDSmsSendJSONRequestBody defines body for PostCompanyUUIDSmsSend for application/json ContentType.
 type PostCompanyUUIDSmsSendJSONRequestBody PostCompanyUUIDSmsSendJSONBody
 
+// PostCompanyUUIDTemplateJSONRequestBody defines body for PostCompanyUUIDTemplate for application/json ContentType.
+type PostCompanyUUIDTemplateJSONRequestBody = TaskTemplateRequest
+
 // PostCompanyUUIDUserJSONRequestBody defines body for PostCompanyUUIDUser for application/json ContentType.
 type PostCompanyUUIDUserJSONRequestBody = CompanyAddUserRequest
 
+// PostCompanyUUIDWebhookJSONRequestBody defines body for PostCompanyUUIDWebhook for application/json ContentType.
+type PostCompanyUUIDWebhookJSONRequestBody = WebhookRequest
+
 // PostFederationJSONRequestBody defines body for PostFederation for application/json ContentType.
 type PostFederationJSONRequestBody = FederationCreateRequest
 
 // PostFederationUUIDAgentJSONRequestBody defines body for PostFederationUUIDAgent for application/json ContentType.
 type PostFederationUUIDAgentJSONRequestBody = AgentCreateRequest
 
+// PostFederationUUIDAgentImportMultipartRequestBody defines body for PostFederationUUIDAgentImport for multipart/form-data ContentType.
+type PostFederationUUIDAgentImportMultipartRequestBody PostFederationUUIDAgentImportMultipartBody
+
 // PatchFederationUUIDAgentEntityUUIDJSONRequestBody defines body for PatchFederationUUIDAgentEntityUUID for application/json ContentType.
 type PatchFederationUUIDAgentEntityUUIDJSONRequestBody = AgentPatchRequest
 
+// PostFederationUUIDAgentEntityUUIDMergeJSONRequestBody defines body for PostFederationUUIDAgentEntityUUIDMerge for application/json ContentType.
+type PostFederationUUIDAgentEntityUUIDMergeJSONRequestBody = AgentMergeRequest
+
 // PostFederationUUIDInviteJSONRequestBody defines body for PostFederationUUIDInvite for application/json ContentType.
 type PostFederationUUIDInviteJSONRequestBody = InviteCreateRequest
 
@@ -399,6 +639,9 @@ type PatchFederationUUIDNameJSONRequestBody = NameRequiredRequest
 // PostFederationUUIDUserJSONRequestBody defines body for PostFederationUUIDUser for application/json ContentType.
 type PostFederationUUIDUserJSONRequestBody = FederationAddUserRequest
 
+// PostFederationUUIDWebhookJSONRequestBody defines body for PostFederationUUIDWebhook for application/json ContentType.
+type PostFederationUUIDWebhookJSONRequestBody = WebhookRequest
+
 // DeleteGroupUUIDUserJSONRequestBody defines body for DeleteGroupUUIDUser for application/json ContentType.
 type DeleteGroupUUIDUserJSONRequestBody DeleteGroupUUIDUserJSONBody
 
@@ -408,6 +651,9 @@ type PostGroupUUIDUserJSONRequestBody PostGroupUUIDUserJSONBody
 // PostPermissionsJSONRequestBody defines body for PostPermissions for application/json ContentType.
 type PostPermissionsJSONRequestBody = PermissionCreateRequest
 
+// PostPermissionsUUIDRolesJSONRequestBody defines body for PostPermissionsUUIDRoles for application/json ContentType.
+type PostPermissionsUUIDRolesJSONRequestBody = PermissionRoleAssignRequest
+
 // PostProfileSurveyJSONRequestBody defines body for PostProfileSurvey for application/json ContentType.
 type PostProfileSurveyJSONRequestBody = SurveyCreateRequest
 
@@ -441,18 +687,45 @@ type PostProjectUUIDStatusJSONRequestBody = ProjectStatusCreateRequest
 // PatchProjectUUIDStatusEntityUUIDJSONRequestBody defines body for PatchProjectUUIDStatusEntityUUID for application/json ContentType.
 type PatchProjectUUIDStatusEntityUUIDJSONRequestBody PatchProjectUUIDStatusEntityUUIDJSONBody
 
+// PostProjectUUIDTaskImportMultipartRequestBody defines body for PostProjectUUIDTaskImport for multipart/form-data ContentType.
+type PostProjectUUIDTaskImportMultipartRequestBody PostProjectUUIDTaskImportMultipartBody
+
+// PostProjectUUIDTemplateJSONRequestBody defines body for PostProjectUUIDTemplate for application/json ContentType.
+type PostProjectUUIDTemplateJSONRequestBody = TaskTemplateRequest
+
 // PostProjectUUIDUserJSONRequestBody defines body for PostProjectUUIDUser for application/json ContentType.
 type PostProjectUUIDUserJSONRequestBody = ProjectAddUserRequest
 
+// PostProjectUUIDViewJSONRequestBody defines body for PostProjectUUIDView for application/json ContentType.
+type PostProjectUUIDViewJSONRequestBody = TaskViewRequest
+
+// PutProjectUUIDViewEntityUUIDJSONRequestBody defines body for PutProjectUUIDViewEntityUUID for application/json ContentType.
+type PutProjectUUIDViewEntityUUIDJSONRequestBody = TaskViewRequest
+
+// PutRecurrenceUUIDJSONRequestBody defines body for PutRecurrenceUUID for application/json ContentType.
+type PutRecurrenceUUIDJSONRequestBody = TaskRecurrenceRequest
+
 // PostTagJSONRequestBody defines body for PostTag for application/json ContentType.
 type PostTagJSONRequestBody = TagCreateRequest
 
 // PatchTagUUIDJSONRequestBody defines body for PatchTagUUID for application/json ContentType.
 type PatchTagUUIDJSONRequestBody PatchTagUUIDJSONBody
 
+// PutTemplateUUIDJSONRequestBody defines body for PutTemplateUUID for application/json ContentType.
+type PutTemplateUUIDJSONRequestBody = TaskTemplateRequest
+
+// PostTemplateUUIDRecurrenceJSONRequestBody defines body for PostTemplateUUIDRecurrence for application/json ContentType.
+type PostTemplateUUIDRecurrenceJSONRequestBody = TaskTemplateRecurrenceRequest
+
+// PostTemplateUUIDTaskJSONRequestBody defines body for PostTemplateUUIDTask for application/json ContentType.
+type PostTemplateUUIDTaskJSONRequestBody = TaskTemplateInstantiateRequest
+
 // GetUserJSONRequestBody defines body for GetUser for application/json ContentType.
 type GetUserJSONRequestBody = SearchUserRequest
 
+// PutWebhookUUIDJSONRequestBody defines body for PutWebhookUUID for application/json ContentType.
+type PutWebhookUUIDJSONRequestBody = WebhookRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -519,12 +792,24 @@ type ServerInterface interface {
 	// (POST /company/{UUID}/sms/send)
 	PostCompanyUUIDSmsSend(ctx echo.Context, uUID Uuid, params PostCompanyUUIDSmsSendParams) error
 
+	// (GET /company/{UUID}/template)
+	GetCompanyUUIDTemplate(ctx echo.Context, uUID Uuid) error
+
+	// (POST /company/{UUID}/template)
+	PostCompanyUUIDTemplate(ctx echo.Context, uUID Uuid) error
+
 	// (POST /company/{UUID}/user)
 	PostCompanyUUIDUser(ctx echo.Context, uUID Uuid) error
 
 	// (DELETE /company/{UUID}/user/{userUUID})
 	DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error
 
+	// (GET /company/{UUID}/webhook)
+	GetCompanyUUIDWebhook(ctx echo.Context, uUID Uuid) error
+
+	// (POST /company/{UUID}/webhook)
+	PostCompanyUUIDWebhook(ctx echo.Context, uUID Uuid) error
+
 	// (POST /federation)
 	PostFederation(ctx echo.Context) error
 
@@ -540,12 +825,27 @@ type ServerInterface interface {
 	// (POST /federation/{UUID}/agent)
 	PostFederationUUIDAgent(ctx echo.Context, uUID Uuid) error
 
+	// (GET /federation/{UUID}/agent/duplicates)
+	GetFederationUUIDAgentDuplicates(ctx echo.Context, uUID Uuid) error
+
+	// (GET /federation/{UUID}/agent/export)
+	GetFederationUUIDAgentExport(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentExportParams) error
+
+	// (POST /federation/{UUID}/agent/import)
+	PostFederationUUIDAgentImport(ctx echo.Context, uUID Uuid, params PostFederationUUIDAgentImportParams) error
+
 	// (DELETE /federation/{UUID}/agent/{entityUUID})
 	DeleteFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
 	// (PATCH /federation/{UUID}/agent/{entityUUID})
 	PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (POST /federation/{UUID}/agent/{entityUUID}/merge)
+	PostFederationUUIDAgentEntityUUIDMerge(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/timeline)
+	GetFederationUUIDAgentEntityUUIDTimeline(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDTimelineParams) error
+
 	// (GET /federation/{UUID}/invite)
 	GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error
 
@@ -567,6 +867,12 @@ type ServerInterface interface {
 	// (DELETE /federation/{UUID}/user/{userUUID})
 	DeleteFederationUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error
 
+	// (GET /federation/{UUID}/webhook)
+	GetFederationUUIDWebhook(ctx echo.Context, uUID Uuid) error
+
+	// (POST /federation/{UUID}/webhook)
+	PostFederationUUIDWebhook(ctx echo.Context, uUID Uuid) error
+
 	// (DELETE /group/{UUID}/user)
 	DeleteGroupUUIDUser(ctx echo.Context, uUID Uuid) error
 
@@ -579,11 +885,26 @@ type ServerInterface interface {
 	// (POST /permissions)
 	PostPermissions(ctx echo.Context) error
 
+	// (GET /permissions/roles)
+	GetPermissionsRoles(ctx echo.Context) error
+
 	// (DELETE /permissions/{UUID})
-	DeletePermissionsUUID(ctx echo.Context, uUID Uuid) error
+	DeletePermissionsUUID(ctx echo.Context, uUID Uuid, params DeletePermissionsUUIDParams) error
 
 	// (GET /permissions/{UUID})
-	GetPermissionsUUID(ctx echo.Context, uUID Uuid) error
+	GetPermissionsUUID(ctx echo.Context, uUID Uuid, params GetPermissionsUUIDParams) error
+
+	// (GET /permissions/{UUID}/effective)
+	GetPermissionsUUIDEffective(ctx echo.Context, uUID Uuid, params GetPermissionsUUIDEffectiveParams) error
+
+	// (GET /permissions/{UUID}/roles)
+	GetPermissionsUUIDRoles(ctx echo.Context, uUID Uuid, params GetPermissionsUUIDRolesParams) error
+
+	// (POST /permissions/{UUID}/roles)
+	PostPermissionsUUIDRoles(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /permissions/{UUID}/roles/{entityUUID})
+	DeletePermissionsUUIDRolesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
 	// (POST /profile/survey)
 	PostProfileSurvey(ctx echo.Context) error
@@ -636,6 +957,9 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID}/options)
 	PatchProjectUUIDOptions(ctx echo.Context, uUID Uuid) error
 
+	// (GET /project/{UUID}/recurrence)
+	GetProjectUUIDRecurrence(ctx echo.Context, uUID Uuid) error
+
 	// (GET /project/{UUID}/status)
 	GetProjectUUIDStatus(ctx echo.Context, uUID Uuid) error
 
@@ -648,12 +972,48 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID}/status/{entityUUID})
 	PatchProjectUUIDStatusEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (POST /project/{UUID}/task/import)
+	PostProjectUUIDTaskImport(ctx echo.Context, uUID Uuid, params PostProjectUUIDTaskImportParams) error
+
+	// (GET /project/{UUID}/template)
+	GetProjectUUIDTemplate(ctx echo.Context, uUID Uuid) error
+
+	// (POST /project/{UUID}/template)
+	PostProjectUUIDTemplate(ctx echo.Context, uUID Uuid) error
+
 	// (POST /project/{UUID}/user)
 	PostProjectUUIDUser(ctx echo.Context, uUID Uuid) error
 
 	// (DELETE /project/{UUID}/user/{userUUID})
 	DeleteProjectUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error
 
+	// (GET /project/{UUID}/view)
+	GetProjectUUIDView(ctx echo.Context, uUID Uuid) error
+
+	// (POST /project/{UUID}/view)
+	PostProjectUUIDView(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /project/{UUID}/view/{entityUUID})
+	DeleteProjectUUIDViewEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /project/{UUID}/view/{entityUUID})
+	PutProjectUUIDViewEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /project/{UUID}/worklog)
+	GetProjectUUIDWorklog(ctx echo.Context, uUID Uuid, params GetProjectUUIDWorklogParams) error
+
+	// (DELETE /recurrence/{UUID})
+	DeleteRecurrenceUUID(ctx echo.Context, uUID Uuid) error
+
+	// (GET /recurrence/{UUID})
+	GetRecurrenceUUID(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /recurrence/{UUID})
+	PutRecurrenceUUID(ctx echo.Context, uUID Uuid) error
+
+	// (GET /search)
+	GetSearch(ctx echo.Context, params GetSearchParams) error
+
 	// (GET /tag)
 	GetTag(ctx echo.Context, params GetTagParams) error
 
@@ -666,8 +1026,41 @@ type ServerInterface interface {
 	// (PATCH /tag/{UUID})
 	PatchTagUUID(ctx echo.Context, uUID Uuid) error
 
+	// (DELETE /template/{UUID})
+	DeleteTemplateUUID(ctx echo.Context, uUID Uuid) error
+
+	// (GET /template/{UUID})
+	GetTemplateUUID(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /template/{UUID})
+	PutTemplateUUID(ctx echo.Context, uUID Uuid) error
+
+	// (POST /template/{UUID}/recurrence)
+	PostTemplateUUIDRecurrence(ctx echo.Context, uUID Uuid) error
+
+	// (POST /template/{UUID}/task)
+	PostTemplateUUIDTask(ctx echo.Context, uUID Uuid) error
+
+	// (GET /timesheet)
+	GetTimesheet(ctx echo.Context, params GetTimesheetParams) error
+
 	// (GET /user)
 	GetUser(ctx echo.Context) error
+
+	// (DELETE /webhook/{UUID})
+	DeleteWebhookUUID(ctx echo.Context, uUID Uuid) error
+
+	// (GET /webhook/{UUID})
+	GetWebhookUUID(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /webhook/{UUID})
+	PutWebhookUUID(ctx echo.Context, uUID Uuid) error
+
+	// (GET /webhook/{UUID}/delivery)
+	GetWebhookUUIDDelivery(ctx echo.Context, uUID Uuid, params GetWebhookUUIDDeliveryParams) error
+
+	// (POST /webhook/{UUID}/delivery/{entityUUID}/redeliver)
+	PostWebhookUUIDDeliveryEntityUUIDRedeliver(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1145,6 +1538,42 @@ func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsSend(ctx echo.Context) error
 	return err
 }
 
+// GetCompanyUUIDTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCompanyUUIDTemplate(ctx, uUID)
+	return err
+}
+
+// PostCompanyUUIDTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCompanyUUIDTemplate(ctx, uUID)
+	return err
+}
+
 // PostCompanyUUIDUser converts echo context to params.
 func (w *ServerInterfaceWrapper) PostCompanyUUIDUser(ctx echo.Context) error {
 	var err error
@@ -1189,6 +1618,42 @@ func (w *ServerInterfaceWrapper) DeleteCompanyUUIDUserUserUUID(ctx echo.Context)
 	return err
 }
 
+// GetCompanyUUIDWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCompanyUUIDWebhook(ctx, uUID)
+	return err
+}
+
+// PostCompanyUUIDWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCompanyUUIDWebhook(ctx, uUID)
+	return err
+}
+
 // PostFederation converts echo context to params.
 func (w *ServerInterfaceWrapper) PostFederation(ctx echo.Context) error {
 	var err error
@@ -1288,8 +1753,8 @@ func (w *ServerInterfaceWrapper) PostFederationUUIDAgent(ctx echo.Context) error
 	return err
 }
 
-// DeleteFederationUUIDAgentEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context) error {
+// GetFederationUUIDAgentDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentDuplicates(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1299,23 +1764,15 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUIDAgentEntityUUID(ctx echo.Co
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetFederationUUIDAgentDuplicates(ctx, uUID)
 	return err
 }
 
-// PatchFederationUUIDAgentEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchFederationUUIDAgentEntityUUID(ctx echo.Context) error {
+// GetFederationUUIDAgentExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentExport(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1325,23 +1782,38 @@ func (w *ServerInterfaceWrapper) PatchFederationUUIDAgentEntityUUID(ctx echo.Con
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDAgentExportParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "company_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetFederationUUIDAgentExport(ctx, uUID, params)
 	return err
 }
 
-// GetFederationUUIDInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error {
+// PostFederationUUIDAgentImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDAgentImport(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1353,13 +1825,22 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFederationUUIDAgentImportParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetFederationUUIDInvite(ctx, uUID)
+	err = w.Handler.PostFederationUUIDAgentImport(ctx, uUID, params)
 	return err
 }
 
-// PostFederationUUIDInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) error {
+// DeleteFederationUUIDAgentEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1369,15 +1850,23 @@ func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFederationUUIDInvite(ctx, uUID)
+	err = w.Handler.DeleteFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteFederationUUIDInviteEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context) error {
+// PatchFederationUUIDAgentEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFederationUUIDAgentEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1398,12 +1887,12 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUIDInviteEntityUUID(ctx echo.C
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteFederationUUIDInviteEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PatchFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchFederationUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchFederationUUIDName(ctx echo.Context) error {
+// PostFederationUUIDAgentEntityUUIDMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDAgentEntityUUIDMerge(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1413,15 +1902,23 @@ func (w *ServerInterfaceWrapper) PatchFederationUUIDName(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchFederationUUIDName(ctx, uUID)
-	return err
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFederationUUIDAgentEntityUUIDMerge(ctx, uUID, entityUUID)
+	return err
 }
 
-// GetFederationUUIDProject converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) error {
+// GetFederationUUIDAgentEntityUUIDTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentEntityUUIDTimeline(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1431,10 +1928,25 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFederationUUIDProjectParams
+	var params GetFederationUUIDAgentEntityUUIDTimelineParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
@@ -1442,27 +1954,27 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	// ------------- Optional query parameter "company_uuid" -------------
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetFederationUUIDProject(ctx, uUID, params)
+	err = w.Handler.GetFederationUUIDAgentEntityUUIDTimeline(ctx, uUID, entityUUID, params)
 	return err
 }
 
-// PostFederationUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFederationUUIDUser(ctx echo.Context) error {
+// GetFederationUUIDInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1475,12 +1987,12 @@ func (w *ServerInterfaceWrapper) PostFederationUUIDUser(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFederationUUIDUser(ctx, uUID)
+	err = w.Handler.GetFederationUUIDInvite(ctx, uUID)
 	return err
 }
 
-// DeleteFederationUUIDUserUserUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFederationUUIDUserUserUUID(ctx echo.Context) error {
+// PostFederationUUIDInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1490,23 +2002,15 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUIDUserUserUUID(ctx echo.Conte
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "userUUID" -------------
-	var userUUID UserUUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteFederationUUIDUserUserUUID(ctx, uUID, userUUID)
+	err = w.Handler.PostFederationUUIDInvite(ctx, uUID)
 	return err
 }
 
-// DeleteGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
+// DeleteFederationUUIDInviteEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1516,15 +2020,23 @@ func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteGroupUUIDUser(ctx, uUID)
+	err = w.Handler.DeleteFederationUUIDInviteEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
+// PatchFederationUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFederationUUIDName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1537,12 +2049,12 @@ func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupUUIDUser(ctx, uUID)
+	err = w.Handler.PatchFederationUUIDName(ctx, uUID)
 	return err
 }
 
-// PostGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
+// GetFederationUUIDProject converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1554,24 +2066,36 @@ func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostGroupUUIDUser(ctx, uUID)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDProjectParams
+	// ------------- Optional query parameter "limit" -------------
 
-// PostPermissions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPermissions(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "company_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPermissions(ctx)
+	err = w.Handler.GetFederationUUIDProject(ctx, uUID, params)
 	return err
 }
 
-// DeletePermissionsUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
+// PostFederationUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDUser(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1584,12 +2108,12 @@ func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeletePermissionsUUID(ctx, uUID)
+	err = w.Handler.PostFederationUUIDUser(ctx, uUID)
 	return err
 }
 
-// GetPermissionsUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
+// DeleteFederationUUIDUserUserUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDUserUserUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1599,26 +2123,23 @@ func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPermissionsUUID(ctx, uUID)
-	return err
-}
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID UserUUID
 
-// PostProfileSurvey converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileSurvey(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileSurvey(ctx)
+	err = w.Handler.DeleteFederationUUIDUserUserUUID(ctx, uUID, userUUID)
 	return err
 }
 
-// DeleteProfileSurveyUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProfileSurveyUUID(ctx echo.Context) error {
+// GetFederationUUIDWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDWebhook(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1631,12 +2152,12 @@ func (w *ServerInterfaceWrapper) DeleteProfileSurveyUUID(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProfileSurveyUUID(ctx, uUID)
+	err = w.Handler.GetFederationUUIDWebhook(ctx, uUID)
 	return err
 }
 
-// GetProfileSurveyUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
+// PostFederationUUIDWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDWebhook(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1649,23 +2170,12 @@ func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProfileSurveyUUID(ctx, uUID)
-	return err
-}
-
-// PostProject converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProject(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProject(ctx)
+	err = w.Handler.PostFederationUUIDWebhook(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
+// DeleteGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1678,12 +2188,12 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUID(ctx, uUID)
+	err = w.Handler.DeleteGroupUUIDUser(ctx, uUID)
 	return err
 }
 
-// GetProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
+// GetGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1696,12 +2206,12 @@ func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUID(ctx, uUID)
+	err = w.Handler.GetGroupUUIDUser(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
+// PostGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1714,30 +2224,34 @@ func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUID(ctx, uUID)
+	err = w.Handler.PostGroupUUIDUser(ctx, uUID)
 	return err
 }
 
-// GetProjectUUIDCatalog converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
+// PostPermissions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPermissions(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPermissions(ctx)
+	return err
+}
+
+// GetPermissionsRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPermissionsRoles(ctx echo.Context) error {
+	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDCatalog(ctx, uUID)
+	err = w.Handler.GetPermissionsRoles(ctx)
 	return err
 }
 
-// PostProjectUUIDCatalog converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error {
+// DeletePermissionsUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1749,13 +2263,22 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeletePermissionsUUIDParams
+	// ------------- Required query parameter "federation_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDCatalog(ctx, uUID)
+	err = w.Handler.DeletePermissionsUUID(ctx, uUID, params)
 	return err
 }
 
-// GetProjectUUIDCatalogEntityName converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Context) error {
+// GetPermissionsUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1765,23 +2288,24 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityName" -------------
-	var entityName EntityName
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPermissionsUUIDParams
+	// ------------- Required query parameter "federation_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDCatalogEntityName(ctx, uUID, entityName)
+	err = w.Handler.GetPermissionsUUID(ctx, uUID, params)
 	return err
 }
 
-// DeleteProjectUUIDCatalogEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context) error {
+// GetPermissionsUUIDEffective converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPermissionsUUIDEffective(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1791,23 +2315,24 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Con
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPermissionsUUIDEffectiveParams
+	// ------------- Required query parameter "entity_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "entity_uuid", ctx.QueryParams(), &params.EntityUuid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entity_uuid: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDCatalogEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetPermissionsUUIDEffective(ctx, uUID, params)
 	return err
 }
 
-// PatchProjectUUIDDescription converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) error {
+// GetPermissionsUUIDRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPermissionsUUIDRoles(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1819,13 +2344,22 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) e
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPermissionsUUIDRolesParams
+	// ------------- Required query parameter "federation_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDDescription(ctx, uUID)
+	err = w.Handler.GetPermissionsUUIDRoles(ctx, uUID, params)
 	return err
 }
 
-// DeleteProjectUUIDFieldEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+// PostPermissionsUUIDRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPermissionsUUIDRoles(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1835,23 +2369,15 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Conte
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PostPermissionsUUIDRoles(ctx, uUID)
 	return err
 }
 
-// PostProjectUUIDFieldEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+// DeletePermissionsUUIDRolesEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePermissionsUUIDRolesEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1872,30 +2398,23 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeletePermissionsUUIDRolesEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchProjectUUIDGraph converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
+// PostProfileSurvey converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileSurvey(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
-	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDGraph(ctx, uUID)
+	err = w.Handler.PostProfileSurvey(ctx)
 	return err
 }
 
-// PatchProjectUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
+// DeleteProfileSurveyUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileSurveyUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1908,12 +2427,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDName(ctx, uUID)
+	err = w.Handler.DeleteProfileSurveyUUID(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDOptions converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error {
+// GetProfileSurveyUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1926,12 +2445,23 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDOptions(ctx, uUID)
+	err = w.Handler.GetProfileSurveyUUID(ctx, uUID)
 	return err
 }
 
-// GetProjectUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
+// PostProject converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProject(ctx)
+	return err
+}
+
+// DeleteProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1944,12 +2474,12 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDStatus(ctx, uUID)
+	err = w.Handler.DeleteProjectUUID(ctx, uUID)
 	return err
 }
 
-// PostProjectUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
+// GetProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1962,12 +2492,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDStatus(ctx, uUID)
+	err = w.Handler.GetProjectUUID(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUIDStatusEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+// PatchProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1977,23 +2507,77 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Cont
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUID(ctx, uUID)
+	return err
+}
+
+// GetProjectUUIDCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetProjectUUIDCatalog(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDStatusEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+// PostProjectUUIDCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDCatalog(ctx, uUID)
+	return err
+}
+
+// GetProjectUUIDCatalogEntityName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityName" -------------
+	var entityName EntityName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDCatalogEntityName(ctx, uUID, entityName)
+	return err
+}
+
+// DeleteProjectUUIDCatalogEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2014,12 +2598,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Conte
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteProjectUUIDCatalogEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostProjectUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
+// PatchProjectUUIDDescription converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2032,12 +2616,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDUser(ctx, uUID)
+	err = w.Handler.PatchProjectUUIDDescription(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUIDUserUserUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context) error {
+// DeleteProjectUUIDFieldEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2047,54 +2631,67 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "userUUID" -------------
-	var userUUID UserUUID
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDUserUserUUID(ctx, uUID, userUUID)
+	err = w.Handler.DeleteProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetTag converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
+// PostProjectUUIDFieldEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTagParams
-	// ------------- Required query parameter "company_uuid" -------------
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindQueryParameter("form", true, true, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTag(ctx, params)
+	err = w.Handler.PostProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostTag converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
+// PatchProjectUUIDGraph converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTag(ctx)
+	err = w.Handler.PatchProjectUUIDGraph(ctx, uUID)
 	return err
 }
 
-// DeleteTagUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
+// PatchProjectUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2107,12 +2704,12 @@ func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTagUUID(ctx, uUID)
+	err = w.Handler.PatchProjectUUIDName(ctx, uUID)
 	return err
 }
 
-// PatchTagUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
+// PatchProjectUUIDOptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2125,54 +2722,810 @@ func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTagUUID(ctx, uUID)
+	err = w.Handler.PatchProjectUUIDOptions(ctx, uUID)
 	return err
 }
 
-// GetUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+// GetProjectUUIDRecurrence converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDRecurrence(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUser(ctx)
+	err = w.Handler.GetProjectUUIDRecurrence(ctx, uUID)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// GetProjectUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDStatus(ctx, uUID)
+	return err
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+// PostProjectUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDStatus(ctx, uUID)
+	return err
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// DeleteProjectUUIDStatusEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	router.POST(baseURL+"/company", wrapper.PostCompany)
-	router.DELETE(baseURL+"/company/:UUID", wrapper.DeleteCompanyUUID)
-	router.GET(baseURL+"/company/:UUID", wrapper.GetCompanyUUID)
-	router.GET(baseURL+"/company/:UUID/fields", wrapper.GetCompanyUUIDFields)
-	router.POST(baseURL+"/company/:UUID/fields", wrapper.PostCompanyUUIDFields)
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PatchProjectUUIDStatusEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostProjectUUIDTaskImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDTaskImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProjectUUIDTaskImportParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDTaskImport(ctx, uUID, params)
+	return err
+}
+
+// GetProjectUUIDTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDTemplate(ctx, uUID)
+	return err
+}
+
+// PostProjectUUIDTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDTemplate(ctx, uUID)
+	return err
+}
+
+// PostProjectUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDUser(ctx, uUID)
+	return err
+}
+
+// DeleteProjectUUIDUserUserUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID UserUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDUserUserUUID(ctx, uUID, userUUID)
+	return err
+}
+
+// GetProjectUUIDView converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDView(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDView(ctx, uUID)
+	return err
+}
+
+// PostProjectUUIDView converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDView(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDView(ctx, uUID)
+	return err
+}
+
+// DeleteProjectUUIDViewEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDViewEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDViewEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PutProjectUUIDViewEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProjectUUIDViewEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutProjectUUIDViewEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetProjectUUIDWorklog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDWorklog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDWorklogParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDWorklog(ctx, uUID, params)
+	return err
+}
+
+// DeleteRecurrenceUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRecurrenceUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRecurrenceUUID(ctx, uUID)
+	return err
+}
+
+// GetRecurrenceUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurrenceUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecurrenceUUID(ctx, uUID)
+	return err
+}
+
+// PutRecurrenceUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutRecurrenceUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutRecurrenceUUID(ctx, uUID)
+	return err
+}
+
+// GetSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSearch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSearchParams
+	// ------------- Required query parameter "federation_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
+	}
+
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "types" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "types", ctx.QueryParams(), &params.Types)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter types: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSearch(ctx, params)
+	return err
+}
+
+// GetTag converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagParams
+	// ------------- Required query parameter "company_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTag(ctx, params)
+	return err
+}
+
+// PostTag converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTag(ctx)
+	return err
+}
+
+// DeleteTagUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTagUUID(ctx, uUID)
+	return err
+}
+
+// PatchTagUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTagUUID(ctx, uUID)
+	return err
+}
+
+// DeleteTemplateUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTemplateUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTemplateUUID(ctx, uUID)
+	return err
+}
+
+// GetTemplateUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTemplateUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTemplateUUID(ctx, uUID)
+	return err
+}
+
+// PutTemplateUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTemplateUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTemplateUUID(ctx, uUID)
+	return err
+}
+
+// PostTemplateUUIDRecurrence converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTemplateUUIDRecurrence(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTemplateUUIDRecurrence(ctx, uUID)
+	return err
+}
+
+// PostTemplateUUIDTask converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTemplateUUIDTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTemplateUUIDTask(ctx, uUID)
+	return err
+}
+
+// GetTimesheet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTimesheet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimesheetParams
+	// ------------- Required query parameter "federation_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "user_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_uuid", ctx.QueryParams(), &params.UserUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_uuid: %s", err))
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "billable" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "billable", ctx.QueryParams(), &params.Billable)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter billable: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTimesheet(ctx, params)
+	return err
+}
+
+// GetUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUser(ctx)
+	return err
+}
+
+// DeleteWebhookUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhookUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWebhookUUID(ctx, uUID)
+	return err
+}
+
+// GetWebhookUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhookUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhookUUID(ctx, uUID)
+	return err
+}
+
+// PutWebhookUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutWebhookUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutWebhookUUID(ctx, uUID)
+	return err
+}
+
+// GetWebhookUUIDDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhookUUIDDelivery(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookUUIDDeliveryParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhookUUIDDelivery(ctx, uUID, params)
+	return err
+}
+
+// PostWebhookUUIDDeliveryEntityUUIDRedeliver converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhookUUIDDeliveryEntityUUIDRedeliver(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhookUUIDDeliveryEntityUUIDRedeliver(ctx, uUID, entityUUID)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/company", wrapper.PostCompany)
+	router.DELETE(baseURL+"/company/:UUID", wrapper.DeleteCompanyUUID)
+	router.GET(baseURL+"/company/:UUID", wrapper.GetCompanyUUID)
+	router.GET(baseURL+"/company/:UUID/fields", wrapper.GetCompanyUUIDFields)
+	router.POST(baseURL+"/company/:UUID/fields", wrapper.PostCompanyUUIDFields)
 	router.DELETE(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.DeleteCompanyUUIDFieldsEntityUUID)
 	router.PUT(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.PutCompanyUUIDFieldsEntityUUID)
 	router.GET(baseURL+"/company/:UUID/group", wrapper.GetCompanyUUIDGroup)
@@ -2189,15 +3542,24 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/company/:UUID/sms/cost", wrapper.PostCompanyUUIDSmsCost)
 	router.POST(baseURL+"/company/:UUID/sms/options", wrapper.PostCompanyUUIDSmsOptions)
 	router.POST(baseURL+"/company/:UUID/sms/send", wrapper.PostCompanyUUIDSmsSend)
+	router.GET(baseURL+"/company/:UUID/template", wrapper.GetCompanyUUIDTemplate)
+	router.POST(baseURL+"/company/:UUID/template", wrapper.PostCompanyUUIDTemplate)
 	router.POST(baseURL+"/company/:UUID/user", wrapper.PostCompanyUUIDUser)
 	router.DELETE(baseURL+"/company/:UUID/user/:userUUID", wrapper.DeleteCompanyUUIDUserUserUUID)
+	router.GET(baseURL+"/company/:UUID/webhook", wrapper.GetCompanyUUIDWebhook)
+	router.POST(baseURL+"/company/:UUID/webhook", wrapper.PostCompanyUUIDWebhook)
 	router.POST(baseURL+"/federation", wrapper.PostFederation)
 	router.DELETE(baseURL+"/federation/:UUID", wrapper.DeleteFederationUUID)
 	router.GET(baseURL+"/federation/:UUID", wrapper.GetFederationUUID)
 	router.GET(baseURL+"/federation/:UUID/agent", wrapper.GetFederationUUIDAgent)
 	router.POST(baseURL+"/federation/:UUID/agent", wrapper.PostFederationUUIDAgent)
+	router.GET(baseURL+"/federation/:UUID/agent/duplicates", wrapper.GetFederationUUIDAgentDuplicates)
+	router.GET(baseURL+"/federation/:UUID/agent/export", wrapper.GetFederationUUIDAgentExport)
+	router.POST(baseURL+"/federation/:UUID/agent/import", wrapper.PostFederationUUIDAgentImport)
 	router.DELETE(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.DeleteFederationUUIDAgentEntityUUID)
 	router.PATCH(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.PatchFederationUUIDAgentEntityUUID)
+	router.POST(baseURL+"/federation/:UUID/agent/:entityUUID/merge", wrapper.PostFederationUUIDAgentEntityUUIDMerge)
+	router.GET(baseURL+"/federation/:UUID/agent/:entityUUID/timeline", wrapper.GetFederationUUIDAgentEntityUUIDTimeline)
 	router.GET(baseURL+"/federation/:UUID/invite", wrapper.GetFederationUUIDInvite)
 	router.POST(baseURL+"/federation/:UUID/invite", wrapper.PostFederationUUIDInvite)
 	router.DELETE(baseURL+"/federation/:UUID/invite/:entityUUID", wrapper.DeleteFederationUUIDInviteEntityUUID)
@@ -2205,12 +3567,19 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/federation/:UUID/project", wrapper.GetFederationUUIDProject)
 	router.POST(baseURL+"/federation/:UUID/user", wrapper.PostFederationUUIDUser)
 	router.DELETE(baseURL+"/federation/:UUID/user/:userUUID", wrapper.DeleteFederationUUIDUserUserUUID)
+	router.GET(baseURL+"/federation/:UUID/webhook", wrapper.GetFederationUUIDWebhook)
+	router.POST(baseURL+"/federation/:UUID/webhook", wrapper.PostFederationUUIDWebhook)
 	router.DELETE(baseURL+"/group/:UUID/user", wrapper.DeleteGroupUUIDUser)
 	router.GET(baseURL+"/group/:UUID/user", wrapper.GetGroupUUIDUser)
 	router.POST(baseURL+"/group/:UUID/user", wrapper.PostGroupUUIDUser)
 	router.POST(baseURL+"/permissions", wrapper.PostPermissions)
+	router.GET(baseURL+"/permissions/roles", wrapper.GetPermissionsRoles)
 	router.DELETE(baseURL+"/permissions/:UUID", wrapper.DeletePermissionsUUID)
 	router.GET(baseURL+"/permissions/:UUID", wrapper.GetPermissionsUUID)
+	router.GET(baseURL+"/permissions/:UUID/effective", wrapper.GetPermissionsUUIDEffective)
+	router.GET(baseURL+"/permissions/:UUID/roles", wrapper.GetPermissionsUUIDRoles)
+	router.POST(baseURL+"/permissions/:UUID/roles", wrapper.PostPermissionsUUIDRoles)
+	router.DELETE(baseURL+"/permissions/:UUID/roles/:entityUUID", wrapper.DeletePermissionsUUIDRolesEntityUUID)
 	router.POST(baseURL+"/profile/survey", wrapper.PostProfileSurvey)
 	router.DELETE(baseURL+"/profile/survey/:UUID", wrapper.DeleteProfileSurveyUUID)
 	router.GET(baseURL+"/profile/survey/:UUID", wrapper.GetProfileSurveyUUID)
@@ -2228,3539 +3597,5615 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PATCH(baseURL+"/project/:UUID/graph", wrapper.PatchProjectUUIDGraph)
 	router.PATCH(baseURL+"/project/:UUID/name", wrapper.PatchProjectUUIDName)
 	router.PATCH(baseURL+"/project/:UUID/options", wrapper.PatchProjectUUIDOptions)
+	router.GET(baseURL+"/project/:UUID/recurrence", wrapper.GetProjectUUIDRecurrence)
 	router.GET(baseURL+"/project/:UUID/status", wrapper.GetProjectUUIDStatus)
 	router.POST(baseURL+"/project/:UUID/status", wrapper.PostProjectUUIDStatus)
 	router.DELETE(baseURL+"/project/:UUID/status/:entityUUID", wrapper.DeleteProjectUUIDStatusEntityUUID)
 	router.PATCH(baseURL+"/project/:UUID/status/:entityUUID", wrapper.PatchProjectUUIDStatusEntityUUID)
+	router.POST(baseURL+"/project/:UUID/task/import", wrapper.PostProjectUUIDTaskImport)
+	router.GET(baseURL+"/project/:UUID/template", wrapper.GetProjectUUIDTemplate)
+	router.POST(baseURL+"/project/:UUID/template", wrapper.PostProjectUUIDTemplate)
 	router.POST(baseURL+"/project/:UUID/user", wrapper.PostProjectUUIDUser)
 	router.DELETE(baseURL+"/project/:UUID/user/:userUUID", wrapper.DeleteProjectUUIDUserUserUUID)
+	router.GET(baseURL+"/project/:UUID/view", wrapper.GetProjectUUIDView)
+	router.POST(baseURL+"/project/:UUID/view", wrapper.PostProjectUUIDView)
+	router.DELETE(baseURL+"/project/:UUID/view/:entityUUID", wrapper.DeleteProjectUUIDViewEntityUUID)
+	router.PUT(baseURL+"/project/:UUID/view/:entityUUID", wrapper.PutProjectUUIDViewEntityUUID)
+	router.GET(baseURL+"/project/:UUID/worklog", wrapper.GetProjectUUIDWorklog)
+	router.DELETE(baseURL+"/recurrence/:UUID", wrapper.DeleteRecurrenceUUID)
+	router.GET(baseURL+"/recurrence/:UUID", wrapper.GetRecurrenceUUID)
+	router.PUT(baseURL+"/recurrence/:UUID", wrapper.PutRecurrenceUUID)
+	router.GET(baseURL+"/search", wrapper.GetSearch)
 	router.GET(baseURL+"/tag", wrapper.GetTag)
 	router.POST(baseURL+"/tag", wrapper.PostTag)
 	router.DELETE(baseURL+"/tag/:UUID", wrapper.DeleteTagUUID)
 	router.PATCH(baseURL+"/tag/:UUID", wrapper.PatchTagUUID)
+	router.DELETE(baseURL+"/template/:UUID", wrapper.DeleteTemplateUUID)
+	router.GET(baseURL+"/template/:UUID", wrapper.GetTemplateUUID)
+	router.PUT(baseURL+"/template/:UUID", wrapper.PutTemplateUUID)
+	router.POST(baseURL+"/template/:UUID/recurrence", wrapper.PostTemplateUUIDRecurrence)
+	router.POST(baseURL+"/template/:UUID/task", wrapper.PostTemplateUUIDTask)
+	router.GET(baseURL+"/timesheet", wrapper.GetTimesheet)
 	router.GET(baseURL+"/user", wrapper.GetUser)
+	router.DELETE(baseURL+"/webhook/:UUID", wrapper.DeleteWebhookUUID)
+	router.GET(baseURL+"/webhook/:UUID", wrapper.GetWebhookUUID)
+	router.PUT(baseURL+"/webhook/:UUID", wrapper.PutWebhookUUID)
+	router.GET(baseURL+"/webhook/:UUID/delivery", wrapper.GetWebhookUUIDDelivery)
+	router.POST(baseURL+"/webhook/:UUID/delivery/:entityUUID/redeliver", wrapper.PostWebhookUUIDDeliveryEntityUUIDRedeliver)
+
+}
+
+type PostCompanyRequestObject struct {
+	Body *PostCompanyJSONRequestBody
+}
+
+type PostCompanyResponseObject interface {
+	VisitPostCompanyResponse(w http.ResponseWriter) error
+}
+
+type PostCompany200JSONResponse UUIDResponse
+
+func (response PostCompany200JSONResponse) VisitPostCompanyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteCompanyUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUID200Response struct {
+}
+
+func (response DeleteCompanyUUID200Response) VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDResponseObject interface {
+	VisitGetCompanyUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUID200JSONResponse CompanyDTO
+
+func (response GetCompanyUUID200JSONResponse) VisitGetCompanyUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDFieldsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDFieldsResponseObject interface {
+	VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDFields200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []CompanyFieldDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDFields200JSONResponse) VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDFieldsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDFieldsJSONRequestBody
+}
+
+type PostCompanyUUIDFieldsResponseObject interface {
+	VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDFields200JSONResponse struct {
+	Hash            string               `json:"hash"`
+	Icon            string               `json:"icon"`
+	Type            domain.FieldDataType `json:"type"`
+	TypeDescription string               `json:"type_description"`
+	TypeUuid        *openapi_types.UUID  `json:"type_uuid,omitempty"`
+	Uuid            string               `json:"uuid"`
+}
+
+func (response PostCompanyUUIDFields200JSONResponse) VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDFieldsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDFieldsEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDFieldsEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDFieldsEntityUUID200Response) VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutCompanyUUIDFieldsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+}
+
+type PutCompanyUUIDFieldsEntityUUIDResponseObject interface {
+	VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutCompanyUUIDFieldsEntityUUID200Response struct {
+}
+
+func (response PutCompanyUUIDFieldsEntityUUID200Response) VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDGroupRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDGroupResponseObject interface {
+	VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDGroup200JSONResponse struct {
+	Count int        `json:"count"`
+	Items []GroupDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDGroup200JSONResponse) VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDGroupRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDGroupJSONRequestBody
+}
+
+type PostCompanyUUIDGroupResponseObject interface {
+	VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDGroup200JSONResponse UUIDResponse
+
+func (response PostCompanyUUIDGroup200JSONResponse) VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDGroupEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDGroupEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDGroupEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDGroupEntityUUID200Response) VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchCompanyUUIDGroupEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
+}
+
+type PatchCompanyUUIDGroupEntityUUIDResponseObject interface {
+	VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchCompanyUUIDGroupEntityUUID200Response struct {
+}
+
+func (response PatchCompanyUUIDGroupEntityUUID200Response) VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchCompanyUUIDNameRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchCompanyUUIDNameJSONRequestBody
+}
+
+type PatchCompanyUUIDNameResponseObject interface {
+	VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error
+}
+
+type PatchCompanyUUIDName200Response struct {
+}
+
+func (response PatchCompanyUUIDName200Response) VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDPrioritiesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDPrioritiesResponseObject interface {
+	VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDPriorities200JSONResponse struct {
+	Count int                  `json:"count"`
+	Items []CompanyPriorityDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDPriorities200JSONResponse) VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDPrioritiesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDPrioritiesJSONRequestBody
+}
+
+type PostCompanyUUIDPrioritiesResponseObject interface {
+	VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDPriorities200JSONResponse UUIDResponse
+
+func (response PostCompanyUUIDPriorities200JSONResponse) VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDPrioritiesEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDPrioritiesEntityUUID200Response) VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
+}
+
+type PatchCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
+	VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchCompanyUUIDPrioritiesEntityUUID200Response struct {
+}
+
+func (response PatchCompanyUUIDPrioritiesEntityUUID200Response) VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDProjectCatalogEntityNameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
+}
+
+type GetCompanyUUIDProjectCatalogEntityNameResponseObject interface {
+	VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDProjectCatalogEntityName200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDProjectCatalogEntityName200JSONResponse) VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDSmsRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCompanyUUIDSmsParams
+}
+
+type GetCompanyUUIDSmsResponseObject interface {
+	VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDSms200JSONResponse struct {
+	Count int      `json:"count"`
+	Items []SmsDTO `json:"items"`
+	Total int64    `json:"total"`
+}
+
+func (response GetCompanyUUIDSms200JSONResponse) VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsCostRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDSmsCostJSONRequestBody
+}
+
+type PostCompanyUUIDSmsCostResponseObject interface {
+	VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsCost200JSONResponse map[string]interface{}
+
+func (response PostCompanyUUIDSmsCost200JSONResponse) VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsOptionsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDSmsOptionsJSONRequestBody
+}
+
+type PostCompanyUUIDSmsOptionsResponseObject interface {
+	VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsOptions200Response struct {
+}
+
+func (response PostCompanyUUIDSmsOptions200Response) VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostCompanyUUIDSmsSendRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params PostCompanyUUIDSmsSendParams
+	Body   *PostCompanyUUIDSmsSendJSONRequestBody
+}
+
+type PostCompanyUUIDSmsSendResponseObject interface {
+	VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsSend200JSONResponse map[string]interface{}
+
+func (response PostCompanyUUIDSmsSend200JSONResponse) VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDTemplateRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDTemplateResponseObject interface {
+	VisitGetCompanyUUIDTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDTemplate200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []TaskTemplateDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDTemplate200JSONResponse) VisitGetCompanyUUIDTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDTemplateRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDTemplateJSONRequestBody
+}
+
+type PostCompanyUUIDTemplateResponseObject interface {
+	VisitPostCompanyUUIDTemplateResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDTemplate200JSONResponse TaskTemplateDTO
+
+func (response PostCompanyUUIDTemplate200JSONResponse) VisitPostCompanyUUIDTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDUserJSONRequestBody
+}
+
+type PostCompanyUUIDUserResponseObject interface {
+	VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDUser200JSONResponse UUIDResponse
+
+func (response PostCompanyUUIDUser200JSONResponse) VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
+}
+
+type DeleteCompanyUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDUserUserUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDUserUserUUID200Response) VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDWebhookRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDWebhookResponseObject interface {
+	VisitGetCompanyUUIDWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDWebhook200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []WebhookDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDWebhook200JSONResponse) VisitGetCompanyUUIDWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDWebhookRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDWebhookJSONRequestBody
+}
+
+type PostCompanyUUIDWebhookResponseObject interface {
+	VisitPostCompanyUUIDWebhookResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDWebhook200JSONResponse WebhookDTO
+
+func (response PostCompanyUUIDWebhook200JSONResponse) VisitPostCompanyUUIDWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationRequestObject struct {
+	Body *PostFederationJSONRequestBody
+}
+
+type PostFederationResponseObject interface {
+	VisitPostFederationResponse(w http.ResponseWriter) error
+}
+
+type PostFederation200JSONResponse UUIDResponse
+
+func (response PostFederation200JSONResponse) VisitPostFederationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteFederationUUIDResponseObject interface {
+	VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUID200Response struct {
+}
+
+func (response DeleteFederationUUID200Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteFederationUUID401Response struct {
+}
+
+func (response DeleteFederationUUID401Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetFederationUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDResponseObject interface {
+	VisitGetFederationUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUID200JSONResponse FederationDTO
+
+func (response GetFederationUUID200JSONResponse) VisitGetFederationUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDAgentParams
+}
+
+type GetFederationUUIDAgentResponseObject interface {
+	VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgent200JSONResponse struct {
+	Count int        `json:"count"`
+	Items []AgentDTO `json:"items"`
+	Total int64      `json:"total"`
+}
+
+func (response GetFederationUUIDAgent200JSONResponse) VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDAgentRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDAgentJSONRequestBody
+}
+
+type PostFederationUUIDAgentResponseObject interface {
+	VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDAgent200JSONResponse UUIDResponse
+
+func (response PostFederationUUIDAgent200JSONResponse) VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentDuplicatesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDAgentDuplicatesResponseObject interface {
+	VisitGetFederationUUIDAgentDuplicatesResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentDuplicates200JSONResponse struct {
+	Count int                  `json:"count"`
+	Items []AgentDuplicatesDTO `json:"items"`
+}
+
+func (response GetFederationUUIDAgentDuplicates200JSONResponse) VisitGetFederationUUIDAgentDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentExportRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDAgentExportParams
+}
+
+type GetFederationUUIDAgentExportResponseObject interface {
+	VisitGetFederationUUIDAgentExportResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentExport200ResponseHeaders struct {
+	ContentDisposition string
+	ContentType        string
+	CacheControl       string
+}
+
+type GetFederationUUIDAgentExport200ApplicationxlsxResponse struct {
+	Body          io.Reader
+	Headers       GetFederationUUIDAgentExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetFederationUUIDAgentExport200ApplicationxlsxResponse) VisitGetFederationUUIDAgentExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xlsx")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetFederationUUIDAgentExport200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetFederationUUIDAgentExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetFederationUUIDAgentExport200TextcsvResponse) VisitGetFederationUUIDAgentExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostFederationUUIDAgentImportRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params PostFederationUUIDAgentImportParams
+	Body   *multipart.Reader
+}
+
+type PostFederationUUIDAgentImportResponseObject interface {
+	VisitPostFederationUUIDAgentImportResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDAgentImport200JSONResponse AgentImportDTO
+
+func (response PostFederationUUIDAgentImport200JSONResponse) VisitPostFederationUUIDAgentImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDAgentEntityUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDAgentEntityUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchFederationUUIDAgentEntityUUIDJSONRequestBody
+}
+
+type PatchFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchFederationUUIDAgentEntityUUID200Response struct {
+}
+
+func (response PatchFederationUUIDAgentEntityUUID200Response) VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostFederationUUIDAgentEntityUUIDMergeRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostFederationUUIDAgentEntityUUIDMergeJSONRequestBody
+}
+
+type PostFederationUUIDAgentEntityUUIDMergeResponseObject interface {
+	VisitPostFederationUUIDAgentEntityUUIDMergeResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDAgentEntityUUIDMerge200JSONResponse AgentDTO
+
+func (response PostFederationUUIDAgentEntityUUIDMerge200JSONResponse) VisitPostFederationUUIDAgentEntityUUIDMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentEntityUUIDTimelineRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetFederationUUIDAgentEntityUUIDTimelineParams
+}
+
+type GetFederationUUIDAgentEntityUUIDTimelineResponseObject interface {
+	VisitGetFederationUUIDAgentEntityUUIDTimelineResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentEntityUUIDTimeline200JSONResponse struct {
+	Count int                    `json:"count"`
+	Items []AgentTimelineItemDTO `json:"items"`
+	Total int64                  `json:"total"`
+}
+
+func (response GetFederationUUIDAgentEntityUUIDTimeline200JSONResponse) VisitGetFederationUUIDAgentEntityUUIDTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDInviteRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDInviteResponseObject interface {
+	VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDInvite200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []InviteDTO `json:"items"`
+}
+
+func (response GetFederationUUIDInvite200JSONResponse) VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDInviteRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDInviteJSONRequestBody
+}
+
+type PostFederationUUIDInviteResponseObject interface {
+	VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDInvite200JSONResponse UUIDResponse
+
+func (response PostFederationUUIDInvite200JSONResponse) VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDInviteEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteFederationUUIDInviteEntityUUIDResponseObject interface {
+	VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDInviteEntityUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDInviteEntityUUID200Response) VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchFederationUUIDNameRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchFederationUUIDNameJSONRequestBody
+}
+
+type PatchFederationUUIDNameResponseObject interface {
+	VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error
+}
+
+type PatchFederationUUIDName200Response struct {
+}
+
+func (response PatchFederationUUIDName200Response) VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFederationUUIDProjectRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDProjectParams
+}
+
+type GetFederationUUIDProjectResponseObject interface {
+	VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDProject200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []ProjectDTO `json:"items"`
+}
+
+func (response GetFederationUUIDProject200JSONResponse) VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDUserJSONRequestBody
+}
+
+type PostFederationUUIDUserResponseObject interface {
+	VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDUser200JSONResponse UUIDResponse
+
+func (response PostFederationUUIDUser200JSONResponse) VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
+}
+
+type DeleteFederationUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDUserUserUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDUserUserUUID200Response) VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFederationUUIDWebhookRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDWebhookResponseObject interface {
+	VisitGetFederationUUIDWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDWebhook200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []WebhookDTO `json:"items"`
+}
+
+func (response GetFederationUUIDWebhook200JSONResponse) VisitGetFederationUUIDWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDWebhookRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDWebhookJSONRequestBody
+}
+
+type PostFederationUUIDWebhookResponseObject interface {
+	VisitPostFederationUUIDWebhookResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDWebhook200JSONResponse WebhookDTO
+
+func (response PostFederationUUIDWebhook200JSONResponse) VisitPostFederationUUIDWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroupUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *DeleteGroupUUIDUserJSONRequestBody
+}
+
+type DeleteGroupUUIDUserResponseObject interface {
+	VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteGroupUUIDUser200Response struct {
+}
+
+func (response DeleteGroupUUIDUser200Response) VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetGroupUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetGroupUUIDUserResponseObject interface {
+	VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type GetGroupUUIDUser200JSONResponse struct {
+	Count int       `json:"count"`
+	Items []UserDTO `json:"items"`
+}
+
+func (response GetGroupUUIDUser200JSONResponse) VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostGroupUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostGroupUUIDUserJSONRequestBody
+}
+
+type PostGroupUUIDUserResponseObject interface {
+	VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type PostGroupUUIDUser200Response struct {
+}
+
+func (response PostGroupUUIDUser200Response) VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostPermissionsRequestObject struct {
+	Body *PostPermissionsJSONRequestBody
+}
+
+type PostPermissionsResponseObject interface {
+	VisitPostPermissionsResponse(w http.ResponseWriter) error
+}
+
+type PostPermissions200JSONResponse struct {
+	UpdatedAt time.Time          `json:"updated_at"`
+	UserUuid  openapi_types.UUID `json:"user_uuid"`
+}
+
+func (response PostPermissions200JSONResponse) VisitPostPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPermissionsRolesRequestObject struct {
+}
+
+type GetPermissionsRolesResponseObject interface {
+	VisitGetPermissionsRolesResponse(w http.ResponseWriter) error
+}
+
+type GetPermissionsRoles200JSONResponse struct {
+	Count int                 `json:"count"`
+	Items []PermissionRoleDTO `json:"items"`
+}
+
+func (response GetPermissionsRoles200JSONResponse) VisitGetPermissionsRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionsUUIDRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params DeletePermissionsUUIDParams
+}
+
+type DeletePermissionsUUIDResponseObject interface {
+	VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeletePermissionsUUID200Response struct {
+}
+
+func (response DeletePermissionsUUID200Response) VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetPermissionsUUIDRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetPermissionsUUIDParams
+}
+
+type GetPermissionsUUIDResponseObject interface {
+	VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetPermissionsUUID200JSONResponse struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	FederationUuid openapi_types.UUID `json:"federation_uuid"`
+	Rules          PermissionRulesDTO `json:"rules"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+	UserUuid       openapi_types.UUID `json:"user_uuid"`
+}
+
+func (response GetPermissionsUUID200JSONResponse) VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPermissionsUUIDEffectiveRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetPermissionsUUIDEffectiveParams
+}
+
+type GetPermissionsUUIDEffectiveResponseObject interface {
+	VisitGetPermissionsUUIDEffectiveResponse(w http.ResponseWriter) error
+}
+
+type GetPermissionsUUIDEffective200JSONResponse EffectivePermissionsDTO
+
+func (response GetPermissionsUUIDEffective200JSONResponse) VisitGetPermissionsUUIDEffectiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPermissionsUUIDRolesRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetPermissionsUUIDRolesParams
+}
+
+type GetPermissionsUUIDRolesResponseObject interface {
+	VisitGetPermissionsUUIDRolesResponse(w http.ResponseWriter) error
+}
+
+type GetPermissionsUUIDRoles200JSONResponse struct {
+	Count int                           `json:"count"`
+	Items []PermissionRoleAssignmentDTO `json:"items"`
+}
+
+func (response GetPermissionsUUIDRoles200JSONResponse) VisitGetPermissionsUUIDRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPermissionsUUIDRolesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostPermissionsUUIDRolesJSONRequestBody
+}
+
+type PostPermissionsUUIDRolesResponseObject interface {
+	VisitPostPermissionsUUIDRolesResponse(w http.ResponseWriter) error
+}
+
+type PostPermissionsUUIDRoles200JSONResponse UUIDResponse
+
+func (response PostPermissionsUUIDRoles200JSONResponse) VisitPostPermissionsUUIDRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionsUUIDRolesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeletePermissionsUUIDRolesEntityUUIDResponseObject interface {
+	VisitDeletePermissionsUUIDRolesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeletePermissionsUUIDRolesEntityUUID200Response struct {
+}
+
+func (response DeletePermissionsUUIDRolesEntityUUID200Response) VisitDeletePermissionsUUIDRolesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileSurveyRequestObject struct {
+	Body *PostProfileSurveyJSONRequestBody
+}
+
+type PostProfileSurveyResponseObject interface {
+	VisitPostProfileSurveyResponse(w http.ResponseWriter) error
+}
+
+type PostProfileSurvey200JSONResponse UUIDResponse
+
+func (response PostProfileSurvey200JSONResponse) VisitPostProfileSurveyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfileSurveyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProfileSurveyUUIDResponseObject interface {
+	VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileSurveyUUID200Response struct {
+}
+
+func (response DeleteProfileSurveyUUID200Response) VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProfileSurveyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProfileSurveyUUIDResponseObject interface {
+	VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetProfileSurveyUUID200JSONResponse SurveyDTO
+
+func (response GetProfileSurveyUUID200JSONResponse) VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectRequestObject struct {
+	Body *PostProjectJSONRequestBody
+}
+
+type PostProjectResponseObject interface {
+	VisitPostProjectResponse(w http.ResponseWriter) error
+}
+
+type PostProject200JSONResponse UUIDResponse
+
+func (response PostProject200JSONResponse) VisitPostProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProjectUUIDResponseObject interface {
+	VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUID200Response struct {
+}
+
+func (response DeleteProjectUUID200Response) VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDResponseObject interface {
+	VisitGetProjectUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUID200JSONResponse ProjectDTO
+
+func (response GetProjectUUID200JSONResponse) VisitGetProjectUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDJSONRequestBody
+}
 
+type PatchProjectUUIDResponseObject interface {
+	VisitPatchProjectUUIDResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyRequestObject struct {
-	Body *PostCompanyJSONRequestBody
+type PatchProjectUUID200Response struct {
 }
 
-type PostCompanyResponseObject interface {
-	VisitPostCompanyResponse(w http.ResponseWriter) error
+func (response PatchProjectUUID200Response) VisitPatchProjectUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-type PostCompany200JSONResponse UUIDResponse
+type GetProjectUUIDCatalogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-func (response PostCompany200JSONResponse) VisitPostCompanyResponse(w http.ResponseWriter) error {
+type GetProjectUUIDCatalogResponseObject interface {
+	VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDCatalog200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
+}
+
+func (response GetProjectUUIDCatalog200JSONResponse) VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDCatalogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDCatalogJSONRequestBody
+}
+
+type PostProjectUUIDCatalogResponseObject interface {
+	VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDCatalog200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostProjectUUIDCatalog200JSONResponse) VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDCatalogEntityNameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
+}
+
+type GetProjectUUIDCatalogEntityNameResponseObject interface {
+	VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDCatalogEntityName200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
+}
+
+func (response GetProjectUUIDCatalogEntityName200JSONResponse) VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDCatalogEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDCatalogEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDCatalogEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDCatalogEntityUUID200Response) VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDDescriptionRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDDescriptionJSONRequestBody
+}
+
+type PatchProjectUUIDDescriptionResponseObject interface {
+	VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDDescription200Response struct {
+}
+
+func (response PatchProjectUUIDDescription200Response) VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProjectUUIDFieldEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDFieldEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDFieldEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDFieldEntityUUID200Response) VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProjectUUIDFieldEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostProjectUUIDFieldEntityUUIDJSONRequestBody
+}
+
+type PostProjectUUIDFieldEntityUUIDResponseObject interface {
+	VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDFieldEntityUUID200Response struct {
+}
+
+func (response PostProjectUUIDFieldEntityUUID200Response) VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDGraphRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDGraphJSONRequestBody
+}
+
+type PatchProjectUUIDGraphResponseObject interface {
+	VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDGraph200JSONResponse map[string]interface{}
+
+func (response PatchProjectUUIDGraph200JSONResponse) VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProjectUUIDNameRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDNameJSONRequestBody
+}
+
+type PatchProjectUUIDNameResponseObject interface {
+	VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDName200Response struct {
+}
+
+func (response PatchProjectUUIDName200Response) VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDOptionsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDOptionsJSONRequestBody
+}
+
+type PatchProjectUUIDOptionsResponseObject interface {
+	VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDOptions200Response struct {
+}
+
+func (response PatchProjectUUIDOptions200Response) VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDRecurrenceRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDRecurrenceResponseObject interface {
+	VisitGetProjectUUIDRecurrenceResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDRecurrence200JSONResponse struct {
+	Count int                 `json:"count"`
+	Items []TaskRecurrenceDTO `json:"items"`
+}
+
+func (response GetProjectUUIDRecurrence200JSONResponse) VisitGetProjectUUIDRecurrenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDStatusRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDStatusResponseObject interface {
+	VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDStatus200JSONResponse struct {
+	Count int                `json:"count"`
+	Items []ProjectStatusDTO `json:"items"`
+}
+
+func (response GetProjectUUIDStatus200JSONResponse) VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDStatusRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDStatusJSONRequestBody
+}
+
+type PostProjectUUIDStatusResponseObject interface {
+	VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDStatus200JSONResponse UUIDResponse
+
+func (response PostProjectUUIDStatus200JSONResponse) VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDStatusEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDStatusEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDStatusEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDStatusEntityUUID200Response) VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-type DeleteCompanyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type PatchProjectUUIDStatusEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchProjectUUIDStatusEntityUUIDJSONRequestBody
 }
 
-type DeleteCompanyUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error
+type PatchProjectUUIDStatusEntityUUIDResponseObject interface {
+	VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteCompanyUUID200Response struct {
+type PatchProjectUUIDStatusEntityUUID200Response struct {
 }
 
-func (response DeleteCompanyUUID200Response) VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error {
+func (response PatchProjectUUIDStatusEntityUUID200Response) VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetCompanyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type PostProjectUUIDTaskImportRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params PostProjectUUIDTaskImportParams
+	Body   *multipart.Reader
 }
 
-type GetCompanyUUIDResponseObject interface {
-	VisitGetCompanyUUIDResponse(w http.ResponseWriter) error
+type PostProjectUUIDTaskImportResponseObject interface {
+	VisitPostProjectUUIDTaskImportResponse(w http.ResponseWriter) error
 }
 
-type GetCompanyUUID200JSONResponse CompanyDTO
+type PostProjectUUIDTaskImport200JSONResponse TaskImportDTO
 
-func (response GetCompanyUUID200JSONResponse) VisitGetCompanyUUIDResponse(w http.ResponseWriter) error {
+func (response PostProjectUUIDTaskImport200JSONResponse) VisitPostProjectUUIDTaskImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCompanyUUIDFieldsRequestObject struct {
+type GetProjectUUIDTemplateRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
 
-type GetCompanyUUIDFieldsResponseObject interface {
-	VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error
+type GetProjectUUIDTemplateResponseObject interface {
+	VisitGetProjectUUIDTemplateResponse(w http.ResponseWriter) error
 }
 
-type GetCompanyUUIDFields200JSONResponse struct {
+type GetProjectUUIDTemplate200JSONResponse struct {
 	Count int               `json:"count"`
-	Items []CompanyFieldDTO `json:"items"`
+	Items []TaskTemplateDTO `json:"items"`
 }
 
-func (response GetCompanyUUIDFields200JSONResponse) VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
+func (response GetProjectUUIDTemplate200JSONResponse) VisitGetProjectUUIDTemplateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCompanyUUIDFieldsRequestObject struct {
+type PostProjectUUIDTemplateRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDFieldsJSONRequestBody
+	Body *PostProjectUUIDTemplateJSONRequestBody
 }
 
-type PostCompanyUUIDFieldsResponseObject interface {
-	VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error
+type PostProjectUUIDTemplateResponseObject interface {
+	VisitPostProjectUUIDTemplateResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDFields200JSONResponse struct {
-	Hash            string               `json:"hash"`
-	Icon            string               `json:"icon"`
-	Type            domain.FieldDataType `json:"type"`
-	TypeDescription string               `json:"type_description"`
-	TypeUuid        *openapi_types.UUID  `json:"type_uuid,omitempty"`
-	Uuid            string               `json:"uuid"`
-}
+type PostProjectUUIDTemplate200JSONResponse TaskTemplateDTO
 
-func (response PostCompanyUUIDFields200JSONResponse) VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
+func (response PostProjectUUIDTemplate200JSONResponse) VisitPostProjectUUIDTemplateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCompanyUUIDFieldsEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
+type PostProjectUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDUserJSONRequestBody
 }
 
-type DeleteCompanyUUIDFieldsEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
+type PostProjectUUIDUserResponseObject interface {
+	VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error
 }
 
-type DeleteCompanyUUIDFieldsEntityUUID200Response struct {
-}
+type PostProjectUUIDUser200JSONResponse UUIDResponse
 
-func (response DeleteCompanyUUIDFieldsEntityUUID200Response) VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
+func (response PostProjectUUIDUser200JSONResponse) VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PutCompanyUUIDFieldsEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+type DeleteProjectUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
 }
 
-type PutCompanyUUIDFieldsEntityUUIDResponseObject interface {
-	VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
+type DeleteProjectUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error
 }
 
-type PutCompanyUUIDFieldsEntityUUID200Response struct {
+type DeleteProjectUUIDUserUserUUID200Response struct {
 }
 
-func (response PutCompanyUUIDFieldsEntityUUID200Response) VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteProjectUUIDUserUserUUID200Response) VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetCompanyUUIDGroupRequestObject struct {
+type GetProjectUUIDViewRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
 
-type GetCompanyUUIDGroupResponseObject interface {
-	VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error
+type GetProjectUUIDViewResponseObject interface {
+	VisitGetProjectUUIDViewResponse(w http.ResponseWriter) error
 }
 
-type GetCompanyUUIDGroup200JSONResponse struct {
-	Count int        `json:"count"`
-	Items []GroupDTO `json:"items"`
+type GetProjectUUIDView200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []TaskViewDTO `json:"items"`
 }
 
-func (response GetCompanyUUIDGroup200JSONResponse) VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error {
+func (response GetProjectUUIDView200JSONResponse) VisitGetProjectUUIDViewResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCompanyUUIDGroupRequestObject struct {
+type PostProjectUUIDViewRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDGroupJSONRequestBody
+	Body *PostProjectUUIDViewJSONRequestBody
 }
 
-type PostCompanyUUIDGroupResponseObject interface {
-	VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error
+type PostProjectUUIDViewResponseObject interface {
+	VisitPostProjectUUIDViewResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDGroup200JSONResponse UUIDResponse
+type PostProjectUUIDView200JSONResponse TaskViewDTO
 
-func (response PostCompanyUUIDGroup200JSONResponse) VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error {
+func (response PostProjectUUIDView200JSONResponse) VisitPostProjectUUIDViewResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCompanyUUIDGroupEntityUUIDRequestObject struct {
+type DeleteProjectUUIDViewEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
 }
 
-type DeleteCompanyUUIDGroupEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+type DeleteProjectUUIDViewEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDViewEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteCompanyUUIDGroupEntityUUID200Response struct {
+type DeleteProjectUUIDViewEntityUUID200Response struct {
 }
 
-func (response DeleteCompanyUUIDGroupEntityUUID200Response) VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteProjectUUIDViewEntityUUID200Response) VisitDeleteProjectUUIDViewEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PatchCompanyUUIDGroupEntityUUIDRequestObject struct {
+type PutProjectUUIDViewEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
+	Body       *PutProjectUUIDViewEntityUUIDJSONRequestBody
 }
 
-type PatchCompanyUUIDGroupEntityUUIDResponseObject interface {
-	VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+type PutProjectUUIDViewEntityUUIDResponseObject interface {
+	VisitPutProjectUUIDViewEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type PatchCompanyUUIDGroupEntityUUID200Response struct {
-}
+type PutProjectUUIDViewEntityUUID200JSONResponse TaskViewDTO
 
-func (response PatchCompanyUUIDGroupEntityUUID200Response) VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
+func (response PutProjectUUIDViewEntityUUID200JSONResponse) VisitPutProjectUUIDViewEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
 
-type PatchCompanyUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchCompanyUUIDNameJSONRequestBody
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchCompanyUUIDNameResponseObject interface {
-	VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error
+type GetProjectUUIDWorklogRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDWorklogParams
 }
 
-type PatchCompanyUUIDName200Response struct {
+type GetProjectUUIDWorklogResponseObject interface {
+	VisitGetProjectUUIDWorklogResponse(w http.ResponseWriter) error
 }
 
-func (response PatchCompanyUUIDName200Response) VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error {
+type GetProjectUUIDWorklog200JSONResponse WorklogTotalDTO
+
+func (response GetProjectUUIDWorklog200JSONResponse) VisitGetProjectUUIDWorklogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCompanyUUIDPrioritiesRequestObject struct {
+type DeleteRecurrenceUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
 
-type GetCompanyUUIDPrioritiesResponseObject interface {
-	VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
+type DeleteRecurrenceUUIDResponseObject interface {
+	VisitDeleteRecurrenceUUIDResponse(w http.ResponseWriter) error
 }
 
-type GetCompanyUUIDPriorities200JSONResponse struct {
-	Count int                  `json:"count"`
-	Items []CompanyPriorityDTO `json:"items"`
+type DeleteRecurrenceUUID200Response struct {
 }
 
-func (response GetCompanyUUIDPriorities200JSONResponse) VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response DeleteRecurrenceUUID200Response) VisitDeleteRecurrenceUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type PostCompanyUUIDPrioritiesRequestObject struct {
+type GetRecurrenceUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDPrioritiesJSONRequestBody
 }
 
-type PostCompanyUUIDPrioritiesResponseObject interface {
-	VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
+type GetRecurrenceUUIDResponseObject interface {
+	VisitGetRecurrenceUUIDResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDPriorities200JSONResponse UUIDResponse
+type GetRecurrenceUUID200JSONResponse TaskRecurrenceDTO
 
-func (response PostCompanyUUIDPriorities200JSONResponse) VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
+func (response GetRecurrenceUUID200JSONResponse) VisitGetRecurrenceUUIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
+type PutRecurrenceUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutRecurrenceUUIDJSONRequestBody
 }
 
-type DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
+type PutRecurrenceUUIDResponseObject interface {
+	VisitPutRecurrenceUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteCompanyUUIDPrioritiesEntityUUID200Response struct {
-}
+type PutRecurrenceUUID200JSONResponse TaskRecurrenceDTO
 
-func (response DeleteCompanyUUIDPrioritiesEntityUUID200Response) VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
+func (response PutRecurrenceUUID200JSONResponse) VisitPutRecurrenceUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
-
-type PatchCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
-}
-
-type PatchCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
-	VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
-}
-
-type PatchCompanyUUIDPrioritiesEntityUUID200Response struct {
-}
 
-func (response PatchCompanyUUIDPrioritiesEntityUUID200Response) VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCompanyUUIDProjectCatalogEntityNameRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityName EntityName `json:"entityName"`
+type GetSearchRequestObject struct {
+	Params GetSearchParams
 }
 
-type GetCompanyUUIDProjectCatalogEntityNameResponseObject interface {
-	VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error
+type GetSearchResponseObject interface {
+	VisitGetSearchResponse(w http.ResponseWriter) error
 }
 
-type GetCompanyUUIDProjectCatalogEntityName200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
+type GetSearch200JSONResponse struct {
+	Count int            `json:"count"`
+	Items []SearchHitDTO `json:"items"`
+	Total int64          `json:"total"`
 }
 
-func (response GetCompanyUUIDProjectCatalogEntityName200JSONResponse) VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error {
+func (response GetSearch200JSONResponse) VisitGetSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCompanyUUIDSmsRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetCompanyUUIDSmsParams
+type GetTagRequestObject struct {
+	Params GetTagParams
 }
 
-type GetCompanyUUIDSmsResponseObject interface {
-	VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error
+type GetTagResponseObject interface {
+	VisitGetTagResponse(w http.ResponseWriter) error
 }
 
-type GetCompanyUUIDSms200JSONResponse struct {
+type GetTag200JSONResponse struct {
 	Count int      `json:"count"`
-	Items []SmsDTO `json:"items"`
-	Total int64    `json:"total"`
+	Items []TagDTO `json:"items"`
 }
 
-func (response GetCompanyUUIDSms200JSONResponse) VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error {
+func (response GetTag200JSONResponse) VisitGetTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCompanyUUIDSmsCostRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDSmsCostJSONRequestBody
+type PostTagRequestObject struct {
+	Body *PostTagJSONRequestBody
 }
 
-type PostCompanyUUIDSmsCostResponseObject interface {
-	VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error
+type PostTagResponseObject interface {
+	VisitPostTagResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDSmsCost200JSONResponse map[string]interface{}
+type PostTag200JSONResponse UUIDResponse
 
-func (response PostCompanyUUIDSmsCost200JSONResponse) VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error {
+func (response PostTag200JSONResponse) VisitPostTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCompanyUUIDSmsOptionsRequestObject struct {
+type DeleteTagUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDSmsOptionsJSONRequestBody
 }
 
-type PostCompanyUUIDSmsOptionsResponseObject interface {
-	VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error
+type DeleteTagUUIDResponseObject interface {
+	VisitDeleteTagUUIDResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDSmsOptions200Response struct {
+type DeleteTagUUID200Response struct {
 }
 
-func (response PostCompanyUUIDSmsOptions200Response) VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error {
+func (response DeleteTagUUID200Response) VisitDeleteTagUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PostCompanyUUIDSmsSendRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params PostCompanyUUIDSmsSendParams
-	Body   *PostCompanyUUIDSmsSendJSONRequestBody
-}
-
-type PostCompanyUUIDSmsSendResponseObject interface {
-	VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error
-}
-
-type PostCompanyUUIDSmsSend200JSONResponse map[string]interface{}
-
-func (response PostCompanyUUIDSmsSend200JSONResponse) VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PostCompanyUUIDUserRequestObject struct {
+type PatchTagUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDUserJSONRequestBody
+	Body *PatchTagUUIDJSONRequestBody
 }
 
-type PostCompanyUUIDUserResponseObject interface {
-	VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error
+type PatchTagUUIDResponseObject interface {
+	VisitPatchTagUUIDResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDUser200JSONResponse UUIDResponse
+type PatchTagUUID200Response struct {
+}
 
-func (response PostCompanyUUIDUser200JSONResponse) VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PatchTagUUID200Response) VisitPatchTagUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type DeleteCompanyUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
+type DeleteTemplateUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
 }
 
-type DeleteCompanyUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+type DeleteTemplateUUIDResponseObject interface {
+	VisitDeleteTemplateUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteCompanyUUIDUserUserUUID200Response struct {
+type DeleteTemplateUUID200Response struct {
 }
 
-func (response DeleteCompanyUUIDUserUserUUID200Response) VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteTemplateUUID200Response) VisitDeleteTemplateUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PostFederationRequestObject struct {
-	Body *PostFederationJSONRequestBody
+type GetTemplateUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
 }
 
-type PostFederationResponseObject interface {
-	VisitPostFederationResponse(w http.ResponseWriter) error
+type GetTemplateUUIDResponseObject interface {
+	VisitGetTemplateUUIDResponse(w http.ResponseWriter) error
 }
 
-type PostFederation200JSONResponse UUIDResponse
+type GetTemplateUUID200JSONResponse TaskTemplateDTO
 
-func (response PostFederation200JSONResponse) VisitPostFederationResponse(w http.ResponseWriter) error {
+func (response GetTemplateUUID200JSONResponse) VisitGetTemplateUUIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFederationUUIDRequestObject struct {
+type PutTemplateUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
+	Body *PutTemplateUUIDJSONRequestBody
 }
 
-type DeleteFederationUUIDResponseObject interface {
-	VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error
+type PutTemplateUUIDResponseObject interface {
+	VisitPutTemplateUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteFederationUUID200Response struct {
+type PutTemplateUUID200Response struct {
 }
 
-func (response DeleteFederationUUID200Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+func (response PutTemplateUUID200Response) VisitPutTemplateUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type DeleteFederationUUID401Response struct {
-}
-
-func (response DeleteFederationUUID401Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
-
-type GetFederationUUIDRequestObject struct {
+type PostTemplateUUIDRecurrenceRequestObject struct {
 	UUID Uuid `json:"UUID"`
+	Body *PostTemplateUUIDRecurrenceJSONRequestBody
 }
 
-type GetFederationUUIDResponseObject interface {
-	VisitGetFederationUUIDResponse(w http.ResponseWriter) error
+type PostTemplateUUIDRecurrenceResponseObject interface {
+	VisitPostTemplateUUIDRecurrenceResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUID200JSONResponse FederationDTO
+type PostTemplateUUIDRecurrence200JSONResponse TaskRecurrenceDTO
 
-func (response GetFederationUUID200JSONResponse) VisitGetFederationUUIDResponse(w http.ResponseWriter) error {
+func (response PostTemplateUUIDRecurrence200JSONResponse) VisitPostTemplateUUIDRecurrenceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetFederationUUIDAgentRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetFederationUUIDAgentParams
+type PostTemplateUUIDTaskRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTemplateUUIDTaskJSONRequestBody
 }
 
-type GetFederationUUIDAgentResponseObject interface {
-	VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error
+type PostTemplateUUIDTaskResponseObject interface {
+	VisitPostTemplateUUIDTaskResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUIDAgent200JSONResponse struct {
-	Count int        `json:"count"`
-	Items []AgentDTO `json:"items"`
-	Total int64      `json:"total"`
+type PostTemplateUUIDTask200JSONResponse struct {
+	Count int `json:"count"`
+
+	// Uuid The root task
+	Uuid openapi_types.UUID `json:"uuid"`
 }
 
-func (response GetFederationUUIDAgent200JSONResponse) VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error {
+func (response PostTemplateUUIDTask200JSONResponse) VisitPostTemplateUUIDTaskResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostFederationUUIDAgentRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDAgentJSONRequestBody
+type GetTimesheetRequestObject struct {
+	Params GetTimesheetParams
 }
 
-type PostFederationUUIDAgentResponseObject interface {
-	VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error
+type GetTimesheetResponseObject interface {
+	VisitGetTimesheetResponse(w http.ResponseWriter) error
 }
 
-type PostFederationUUIDAgent200JSONResponse UUIDResponse
+type GetTimesheet200ResponseHeaders struct {
+	ContentDisposition string
+	ContentType        string
+	CacheControl       string
+}
 
-func (response PostFederationUUIDAgent200JSONResponse) VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error {
+type GetTimesheet200JSONResponse struct {
+	Body struct {
+		Count int               `json:"count"`
+		Items []TimesheetRowDTO `json:"items"`
+		Total WorklogTotalDTO   `json:"total"`
+	}
+	Headers GetTimesheet200ResponseHeaders
+}
+
+func (response GetTimesheet200JSONResponse) VisitGetTimesheetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteFederationUUIDAgentEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
+type GetTimesheet200ApplicationxlsxResponse struct {
+	Body          io.Reader
+	Headers       GetTimesheet200ResponseHeaders
+	ContentLength int64
 }
 
-type DeleteFederationUUIDAgentEntityUUIDResponseObject interface {
-	VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+func (response GetTimesheet200ApplicationxlsxResponse) VisitGetTimesheetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xlsx")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type DeleteFederationUUIDAgentEntityUUID200Response struct {
+type GetUserRequestObject struct {
+	Body *GetUserJSONRequestBody
 }
 
-func (response DeleteFederationUUIDAgentEntityUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+type GetUserResponseObject interface {
+	VisitGetUserResponse(w http.ResponseWriter) error
+}
+
+type GetUser200JSONResponse struct {
+	Count int       `json:"count"`
+	Items []UserDTO `json:"items"`
+}
+
+func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchFederationUUIDAgentEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchFederationUUIDAgentEntityUUIDJSONRequestBody
+type DeleteWebhookUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
 }
 
-type PatchFederationUUIDAgentEntityUUIDResponseObject interface {
-	VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+type DeleteWebhookUUIDResponseObject interface {
+	VisitDeleteWebhookUUIDResponse(w http.ResponseWriter) error
 }
 
-type PatchFederationUUIDAgentEntityUUID200Response struct {
+type DeleteWebhookUUID200Response struct {
 }
 
-func (response PatchFederationUUIDAgentEntityUUID200Response) VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteWebhookUUID200Response) VisitDeleteWebhookUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetFederationUUIDInviteRequestObject struct {
+type GetWebhookUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
 
-type GetFederationUUIDInviteResponseObject interface {
-	VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error
+type GetWebhookUUIDResponseObject interface {
+	VisitGetWebhookUUIDResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUIDInvite200JSONResponse struct {
-	Count int         `json:"count"`
-	Items []InviteDTO `json:"items"`
-}
+type GetWebhookUUID200JSONResponse WebhookDTO
 
-func (response GetFederationUUIDInvite200JSONResponse) VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error {
+func (response GetWebhookUUID200JSONResponse) VisitGetWebhookUUIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostFederationUUIDInviteRequestObject struct {
+type PutWebhookUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDInviteJSONRequestBody
+	Body *PutWebhookUUIDJSONRequestBody
 }
 
-type PostFederationUUIDInviteResponseObject interface {
-	VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error
+type PutWebhookUUIDResponseObject interface {
+	VisitPutWebhookUUIDResponse(w http.ResponseWriter) error
 }
 
-type PostFederationUUIDInvite200JSONResponse UUIDResponse
+type PutWebhookUUID200Response struct {
+}
 
-func (response PostFederationUUIDInvite200JSONResponse) VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error {
+func (response PutWebhookUUID200Response) VisitPutWebhookUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetWebhookUUIDDeliveryRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetWebhookUUIDDeliveryParams
+}
+
+type GetWebhookUUIDDeliveryResponseObject interface {
+	VisitGetWebhookUUIDDeliveryResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookUUIDDelivery200JSONResponse struct {
+	Count int                  `json:"count"`
+	Items []WebhookDeliveryDTO `json:"items"`
+}
+
+func (response GetWebhookUUIDDelivery200JSONResponse) VisitGetWebhookUUIDDeliveryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFederationUUIDInviteEntityUUIDRequestObject struct {
+type PostWebhookUUIDDeliveryEntityUUIDRedeliverRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
 }
 
-type DeleteFederationUUIDInviteEntityUUIDResponseObject interface {
-	VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error
+type PostWebhookUUIDDeliveryEntityUUIDRedeliverResponseObject interface {
+	VisitPostWebhookUUIDDeliveryEntityUUIDRedeliverResponse(w http.ResponseWriter) error
 }
 
-type DeleteFederationUUIDInviteEntityUUID200Response struct {
-}
+type PostWebhookUUIDDeliveryEntityUUIDRedeliver200JSONResponse WebhookDeliveryDTO
 
-func (response DeleteFederationUUIDInviteEntityUUID200Response) VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error {
+func (response PostWebhookUUIDDeliveryEntityUUIDRedeliver200JSONResponse) VisitPostWebhookUUIDDeliveryEntityUUIDRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchFederationUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchFederationUUIDNameJSONRequestBody
-}
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /company)
+	PostCompany(ctx context.Context, request PostCompanyRequestObject) (PostCompanyResponseObject, error)
+
+	// (DELETE /company/{UUID})
+	DeleteCompanyUUID(ctx context.Context, request DeleteCompanyUUIDRequestObject) (DeleteCompanyUUIDResponseObject, error)
+
+	// (GET /company/{UUID})
+	GetCompanyUUID(ctx context.Context, request GetCompanyUUIDRequestObject) (GetCompanyUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/fields)
+	GetCompanyUUIDFields(ctx context.Context, request GetCompanyUUIDFieldsRequestObject) (GetCompanyUUIDFieldsResponseObject, error)
+
+	// (POST /company/{UUID}/fields)
+	PostCompanyUUIDFields(ctx context.Context, request PostCompanyUUIDFieldsRequestObject) (PostCompanyUUIDFieldsResponseObject, error)
+
+	// (DELETE /company/{UUID}/fields/{entityUUID})
+	DeleteCompanyUUIDFieldsEntityUUID(ctx context.Context, request DeleteCompanyUUIDFieldsEntityUUIDRequestObject) (DeleteCompanyUUIDFieldsEntityUUIDResponseObject, error)
+
+	// (PUT /company/{UUID}/fields/{entityUUID})
+	PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request PutCompanyUUIDFieldsEntityUUIDRequestObject) (PutCompanyUUIDFieldsEntityUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/group)
+	GetCompanyUUIDGroup(ctx context.Context, request GetCompanyUUIDGroupRequestObject) (GetCompanyUUIDGroupResponseObject, error)
+
+	// (POST /company/{UUID}/group)
+	PostCompanyUUIDGroup(ctx context.Context, request PostCompanyUUIDGroupRequestObject) (PostCompanyUUIDGroupResponseObject, error)
+
+	// (DELETE /company/{UUID}/group/{entityUUID})
+	DeleteCompanyUUIDGroupEntityUUID(ctx context.Context, request DeleteCompanyUUIDGroupEntityUUIDRequestObject) (DeleteCompanyUUIDGroupEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/group/{entityUUID})
+	PatchCompanyUUIDGroupEntityUUID(ctx context.Context, request PatchCompanyUUIDGroupEntityUUIDRequestObject) (PatchCompanyUUIDGroupEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/name)
+	PatchCompanyUUIDName(ctx context.Context, request PatchCompanyUUIDNameRequestObject) (PatchCompanyUUIDNameResponseObject, error)
+
+	// (GET /company/{UUID}/priorities)
+	GetCompanyUUIDPriorities(ctx context.Context, request GetCompanyUUIDPrioritiesRequestObject) (GetCompanyUUIDPrioritiesResponseObject, error)
+
+	// (POST /company/{UUID}/priorities)
+	PostCompanyUUIDPriorities(ctx context.Context, request PostCompanyUUIDPrioritiesRequestObject) (PostCompanyUUIDPrioritiesResponseObject, error)
+
+	// (DELETE /company/{UUID}/priorities/{entityUUID})
+	DeleteCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject) (DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/priorities/{entityUUID})
+	PatchCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject) (PatchCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/project/catalog/{entityName})
+	GetCompanyUUIDProjectCatalogEntityName(ctx context.Context, request GetCompanyUUIDProjectCatalogEntityNameRequestObject) (GetCompanyUUIDProjectCatalogEntityNameResponseObject, error)
+
+	// (GET /company/{UUID}/sms)
+	GetCompanyUUIDSms(ctx context.Context, request GetCompanyUUIDSmsRequestObject) (GetCompanyUUIDSmsResponseObject, error)
+
+	// (POST /company/{UUID}/sms/cost)
+	PostCompanyUUIDSmsCost(ctx context.Context, request PostCompanyUUIDSmsCostRequestObject) (PostCompanyUUIDSmsCostResponseObject, error)
+
+	// (POST /company/{UUID}/sms/options)
+	PostCompanyUUIDSmsOptions(ctx context.Context, request PostCompanyUUIDSmsOptionsRequestObject) (PostCompanyUUIDSmsOptionsResponseObject, error)
+
+	// (POST /company/{UUID}/sms/send)
+	PostCompanyUUIDSmsSend(ctx context.Context, request PostCompanyUUIDSmsSendRequestObject) (PostCompanyUUIDSmsSendResponseObject, error)
+
+	// (GET /company/{UUID}/template)
+	GetCompanyUUIDTemplate(ctx context.Context, request GetCompanyUUIDTemplateRequestObject) (GetCompanyUUIDTemplateResponseObject, error)
+
+	// (POST /company/{UUID}/template)
+	PostCompanyUUIDTemplate(ctx context.Context, request PostCompanyUUIDTemplateRequestObject) (PostCompanyUUIDTemplateResponseObject, error)
+
+	// (POST /company/{UUID}/user)
+	PostCompanyUUIDUser(ctx context.Context, request PostCompanyUUIDUserRequestObject) (PostCompanyUUIDUserResponseObject, error)
+
+	// (DELETE /company/{UUID}/user/{userUUID})
+	DeleteCompanyUUIDUserUserUUID(ctx context.Context, request DeleteCompanyUUIDUserUserUUIDRequestObject) (DeleteCompanyUUIDUserUserUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/webhook)
+	GetCompanyUUIDWebhook(ctx context.Context, request GetCompanyUUIDWebhookRequestObject) (GetCompanyUUIDWebhookResponseObject, error)
+
+	// (POST /company/{UUID}/webhook)
+	PostCompanyUUIDWebhook(ctx context.Context, request PostCompanyUUIDWebhookRequestObject) (PostCompanyUUIDWebhookResponseObject, error)
+
+	// (POST /federation)
+	PostFederation(ctx context.Context, request PostFederationRequestObject) (PostFederationResponseObject, error)
+
+	// (DELETE /federation/{UUID})
+	DeleteFederationUUID(ctx context.Context, request DeleteFederationUUIDRequestObject) (DeleteFederationUUIDResponseObject, error)
+
+	// (GET /federation/{UUID})
+	GetFederationUUID(ctx context.Context, request GetFederationUUIDRequestObject) (GetFederationUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/agent)
+	GetFederationUUIDAgent(ctx context.Context, request GetFederationUUIDAgentRequestObject) (GetFederationUUIDAgentResponseObject, error)
+
+	// (POST /federation/{UUID}/agent)
+	PostFederationUUIDAgent(ctx context.Context, request PostFederationUUIDAgentRequestObject) (PostFederationUUIDAgentResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/duplicates)
+	GetFederationUUIDAgentDuplicates(ctx context.Context, request GetFederationUUIDAgentDuplicatesRequestObject) (GetFederationUUIDAgentDuplicatesResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/export)
+	GetFederationUUIDAgentExport(ctx context.Context, request GetFederationUUIDAgentExportRequestObject) (GetFederationUUIDAgentExportResponseObject, error)
+
+	// (POST /federation/{UUID}/agent/import)
+	PostFederationUUIDAgentImport(ctx context.Context, request PostFederationUUIDAgentImportRequestObject) (PostFederationUUIDAgentImportResponseObject, error)
+
+	// (DELETE /federation/{UUID}/agent/{entityUUID})
+	DeleteFederationUUIDAgentEntityUUID(ctx context.Context, request DeleteFederationUUIDAgentEntityUUIDRequestObject) (DeleteFederationUUIDAgentEntityUUIDResponseObject, error)
+
+	// (PATCH /federation/{UUID}/agent/{entityUUID})
+	PatchFederationUUIDAgentEntityUUID(ctx context.Context, request PatchFederationUUIDAgentEntityUUIDRequestObject) (PatchFederationUUIDAgentEntityUUIDResponseObject, error)
+
+	// (POST /federation/{UUID}/agent/{entityUUID}/merge)
+	PostFederationUUIDAgentEntityUUIDMerge(ctx context.Context, request PostFederationUUIDAgentEntityUUIDMergeRequestObject) (PostFederationUUIDAgentEntityUUIDMergeResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/timeline)
+	GetFederationUUIDAgentEntityUUIDTimeline(ctx context.Context, request GetFederationUUIDAgentEntityUUIDTimelineRequestObject) (GetFederationUUIDAgentEntityUUIDTimelineResponseObject, error)
+
+	// (GET /federation/{UUID}/invite)
+	GetFederationUUIDInvite(ctx context.Context, request GetFederationUUIDInviteRequestObject) (GetFederationUUIDInviteResponseObject, error)
+
+	// (POST /federation/{UUID}/invite)
+	PostFederationUUIDInvite(ctx context.Context, request PostFederationUUIDInviteRequestObject) (PostFederationUUIDInviteResponseObject, error)
+
+	// (DELETE /federation/{UUID}/invite/{entityUUID})
+	DeleteFederationUUIDInviteEntityUUID(ctx context.Context, request DeleteFederationUUIDInviteEntityUUIDRequestObject) (DeleteFederationUUIDInviteEntityUUIDResponseObject, error)
+
+	// (PATCH /federation/{UUID}/name)
+	PatchFederationUUIDName(ctx context.Context, request PatchFederationUUIDNameRequestObject) (PatchFederationUUIDNameResponseObject, error)
+
+	// (GET /federation/{UUID}/project)
+	GetFederationUUIDProject(ctx context.Context, request GetFederationUUIDProjectRequestObject) (GetFederationUUIDProjectResponseObject, error)
+
+	// (POST /federation/{UUID}/user)
+	PostFederationUUIDUser(ctx context.Context, request PostFederationUUIDUserRequestObject) (PostFederationUUIDUserResponseObject, error)
+
+	// (DELETE /federation/{UUID}/user/{userUUID})
+	DeleteFederationUUIDUserUserUUID(ctx context.Context, request DeleteFederationUUIDUserUserUUIDRequestObject) (DeleteFederationUUIDUserUserUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/webhook)
+	GetFederationUUIDWebhook(ctx context.Context, request GetFederationUUIDWebhookRequestObject) (GetFederationUUIDWebhookResponseObject, error)
+
+	// (POST /federation/{UUID}/webhook)
+	PostFederationUUIDWebhook(ctx context.Context, request PostFederationUUIDWebhookRequestObject) (PostFederationUUIDWebhookResponseObject, error)
+
+	// (DELETE /group/{UUID}/user)
+	DeleteGroupUUIDUser(ctx context.Context, request DeleteGroupUUIDUserRequestObject) (DeleteGroupUUIDUserResponseObject, error)
+
+	// (GET /group/{UUID}/user)
+	GetGroupUUIDUser(ctx context.Context, request GetGroupUUIDUserRequestObject) (GetGroupUUIDUserResponseObject, error)
+
+	// (POST /group/{UUID}/user)
+	PostGroupUUIDUser(ctx context.Context, request PostGroupUUIDUserRequestObject) (PostGroupUUIDUserResponseObject, error)
 
-type PatchFederationUUIDNameResponseObject interface {
-	VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error
-}
+	// (POST /permissions)
+	PostPermissions(ctx context.Context, request PostPermissionsRequestObject) (PostPermissionsResponseObject, error)
 
-type PatchFederationUUIDName200Response struct {
-}
+	// (GET /permissions/roles)
+	GetPermissionsRoles(ctx context.Context, request GetPermissionsRolesRequestObject) (GetPermissionsRolesResponseObject, error)
 
-func (response PatchFederationUUIDName200Response) VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// (DELETE /permissions/{UUID})
+	DeletePermissionsUUID(ctx context.Context, request DeletePermissionsUUIDRequestObject) (DeletePermissionsUUIDResponseObject, error)
 
-type GetFederationUUIDProjectRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetFederationUUIDProjectParams
-}
+	// (GET /permissions/{UUID})
+	GetPermissionsUUID(ctx context.Context, request GetPermissionsUUIDRequestObject) (GetPermissionsUUIDResponseObject, error)
 
-type GetFederationUUIDProjectResponseObject interface {
-	VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error
-}
+	// (GET /permissions/{UUID}/effective)
+	GetPermissionsUUIDEffective(ctx context.Context, request GetPermissionsUUIDEffectiveRequestObject) (GetPermissionsUUIDEffectiveResponseObject, error)
 
-type GetFederationUUIDProject200JSONResponse struct {
-	Count int          `json:"count"`
-	Items []ProjectDTO `json:"items"`
-}
+	// (GET /permissions/{UUID}/roles)
+	GetPermissionsUUIDRoles(ctx context.Context, request GetPermissionsUUIDRolesRequestObject) (GetPermissionsUUIDRolesResponseObject, error)
 
-func (response GetFederationUUIDProject200JSONResponse) VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (POST /permissions/{UUID}/roles)
+	PostPermissionsUUIDRoles(ctx context.Context, request PostPermissionsUUIDRolesRequestObject) (PostPermissionsUUIDRolesResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /permissions/{UUID}/roles/{entityUUID})
+	DeletePermissionsUUIDRolesEntityUUID(ctx context.Context, request DeletePermissionsUUIDRolesEntityUUIDRequestObject) (DeletePermissionsUUIDRolesEntityUUIDResponseObject, error)
 
-type PostFederationUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDUserJSONRequestBody
-}
+	// (POST /profile/survey)
+	PostProfileSurvey(ctx context.Context, request PostProfileSurveyRequestObject) (PostProfileSurveyResponseObject, error)
 
-type PostFederationUUIDUserResponseObject interface {
-	VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error
-}
+	// (DELETE /profile/survey/{UUID})
+	DeleteProfileSurveyUUID(ctx context.Context, request DeleteProfileSurveyUUIDRequestObject) (DeleteProfileSurveyUUIDResponseObject, error)
 
-type PostFederationUUIDUser200JSONResponse UUIDResponse
+	// (GET /profile/survey/{UUID})
+	GetProfileSurveyUUID(ctx context.Context, request GetProfileSurveyUUIDRequestObject) (GetProfileSurveyUUIDResponseObject, error)
 
-func (response PostFederationUUIDUser200JSONResponse) VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (POST /project)
+	PostProject(ctx context.Context, request PostProjectRequestObject) (PostProjectResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /project/{UUID})
+	DeleteProjectUUID(ctx context.Context, request DeleteProjectUUIDRequestObject) (DeleteProjectUUIDResponseObject, error)
 
-type DeleteFederationUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
-}
+	// (GET /project/{UUID})
+	GetProjectUUID(ctx context.Context, request GetProjectUUIDRequestObject) (GetProjectUUIDResponseObject, error)
 
-type DeleteFederationUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error
-}
+	// (PATCH /project/{UUID})
+	PatchProjectUUID(ctx context.Context, request PatchProjectUUIDRequestObject) (PatchProjectUUIDResponseObject, error)
 
-type DeleteFederationUUIDUserUserUUID200Response struct {
-}
+	// (GET /project/{UUID}/catalog)
+	GetProjectUUIDCatalog(ctx context.Context, request GetProjectUUIDCatalogRequestObject) (GetProjectUUIDCatalogResponseObject, error)
 
-func (response DeleteFederationUUIDUserUserUUID200Response) VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// (POST /project/{UUID}/catalog)
+	PostProjectUUIDCatalog(ctx context.Context, request PostProjectUUIDCatalogRequestObject) (PostProjectUUIDCatalogResponseObject, error)
 
-type DeleteGroupUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *DeleteGroupUUIDUserJSONRequestBody
-}
+	// (GET /project/{UUID}/catalog/{entityName})
+	GetProjectUUIDCatalogEntityName(ctx context.Context, request GetProjectUUIDCatalogEntityNameRequestObject) (GetProjectUUIDCatalogEntityNameResponseObject, error)
 
-type DeleteGroupUUIDUserResponseObject interface {
-	VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error
-}
+	// (DELETE /project/{UUID}/catalog/{entityUUID})
+	DeleteProjectUUIDCatalogEntityUUID(ctx context.Context, request DeleteProjectUUIDCatalogEntityUUIDRequestObject) (DeleteProjectUUIDCatalogEntityUUIDResponseObject, error)
 
-type DeleteGroupUUIDUser200Response struct {
-}
+	// (PATCH /project/{UUID}/description)
+	PatchProjectUUIDDescription(ctx context.Context, request PatchProjectUUIDDescriptionRequestObject) (PatchProjectUUIDDescriptionResponseObject, error)
 
-func (response DeleteGroupUUIDUser200Response) VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// (DELETE /project/{UUID}/field/{entityUUID})
+	DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request DeleteProjectUUIDFieldEntityUUIDRequestObject) (DeleteProjectUUIDFieldEntityUUIDResponseObject, error)
 
-type GetGroupUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// (POST /project/{UUID}/field/{entityUUID})
+	PostProjectUUIDFieldEntityUUID(ctx context.Context, request PostProjectUUIDFieldEntityUUIDRequestObject) (PostProjectUUIDFieldEntityUUIDResponseObject, error)
 
-type GetGroupUUIDUserResponseObject interface {
-	VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error
-}
+	// (PATCH /project/{UUID}/graph)
+	PatchProjectUUIDGraph(ctx context.Context, request PatchProjectUUIDGraphRequestObject) (PatchProjectUUIDGraphResponseObject, error)
 
-type GetGroupUUIDUser200JSONResponse struct {
-	Count int       `json:"count"`
-	Items []UserDTO `json:"items"`
-}
+	// (PATCH /project/{UUID}/name)
+	PatchProjectUUIDName(ctx context.Context, request PatchProjectUUIDNameRequestObject) (PatchProjectUUIDNameResponseObject, error)
 
-func (response GetGroupUUIDUser200JSONResponse) VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (PATCH /project/{UUID}/options)
+	PatchProjectUUIDOptions(ctx context.Context, request PatchProjectUUIDOptionsRequestObject) (PatchProjectUUIDOptionsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /project/{UUID}/recurrence)
+	GetProjectUUIDRecurrence(ctx context.Context, request GetProjectUUIDRecurrenceRequestObject) (GetProjectUUIDRecurrenceResponseObject, error)
 
-type PostGroupUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostGroupUUIDUserJSONRequestBody
-}
+	// (GET /project/{UUID}/status)
+	GetProjectUUIDStatus(ctx context.Context, request GetProjectUUIDStatusRequestObject) (GetProjectUUIDStatusResponseObject, error)
 
-type PostGroupUUIDUserResponseObject interface {
-	VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error
-}
+	// (POST /project/{UUID}/status)
+	PostProjectUUIDStatus(ctx context.Context, request PostProjectUUIDStatusRequestObject) (PostProjectUUIDStatusResponseObject, error)
 
-type PostGroupUUIDUser200Response struct {
-}
+	// (DELETE /project/{UUID}/status/{entityUUID})
+	DeleteProjectUUIDStatusEntityUUID(ctx context.Context, request DeleteProjectUUIDStatusEntityUUIDRequestObject) (DeleteProjectUUIDStatusEntityUUIDResponseObject, error)
 
-func (response PostGroupUUIDUser200Response) VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// (PATCH /project/{UUID}/status/{entityUUID})
+	PatchProjectUUIDStatusEntityUUID(ctx context.Context, request PatchProjectUUIDStatusEntityUUIDRequestObject) (PatchProjectUUIDStatusEntityUUIDResponseObject, error)
 
-type PostPermissionsRequestObject struct {
-	Body *PostPermissionsJSONRequestBody
-}
+	// (POST /project/{UUID}/task/import)
+	PostProjectUUIDTaskImport(ctx context.Context, request PostProjectUUIDTaskImportRequestObject) (PostProjectUUIDTaskImportResponseObject, error)
 
-type PostPermissionsResponseObject interface {
-	VisitPostPermissionsResponse(w http.ResponseWriter) error
-}
+	// (GET /project/{UUID}/template)
+	GetProjectUUIDTemplate(ctx context.Context, request GetProjectUUIDTemplateRequestObject) (GetProjectUUIDTemplateResponseObject, error)
 
-type PostPermissions200JSONResponse struct {
-	UpdatedAt time.Time          `json:"updated_at"`
-	UserUuid  openapi_types.UUID `json:"user_uuid"`
-}
+	// (POST /project/{UUID}/template)
+	PostProjectUUIDTemplate(ctx context.Context, request PostProjectUUIDTemplateRequestObject) (PostProjectUUIDTemplateResponseObject, error)
 
-func (response PostPermissions200JSONResponse) VisitPostPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (POST /project/{UUID}/user)
+	PostProjectUUIDUser(ctx context.Context, request PostProjectUUIDUserRequestObject) (PostProjectUUIDUserResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /project/{UUID}/user/{userUUID})
+	DeleteProjectUUIDUserUserUUID(ctx context.Context, request DeleteProjectUUIDUserUserUUIDRequestObject) (DeleteProjectUUIDUserUserUUIDResponseObject, error)
 
-type DeletePermissionsUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// (GET /project/{UUID}/view)
+	GetProjectUUIDView(ctx context.Context, request GetProjectUUIDViewRequestObject) (GetProjectUUIDViewResponseObject, error)
 
-type DeletePermissionsUUIDResponseObject interface {
-	VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error
-}
+	// (POST /project/{UUID}/view)
+	PostProjectUUIDView(ctx context.Context, request PostProjectUUIDViewRequestObject) (PostProjectUUIDViewResponseObject, error)
 
-type DeletePermissionsUUID200Response struct {
-}
+	// (DELETE /project/{UUID}/view/{entityUUID})
+	DeleteProjectUUIDViewEntityUUID(ctx context.Context, request DeleteProjectUUIDViewEntityUUIDRequestObject) (DeleteProjectUUIDViewEntityUUIDResponseObject, error)
 
-func (response DeletePermissionsUUID200Response) VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// (PUT /project/{UUID}/view/{entityUUID})
+	PutProjectUUIDViewEntityUUID(ctx context.Context, request PutProjectUUIDViewEntityUUIDRequestObject) (PutProjectUUIDViewEntityUUIDResponseObject, error)
 
-type GetPermissionsUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// (GET /project/{UUID}/worklog)
+	GetProjectUUIDWorklog(ctx context.Context, request GetProjectUUIDWorklogRequestObject) (GetProjectUUIDWorklogResponseObject, error)
 
-type GetPermissionsUUIDResponseObject interface {
-	VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error
-}
+	// (DELETE /recurrence/{UUID})
+	DeleteRecurrenceUUID(ctx context.Context, request DeleteRecurrenceUUIDRequestObject) (DeleteRecurrenceUUIDResponseObject, error)
 
-type GetPermissionsUUID200JSONResponse struct {
-	CreatedAt      time.Time          `json:"created_at"`
-	FederationUuid openapi_types.UUID `json:"federation_uuid"`
-	Rules          PermissionRulesDTO `json:"rules"`
-	UpdatedAt      time.Time          `json:"updated_at"`
-	UserUuid       openapi_types.UUID `json:"user_uuid"`
-}
+	// (GET /recurrence/{UUID})
+	GetRecurrenceUUID(ctx context.Context, request GetRecurrenceUUIDRequestObject) (GetRecurrenceUUIDResponseObject, error)
 
-func (response GetPermissionsUUID200JSONResponse) VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (PUT /recurrence/{UUID})
+	PutRecurrenceUUID(ctx context.Context, request PutRecurrenceUUIDRequestObject) (PutRecurrenceUUIDResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /search)
+	GetSearch(ctx context.Context, request GetSearchRequestObject) (GetSearchResponseObject, error)
 
-type PostProfileSurveyRequestObject struct {
-	Body *PostProfileSurveyJSONRequestBody
-}
+	// (GET /tag)
+	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
 
-type PostProfileSurveyResponseObject interface {
-	VisitPostProfileSurveyResponse(w http.ResponseWriter) error
-}
+	// (POST /tag)
+	PostTag(ctx context.Context, request PostTagRequestObject) (PostTagResponseObject, error)
 
-type PostProfileSurvey200JSONResponse UUIDResponse
+	// (DELETE /tag/{UUID})
+	DeleteTagUUID(ctx context.Context, request DeleteTagUUIDRequestObject) (DeleteTagUUIDResponseObject, error)
 
-func (response PostProfileSurvey200JSONResponse) VisitPostProfileSurveyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (PATCH /tag/{UUID})
+	PatchTagUUID(ctx context.Context, request PatchTagUUIDRequestObject) (PatchTagUUIDResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /template/{UUID})
+	DeleteTemplateUUID(ctx context.Context, request DeleteTemplateUUIDRequestObject) (DeleteTemplateUUIDResponseObject, error)
 
-type DeleteProfileSurveyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// (GET /template/{UUID})
+	GetTemplateUUID(ctx context.Context, request GetTemplateUUIDRequestObject) (GetTemplateUUIDResponseObject, error)
 
-type DeleteProfileSurveyUUIDResponseObject interface {
-	VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error
-}
+	// (PUT /template/{UUID})
+	PutTemplateUUID(ctx context.Context, request PutTemplateUUIDRequestObject) (PutTemplateUUIDResponseObject, error)
 
-type DeleteProfileSurveyUUID200Response struct {
-}
+	// (POST /template/{UUID}/recurrence)
+	PostTemplateUUIDRecurrence(ctx context.Context, request PostTemplateUUIDRecurrenceRequestObject) (PostTemplateUUIDRecurrenceResponseObject, error)
 
-func (response DeleteProfileSurveyUUID200Response) VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// (POST /template/{UUID}/task)
+	PostTemplateUUIDTask(ctx context.Context, request PostTemplateUUIDTaskRequestObject) (PostTemplateUUIDTaskResponseObject, error)
 
-type GetProfileSurveyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// (GET /timesheet)
+	GetTimesheet(ctx context.Context, request GetTimesheetRequestObject) (GetTimesheetResponseObject, error)
 
-type GetProfileSurveyUUIDResponseObject interface {
-	VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error
-}
+	// (GET /user)
+	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
 
-type GetProfileSurveyUUID200JSONResponse SurveyDTO
+	// (DELETE /webhook/{UUID})
+	DeleteWebhookUUID(ctx context.Context, request DeleteWebhookUUIDRequestObject) (DeleteWebhookUUIDResponseObject, error)
 
-func (response GetProfileSurveyUUID200JSONResponse) VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (GET /webhook/{UUID})
+	GetWebhookUUID(ctx context.Context, request GetWebhookUUIDRequestObject) (GetWebhookUUIDResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (PUT /webhook/{UUID})
+	PutWebhookUUID(ctx context.Context, request PutWebhookUUIDRequestObject) (PutWebhookUUIDResponseObject, error)
 
-type PostProjectRequestObject struct {
-	Body *PostProjectJSONRequestBody
-}
+	// (GET /webhook/{UUID}/delivery)
+	GetWebhookUUIDDelivery(ctx context.Context, request GetWebhookUUIDDeliveryRequestObject) (GetWebhookUUIDDeliveryResponseObject, error)
 
-type PostProjectResponseObject interface {
-	VisitPostProjectResponse(w http.ResponseWriter) error
+	// (POST /webhook/{UUID}/delivery/{entityUUID}/redeliver)
+	PostWebhookUUIDDeliveryEntityUUIDRedeliver(ctx context.Context, request PostWebhookUUIDDeliveryEntityUUIDRedeliverRequestObject) (PostWebhookUUIDDeliveryEntityUUIDRedeliverResponseObject, error)
 }
 
-type PostProject200JSONResponse UUIDResponse
-
-func (response PostProject200JSONResponse) VisitPostProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
 
-	return json.NewEncoder(w).Encode(response)
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
 }
 
-type DeleteProjectUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
 }
 
-type DeleteProjectUUIDResponseObject interface {
-	VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error
-}
+// PostCompany operation middleware
+func (sh *strictHandler) PostCompany(ctx echo.Context) error {
+	var request PostCompanyRequestObject
 
-type DeleteProjectUUID200Response struct {
-}
+	var body PostCompanyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response DeleteProjectUUID200Response) VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompany(ctx.Request().Context(), request.(PostCompanyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompany")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyResponseObject); ok {
+		return validResponse.VisitPostCompanyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetProjectUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// DeleteCompanyUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteCompanyUUIDRequestObject
 
-type GetProjectUUIDResponseObject interface {
-	VisitGetProjectUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type GetProjectUUID200JSONResponse ProjectDTO
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUID")
+	}
 
-func (response GetProjectUUID200JSONResponse) VisitGetProjectUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchProjectUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDJSONRequestBody
-}
+// GetCompanyUUID operation middleware
+func (sh *strictHandler) GetCompanyUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDRequestObject
 
-type PatchProjectUUIDResponseObject interface {
-	VisitPatchProjectUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type PatchProjectUUID200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUID(ctx.Request().Context(), request.(GetCompanyUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUID")
+	}
 
-func (response PatchProjectUUID200Response) VisitPatchProjectUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetProjectUUIDCatalogRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// GetCompanyUUIDFields operation middleware
+func (sh *strictHandler) GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDFieldsRequestObject
 
-type GetProjectUUIDCatalogResponseObject interface {
-	VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type GetProjectUUIDCatalog200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDFields(ctx.Request().Context(), request.(GetCompanyUUIDFieldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDFields")
+	}
 
-func (response GetProjectUUIDCatalog200JSONResponse) VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDFieldsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDFieldsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostProjectUUIDCatalogRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDCatalogJSONRequestBody
-}
+// PostCompanyUUIDFields operation middleware
+func (sh *strictHandler) PostCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDFieldsRequestObject
 
-type PostProjectUUIDCatalogResponseObject interface {
-	VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type PostProjectUUIDCatalog200JSONResponse struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-}
+	var body PostCompanyUUIDFieldsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response PostProjectUUIDCatalog200JSONResponse) VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDFields(ctx.Request().Context(), request.(PostCompanyUUIDFieldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDFields")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type GetProjectUUIDCatalogEntityNameRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityName EntityName `json:"entityName"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDFieldsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDFieldsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetProjectUUIDCatalogEntityNameResponseObject interface {
-	VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error
-}
+// DeleteCompanyUUIDFieldsEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDFieldsEntityUUIDRequestObject
 
-type GetProjectUUIDCatalogEntityName200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response GetProjectUUIDCatalogEntityName200JSONResponse) VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDFieldsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDFieldsEntityUUID")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type DeleteProjectUUIDCatalogEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDFieldsEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteProjectUUIDCatalogEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error
-}
+// PutCompanyUUIDFieldsEntityUUID operation middleware
+func (sh *strictHandler) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutCompanyUUIDFieldsEntityUUIDRequestObject
 
-type DeleteProjectUUIDCatalogEntityUUID200Response struct {
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response DeleteProjectUUIDCatalogEntityUUID200Response) VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	var body PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDFieldsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCompanyUUIDFieldsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCompanyUUIDFieldsEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchProjectUUIDDescriptionRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDDescriptionJSONRequestBody
-}
+// GetCompanyUUIDGroup operation middleware
+func (sh *strictHandler) GetCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDGroupRequestObject
 
-type PatchProjectUUIDDescriptionResponseObject interface {
-	VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDGroup(ctx.Request().Context(), request.(GetCompanyUUIDGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDGroup")
+	}
 
-type PatchProjectUUIDDescription200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PatchProjectUUIDDescription200Response) VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDGroupResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type DeleteProjectUUIDFieldEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+// PostCompanyUUIDGroup operation middleware
+func (sh *strictHandler) PostCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDGroupRequestObject
 
-type DeleteProjectUUIDFieldEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type DeleteProjectUUIDFieldEntityUUID200Response struct {
-}
+	var body PostCompanyUUIDGroupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response DeleteProjectUUIDFieldEntityUUID200Response) VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDGroup(ctx.Request().Context(), request.(PostCompanyUUIDGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDGroupResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PostProjectUUIDFieldEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PostProjectUUIDFieldEntityUUIDJSONRequestBody
-}
+// DeleteCompanyUUIDGroupEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDGroupEntityUUIDRequestObject
 
-type PostProjectUUIDFieldEntityUUIDResponseObject interface {
-	VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type PostProjectUUIDFieldEntityUUID200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDGroupEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDGroupEntityUUID")
+	}
 
-func (response PostProjectUUIDFieldEntityUUID200Response) VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	response, err := handler(ctx, request)
 
-type PatchProjectUUIDGraphRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDGraphJSONRequestBody
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDGroupEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchProjectUUIDGraphResponseObject interface {
-	VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error
-}
+// PatchCompanyUUIDGroupEntityUUID operation middleware
+func (sh *strictHandler) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchCompanyUUIDGroupEntityUUIDRequestObject
 
-type PatchProjectUUIDGraph200JSONResponse map[string]interface{}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response PatchProjectUUIDGraph200JSONResponse) VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDGroupEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDGroupEntityUUID")
+	}
 
-type PatchProjectUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDNameJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PatchProjectUUIDNameResponseObject interface {
-	VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDGroupEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchProjectUUIDName200Response struct {
-}
+// PatchCompanyUUIDName operation middleware
+func (sh *strictHandler) PatchCompanyUUIDName(ctx echo.Context, uUID Uuid) error {
+	var request PatchCompanyUUIDNameRequestObject
 
-func (response PatchProjectUUIDName200Response) VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.UUID = uUID
 
-type PatchProjectUUIDOptionsRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDOptionsJSONRequestBody
-}
+	var body PatchCompanyUUIDNameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchProjectUUIDOptionsResponseObject interface {
-	VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDName(ctx.Request().Context(), request.(PatchCompanyUUIDNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDName")
+	}
 
-type PatchProjectUUIDOptions200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PatchProjectUUIDOptions200Response) VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDNameResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetProjectUUIDStatusRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// GetCompanyUUIDPriorities operation middleware
+func (sh *strictHandler) GetCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDPrioritiesRequestObject
 
-type GetProjectUUIDStatusResponseObject interface {
-	VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type GetProjectUUIDStatus200JSONResponse struct {
-	Count int                `json:"count"`
-	Items []ProjectStatusDTO `json:"items"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDPriorities(ctx.Request().Context(), request.(GetCompanyUUIDPrioritiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDPriorities")
+	}
 
-func (response GetProjectUUIDStatus200JSONResponse) VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDPrioritiesResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostProjectUUIDStatusRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDStatusJSONRequestBody
-}
+// PostCompanyUUIDPriorities operation middleware
+func (sh *strictHandler) PostCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDPrioritiesRequestObject
 
-type PostProjectUUIDStatusResponseObject interface {
-	VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type PostProjectUUIDStatus200JSONResponse UUIDResponse
+	var body PostCompanyUUIDPrioritiesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response PostProjectUUIDStatus200JSONResponse) VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDPriorities(ctx.Request().Context(), request.(PostCompanyUUIDPrioritiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDPriorities")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type DeleteProjectUUIDStatusEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDPrioritiesResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteProjectUUIDStatusEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
-}
+// DeleteCompanyUUIDPrioritiesEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDPrioritiesEntityUUID")
+	}
 
-type DeleteProjectUUIDStatusEntityUUID200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response DeleteProjectUUIDStatusEntityUUID200Response) VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchProjectUUIDStatusEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchProjectUUIDStatusEntityUUIDJSONRequestBody
-}
+// PatchCompanyUUIDPrioritiesEntityUUID operation middleware
+func (sh *strictHandler) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject
 
-type PatchProjectUUIDStatusEntityUUIDResponseObject interface {
-	VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type PatchProjectUUIDStatusEntityUUID200Response struct {
-}
+	var body PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response PatchProjectUUIDStatusEntityUUID200Response) VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDPrioritiesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDPrioritiesEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PostProjectUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDUserJSONRequestBody
-}
+// GetCompanyUUIDProjectCatalogEntityName operation middleware
+func (sh *strictHandler) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
+	var request GetCompanyUUIDProjectCatalogEntityNameRequestObject
 
-type PostProjectUUIDUserResponseObject interface {
-	VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityName = entityName
 
-type PostProjectUUIDUser200JSONResponse UUIDResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDProjectCatalogEntityName(ctx.Request().Context(), request.(GetCompanyUUIDProjectCatalogEntityNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDProjectCatalogEntityName")
+	}
 
-func (response PostProjectUUIDUser200JSONResponse) VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDProjectCatalogEntityNameResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDProjectCatalogEntityNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteProjectUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
-}
+// GetCompanyUUIDSms operation middleware
+func (sh *strictHandler) GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error {
+	var request GetCompanyUUIDSmsRequestObject
 
-type DeleteProjectUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.Params = params
 
-type DeleteProjectUUIDUserUserUUID200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDSms(ctx.Request().Context(), request.(GetCompanyUUIDSmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDSms")
+	}
 
-func (response DeleteProjectUUIDUserUserUUID200Response) VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDSmsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDSmsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetTagRequestObject struct {
-	Params GetTagParams
-}
+// PostCompanyUUIDSmsCost operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsCost(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDSmsCostRequestObject
 
-type GetTagResponseObject interface {
-	VisitGetTagResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type GetTag200JSONResponse struct {
-	Count int      `json:"count"`
-	Items []TagDTO `json:"items"`
-}
+	var body PostCompanyUUIDSmsCostJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response GetTag200JSONResponse) VisitGetTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsCost(ctx.Request().Context(), request.(PostCompanyUUIDSmsCostRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsCost")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type PostTagRequestObject struct {
-	Body *PostTagJSONRequestBody
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsCostResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsCostResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostTagResponseObject interface {
-	VisitPostTagResponse(w http.ResponseWriter) error
-}
+// PostCompanyUUIDSmsOptions operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsOptions(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDSmsOptionsRequestObject
 
-type PostTag200JSONResponse UUIDResponse
+	request.UUID = uUID
 
-func (response PostTag200JSONResponse) VisitPostTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body PostCompanyUUIDSmsOptionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsOptions(ctx.Request().Context(), request.(PostCompanyUUIDSmsOptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsOptions")
+	}
 
-type DeleteTagUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	response, err := handler(ctx, request)
 
-type DeleteTagUUIDResponseObject interface {
-	VisitDeleteTagUUIDResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsOptionsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsOptionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteTagUUID200Response struct {
-}
+// PostCompanyUUIDSmsSend operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsSend(ctx echo.Context, uUID Uuid, params PostCompanyUUIDSmsSendParams) error {
+	var request PostCompanyUUIDSmsSendRequestObject
 
-func (response DeleteTagUUID200Response) VisitDeleteTagUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	request.UUID = uUID
+	request.Params = params
+
+	var body PostCompanyUUIDSmsSendJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsSend(ctx.Request().Context(), request.(PostCompanyUUIDSmsSendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsSend")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsSendResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsSendResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchTagUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchTagUUIDJSONRequestBody
-}
+// GetCompanyUUIDTemplate operation middleware
+func (sh *strictHandler) GetCompanyUUIDTemplate(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDTemplateRequestObject
 
-type PatchTagUUIDResponseObject interface {
-	VisitPatchTagUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type PatchTagUUID200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDTemplate(ctx.Request().Context(), request.(GetCompanyUUIDTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDTemplate")
+	}
 
-func (response PatchTagUUID200Response) VisitPatchTagUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDTemplateResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDTemplateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetUserRequestObject struct {
-	Body *GetUserJSONRequestBody
-}
+// PostCompanyUUIDTemplate operation middleware
+func (sh *strictHandler) PostCompanyUUIDTemplate(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDTemplateRequestObject
 
-type GetUserResponseObject interface {
-	VisitGetUserResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+
+	var body PostCompanyUUIDTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type GetUser200JSONResponse struct {
-	Count int       `json:"count"`
-	Items []UserDTO `json:"items"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDTemplate(ctx.Request().Context(), request.(PostCompanyUUIDTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDTemplate")
+	}
 
-func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDTemplateResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDTemplateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// PostCompanyUUIDUser operation middleware
+func (sh *strictHandler) PostCompanyUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDUserRequestObject
 
-	// (POST /company)
-	PostCompany(ctx context.Context, request PostCompanyRequestObject) (PostCompanyResponseObject, error)
+	request.UUID = uUID
 
-	// (DELETE /company/{UUID})
-	DeleteCompanyUUID(ctx context.Context, request DeleteCompanyUUIDRequestObject) (DeleteCompanyUUIDResponseObject, error)
+	var body PostCompanyUUIDUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (GET /company/{UUID})
-	GetCompanyUUID(ctx context.Context, request GetCompanyUUIDRequestObject) (GetCompanyUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDUser(ctx.Request().Context(), request.(PostCompanyUUIDUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDUser")
+	}
 
-	// (GET /company/{UUID}/fields)
-	GetCompanyUUIDFields(ctx context.Context, request GetCompanyUUIDFieldsRequestObject) (GetCompanyUUIDFieldsResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /company/{UUID}/fields)
-	PostCompanyUUIDFields(ctx context.Context, request PostCompanyUUIDFieldsRequestObject) (PostCompanyUUIDFieldsResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDUserResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /company/{UUID}/fields/{entityUUID})
-	DeleteCompanyUUIDFieldsEntityUUID(ctx context.Context, request DeleteCompanyUUIDFieldsEntityUUIDRequestObject) (DeleteCompanyUUIDFieldsEntityUUIDResponseObject, error)
+// DeleteCompanyUUIDUserUserUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
+	var request DeleteCompanyUUIDUserUserUUIDRequestObject
 
-	// (PUT /company/{UUID}/fields/{entityUUID})
-	PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request PutCompanyUUIDFieldsEntityUUIDRequestObject) (PutCompanyUUIDFieldsEntityUUIDResponseObject, error)
+	request.UUID = uUID
+	request.UserUUID = userUUID
 
-	// (GET /company/{UUID}/group)
-	GetCompanyUUIDGroup(ctx context.Context, request GetCompanyUUIDGroupRequestObject) (GetCompanyUUIDGroupResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDUserUserUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDUserUserUUID")
+	}
 
-	// (POST /company/{UUID}/group)
-	PostCompanyUUIDGroup(ctx context.Context, request PostCompanyUUIDGroupRequestObject) (PostCompanyUUIDGroupResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (DELETE /company/{UUID}/group/{entityUUID})
-	DeleteCompanyUUIDGroupEntityUUID(ctx context.Context, request DeleteCompanyUUIDGroupEntityUUIDRequestObject) (DeleteCompanyUUIDGroupEntityUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDUserUserUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDUserUserUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (PATCH /company/{UUID}/group/{entityUUID})
-	PatchCompanyUUIDGroupEntityUUID(ctx context.Context, request PatchCompanyUUIDGroupEntityUUIDRequestObject) (PatchCompanyUUIDGroupEntityUUIDResponseObject, error)
+// GetCompanyUUIDWebhook operation middleware
+func (sh *strictHandler) GetCompanyUUIDWebhook(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDWebhookRequestObject
 
-	// (PATCH /company/{UUID}/name)
-	PatchCompanyUUIDName(ctx context.Context, request PatchCompanyUUIDNameRequestObject) (PatchCompanyUUIDNameResponseObject, error)
+	request.UUID = uUID
 
-	// (GET /company/{UUID}/priorities)
-	GetCompanyUUIDPriorities(ctx context.Context, request GetCompanyUUIDPrioritiesRequestObject) (GetCompanyUUIDPrioritiesResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDWebhook(ctx.Request().Context(), request.(GetCompanyUUIDWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDWebhook")
+	}
 
-	// (POST /company/{UUID}/priorities)
-	PostCompanyUUIDPriorities(ctx context.Context, request PostCompanyUUIDPrioritiesRequestObject) (PostCompanyUUIDPrioritiesResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (DELETE /company/{UUID}/priorities/{entityUUID})
-	DeleteCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject) (DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDWebhookResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDWebhookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (PATCH /company/{UUID}/priorities/{entityUUID})
-	PatchCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject) (PatchCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+// PostCompanyUUIDWebhook operation middleware
+func (sh *strictHandler) PostCompanyUUIDWebhook(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDWebhookRequestObject
 
-	// (GET /company/{UUID}/project/catalog/{entityName})
-	GetCompanyUUIDProjectCatalogEntityName(ctx context.Context, request GetCompanyUUIDProjectCatalogEntityNameRequestObject) (GetCompanyUUIDProjectCatalogEntityNameResponseObject, error)
+	request.UUID = uUID
 
-	// (GET /company/{UUID}/sms)
-	GetCompanyUUIDSms(ctx context.Context, request GetCompanyUUIDSmsRequestObject) (GetCompanyUUIDSmsResponseObject, error)
+	var body PostCompanyUUIDWebhookJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (POST /company/{UUID}/sms/cost)
-	PostCompanyUUIDSmsCost(ctx context.Context, request PostCompanyUUIDSmsCostRequestObject) (PostCompanyUUIDSmsCostResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDWebhook(ctx.Request().Context(), request.(PostCompanyUUIDWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDWebhook")
+	}
 
-	// (POST /company/{UUID}/sms/options)
-	PostCompanyUUIDSmsOptions(ctx context.Context, request PostCompanyUUIDSmsOptionsRequestObject) (PostCompanyUUIDSmsOptionsResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /company/{UUID}/sms/send)
-	PostCompanyUUIDSmsSend(ctx context.Context, request PostCompanyUUIDSmsSendRequestObject) (PostCompanyUUIDSmsSendResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDWebhookResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDWebhookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /company/{UUID}/user)
-	PostCompanyUUIDUser(ctx context.Context, request PostCompanyUUIDUserRequestObject) (PostCompanyUUIDUserResponseObject, error)
+// PostFederation operation middleware
+func (sh *strictHandler) PostFederation(ctx echo.Context) error {
+	var request PostFederationRequestObject
 
-	// (DELETE /company/{UUID}/user/{userUUID})
-	DeleteCompanyUUIDUserUserUUID(ctx context.Context, request DeleteCompanyUUIDUserUserUUIDRequestObject) (DeleteCompanyUUIDUserUserUUIDResponseObject, error)
+	var body PostFederationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (POST /federation)
-	PostFederation(ctx context.Context, request PostFederationRequestObject) (PostFederationResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostFederation(ctx.Request().Context(), request.(PostFederationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostFederation")
+	}
 
-	// (DELETE /federation/{UUID})
-	DeleteFederationUUID(ctx context.Context, request DeleteFederationUUIDRequestObject) (DeleteFederationUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /federation/{UUID})
-	GetFederationUUID(ctx context.Context, request GetFederationUUIDRequestObject) (GetFederationUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostFederationResponseObject); ok {
+		return validResponse.VisitPostFederationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (GET /federation/{UUID}/agent)
-	GetFederationUUIDAgent(ctx context.Context, request GetFederationUUIDAgentRequestObject) (GetFederationUUIDAgentResponseObject, error)
+// DeleteFederationUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteFederationUUIDRequestObject
 
-	// (POST /federation/{UUID}/agent)
-	PostFederationUUIDAgent(ctx context.Context, request PostFederationUUIDAgentRequestObject) (PostFederationUUIDAgentResponseObject, error)
+	request.UUID = uUID
 
-	// (DELETE /federation/{UUID}/agent/{entityUUID})
-	DeleteFederationUUIDAgentEntityUUID(ctx context.Context, request DeleteFederationUUIDAgentEntityUUIDRequestObject) (DeleteFederationUUIDAgentEntityUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFederationUUID(ctx.Request().Context(), request.(DeleteFederationUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFederationUUID")
+	}
 
-	// (PATCH /federation/{UUID}/agent/{entityUUID})
-	PatchFederationUUIDAgentEntityUUID(ctx context.Context, request PatchFederationUUIDAgentEntityUUIDRequestObject) (PatchFederationUUIDAgentEntityUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /federation/{UUID}/invite)
-	GetFederationUUIDInvite(ctx context.Context, request GetFederationUUIDInviteRequestObject) (GetFederationUUIDInviteResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteFederationUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /federation/{UUID}/invite)
-	PostFederationUUIDInvite(ctx context.Context, request PostFederationUUIDInviteRequestObject) (PostFederationUUIDInviteResponseObject, error)
+// GetFederationUUID operation middleware
+func (sh *strictHandler) GetFederationUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDRequestObject
 
-	// (DELETE /federation/{UUID}/invite/{entityUUID})
-	DeleteFederationUUIDInviteEntityUUID(ctx context.Context, request DeleteFederationUUIDInviteEntityUUIDRequestObject) (DeleteFederationUUIDInviteEntityUUIDResponseObject, error)
+	request.UUID = uUID
 
-	// (PATCH /federation/{UUID}/name)
-	PatchFederationUUIDName(ctx context.Context, request PatchFederationUUIDNameRequestObject) (PatchFederationUUIDNameResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFederationUUID(ctx.Request().Context(), request.(GetFederationUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFederationUUID")
+	}
 
-	// (GET /federation/{UUID}/project)
-	GetFederationUUIDProject(ctx context.Context, request GetFederationUUIDProjectRequestObject) (GetFederationUUIDProjectResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /federation/{UUID}/user)
-	PostFederationUUIDUser(ctx context.Context, request PostFederationUUIDUserRequestObject) (PostFederationUUIDUserResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFederationUUIDResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /federation/{UUID}/user/{userUUID})
-	DeleteFederationUUIDUserUserUUID(ctx context.Context, request DeleteFederationUUIDUserUserUUIDRequestObject) (DeleteFederationUUIDUserUserUUIDResponseObject, error)
+// GetFederationUUIDAgent operation middleware
+func (sh *strictHandler) GetFederationUUIDAgent(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentParams) error {
+	var request GetFederationUUIDAgentRequestObject
 
-	// (DELETE /group/{UUID}/user)
-	DeleteGroupUUIDUser(ctx context.Context, request DeleteGroupUUIDUserRequestObject) (DeleteGroupUUIDUserResponseObject, error)
+	request.UUID = uUID
+	request.Params = params
 
-	// (GET /group/{UUID}/user)
-	GetGroupUUIDUser(ctx context.Context, request GetGroupUUIDUserRequestObject) (GetGroupUUIDUserResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFederationUUIDAgent(ctx.Request().Context(), request.(GetFederationUUIDAgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFederationUUIDAgent")
+	}
+
+	response, err := handler(ctx, request)
 
-	// (POST /group/{UUID}/user)
-	PostGroupUUIDUser(ctx context.Context, request PostGroupUUIDUserRequestObject) (PostGroupUUIDUserResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFederationUUIDAgentResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /permissions)
-	PostPermissions(ctx context.Context, request PostPermissionsRequestObject) (PostPermissionsResponseObject, error)
+// PostFederationUUIDAgent operation middleware
+func (sh *strictHandler) PostFederationUUIDAgent(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDAgentRequestObject
 
-	// (DELETE /permissions/{UUID})
-	DeletePermissionsUUID(ctx context.Context, request DeletePermissionsUUIDRequestObject) (DeletePermissionsUUIDResponseObject, error)
+	request.UUID = uUID
 
-	// (GET /permissions/{UUID})
-	GetPermissionsUUID(ctx context.Context, request GetPermissionsUUIDRequestObject) (GetPermissionsUUIDResponseObject, error)
+	var body PostFederationUUIDAgentJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (POST /profile/survey)
-	PostProfileSurvey(ctx context.Context, request PostProfileSurveyRequestObject) (PostProfileSurveyResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostFederationUUIDAgent(ctx.Request().Context(), request.(PostFederationUUIDAgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostFederationUUIDAgent")
+	}
 
-	// (DELETE /profile/survey/{UUID})
-	DeleteProfileSurveyUUID(ctx context.Context, request DeleteProfileSurveyUUIDRequestObject) (DeleteProfileSurveyUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /profile/survey/{UUID})
-	GetProfileSurveyUUID(ctx context.Context, request GetProfileSurveyUUIDRequestObject) (GetProfileSurveyUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostFederationUUIDAgentResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDAgentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /project)
-	PostProject(ctx context.Context, request PostProjectRequestObject) (PostProjectResponseObject, error)
+// GetFederationUUIDAgentDuplicates operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentDuplicates(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDAgentDuplicatesRequestObject
 
-	// (DELETE /project/{UUID})
-	DeleteProjectUUID(ctx context.Context, request DeleteProjectUUIDRequestObject) (DeleteProjectUUIDResponseObject, error)
+	request.UUID = uUID
 
-	// (GET /project/{UUID})
-	GetProjectUUID(ctx context.Context, request GetProjectUUIDRequestObject) (GetProjectUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFederationUUIDAgentDuplicates(ctx.Request().Context(), request.(GetFederationUUIDAgentDuplicatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFederationUUIDAgentDuplicates")
+	}
 
-	// (PATCH /project/{UUID})
-	PatchProjectUUID(ctx context.Context, request PatchProjectUUIDRequestObject) (PatchProjectUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /project/{UUID}/catalog)
-	GetProjectUUIDCatalog(ctx context.Context, request GetProjectUUIDCatalogRequestObject) (GetProjectUUIDCatalogResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFederationUUIDAgentDuplicatesResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentDuplicatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /project/{UUID}/catalog)
-	PostProjectUUIDCatalog(ctx context.Context, request PostProjectUUIDCatalogRequestObject) (PostProjectUUIDCatalogResponseObject, error)
+// GetFederationUUIDAgentExport operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentExport(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentExportParams) error {
+	var request GetFederationUUIDAgentExportRequestObject
 
-	// (GET /project/{UUID}/catalog/{entityName})
-	GetProjectUUIDCatalogEntityName(ctx context.Context, request GetProjectUUIDCatalogEntityNameRequestObject) (GetProjectUUIDCatalogEntityNameResponseObject, error)
+	request.UUID = uUID
+	request.Params = params
 
-	// (DELETE /project/{UUID}/catalog/{entityUUID})
-	DeleteProjectUUIDCatalogEntityUUID(ctx context.Context, request DeleteProjectUUIDCatalogEntityUUIDRequestObject) (DeleteProjectUUIDCatalogEntityUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFederationUUIDAgentExport(ctx.Request().Context(), request.(GetFederationUUIDAgentExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFederationUUIDAgentExport")
+	}
 
-	// (PATCH /project/{UUID}/description)
-	PatchProjectUUIDDescription(ctx context.Context, request PatchProjectUUIDDescriptionRequestObject) (PatchProjectUUIDDescriptionResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (DELETE /project/{UUID}/field/{entityUUID})
-	DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request DeleteProjectUUIDFieldEntityUUIDRequestObject) (DeleteProjectUUIDFieldEntityUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFederationUUIDAgentExportResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /project/{UUID}/field/{entityUUID})
-	PostProjectUUIDFieldEntityUUID(ctx context.Context, request PostProjectUUIDFieldEntityUUIDRequestObject) (PostProjectUUIDFieldEntityUUIDResponseObject, error)
+// PostFederationUUIDAgentImport operation middleware
+func (sh *strictHandler) PostFederationUUIDAgentImport(ctx echo.Context, uUID Uuid, params PostFederationUUIDAgentImportParams) error {
+	var request PostFederationUUIDAgentImportRequestObject
 
-	// (PATCH /project/{UUID}/graph)
-	PatchProjectUUIDGraph(ctx context.Context, request PatchProjectUUIDGraphRequestObject) (PatchProjectUUIDGraphResponseObject, error)
+	request.UUID = uUID
+	request.Params = params
 
-	// (PATCH /project/{UUID}/name)
-	PatchProjectUUIDName(ctx context.Context, request PatchProjectUUIDNameRequestObject) (PatchProjectUUIDNameResponseObject, error)
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
 
-	// (PATCH /project/{UUID}/options)
-	PatchProjectUUIDOptions(ctx context.Context, request PatchProjectUUIDOptionsRequestObject) (PatchProjectUUIDOptionsResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostFederationUUIDAgentImport(ctx.Request().Context(), request.(PostFederationUUIDAgentImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostFederationUUIDAgentImport")
+	}
 
-	// (GET /project/{UUID}/status)
-	GetProjectUUIDStatus(ctx context.Context, request GetProjectUUIDStatusRequestObject) (GetProjectUUIDStatusResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /project/{UUID}/status)
-	PostProjectUUIDStatus(ctx context.Context, request PostProjectUUIDStatusRequestObject) (PostProjectUUIDStatusResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostFederationUUIDAgentImportResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDAgentImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /project/{UUID}/status/{entityUUID})
-	DeleteProjectUUIDStatusEntityUUID(ctx context.Context, request DeleteProjectUUIDStatusEntityUUIDRequestObject) (DeleteProjectUUIDStatusEntityUUIDResponseObject, error)
+// DeleteFederationUUIDAgentEntityUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteFederationUUIDAgentEntityUUIDRequestObject
 
-	// (PATCH /project/{UUID}/status/{entityUUID})
-	PatchProjectUUIDStatusEntityUUID(ctx context.Context, request PatchProjectUUIDStatusEntityUUIDRequestObject) (PatchProjectUUIDStatusEntityUUIDResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (POST /project/{UUID}/user)
-	PostProjectUUIDUser(ctx context.Context, request PostProjectUUIDUserRequestObject) (PostProjectUUIDUserResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDAgentEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFederationUUIDAgentEntityUUID")
+	}
 
-	// (DELETE /project/{UUID}/user/{userUUID})
-	DeleteProjectUUIDUserUserUUID(ctx context.Context, request DeleteProjectUUIDUserUserUUIDRequestObject) (DeleteProjectUUIDUserUserUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /tag)
-	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteFederationUUIDAgentEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDAgentEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /tag)
-	PostTag(ctx context.Context, request PostTagRequestObject) (PostTagResponseObject, error)
+// PatchFederationUUIDAgentEntityUUID operation middleware
+func (sh *strictHandler) PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchFederationUUIDAgentEntityUUIDRequestObject
 
-	// (DELETE /tag/{UUID})
-	DeleteTagUUID(ctx context.Context, request DeleteTagUUIDRequestObject) (DeleteTagUUIDResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (PATCH /tag/{UUID})
-	PatchTagUUID(ctx context.Context, request PatchTagUUIDRequestObject) (PatchTagUUIDResponseObject, error)
+	var body PatchFederationUUIDAgentEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (GET /user)
-	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(PatchFederationUUIDAgentEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchFederationUUIDAgentEntityUUID")
+	}
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	response, err := handler(ctx, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchFederationUUIDAgentEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchFederationUUIDAgentEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// PostFederationUUIDAgentEntityUUIDMerge operation middleware
+func (sh *strictHandler) PostFederationUUIDAgentEntityUUIDMerge(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostFederationUUIDAgentEntityUUIDMergeRequestObject
 
-// PostCompany operation middleware
-func (sh *strictHandler) PostCompany(ctx echo.Context) error {
-	var request PostCompanyRequestObject
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PostCompanyJSONRequestBody
+	var body PostFederationUUIDAgentEntityUUIDMergeJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompany(ctx.Request().Context(), request.(PostCompanyRequestObject))
+		return sh.ssi.PostFederationUUIDAgentEntityUUIDMerge(ctx.Request().Context(), request.(PostFederationUUIDAgentEntityUUIDMergeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompany")
+		handler = middleware(handler, "PostFederationUUIDAgentEntityUUIDMerge")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyResponseObject); ok {
-		return validResponse.VisitPostCompanyResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDAgentEntityUUIDMergeResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDAgentEntityUUIDMergeResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteCompanyUUIDRequestObject
+// GetFederationUUIDAgentEntityUUIDTimeline operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentEntityUUIDTimeline(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDTimelineParams) error {
+	var request GetFederationUUIDAgentEntityUUIDTimelineRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDAgentEntityUUIDTimeline(ctx.Request().Context(), request.(GetFederationUUIDAgentEntityUUIDTimelineRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUID")
+		handler = middleware(handler, "GetFederationUUIDAgentEntityUUIDTimeline")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentEntityUUIDTimelineResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentEntityUUIDTimelineResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUID operation middleware
-func (sh *strictHandler) GetCompanyUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDRequestObject
+// GetFederationUUIDInvite operation middleware
+func (sh *strictHandler) GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDInviteRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUID(ctx.Request().Context(), request.(GetCompanyUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDInvite(ctx.Request().Context(), request.(GetFederationUUIDInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUID")
+		handler = middleware(handler, "GetFederationUUIDInvite")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFederationUUIDInviteResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDInviteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostFederationUUIDInvite operation middleware
+func (sh *strictHandler) PostFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDInviteRequestObject
+
+	request.UUID = uUID
+
+	var body PostFederationUUIDInviteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostFederationUUIDInvite(ctx.Request().Context(), request.(PostFederationUUIDInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostFederationUUIDInvite")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDInviteResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDInviteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDFields operation middleware
-func (sh *strictHandler) GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDFieldsRequestObject
+// DeleteFederationUUIDInviteEntityUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteFederationUUIDInviteEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDFields(ctx.Request().Context(), request.(GetCompanyUUIDFieldsRequestObject))
+		return sh.ssi.DeleteFederationUUIDInviteEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDInviteEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDFields")
+		handler = middleware(handler, "DeleteFederationUUIDInviteEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDFieldsResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDFieldsResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDInviteEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDInviteEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDFields operation middleware
-func (sh *strictHandler) PostCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDFieldsRequestObject
+// PatchFederationUUIDName operation middleware
+func (sh *strictHandler) PatchFederationUUIDName(ctx echo.Context, uUID Uuid) error {
+	var request PatchFederationUUIDNameRequestObject
 
 	request.UUID = uUID
 
-	var body PostCompanyUUIDFieldsJSONRequestBody
+	var body PatchFederationUUIDNameJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDFields(ctx.Request().Context(), request.(PostCompanyUUIDFieldsRequestObject))
+		return sh.ssi.PatchFederationUUIDName(ctx.Request().Context(), request.(PatchFederationUUIDNameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDFields")
+		handler = middleware(handler, "PatchFederationUUIDName")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDFieldsResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDFieldsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchFederationUUIDNameResponseObject); ok {
+		return validResponse.VisitPatchFederationUUIDNameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDFieldsEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDFieldsEntityUUIDRequestObject
+// GetFederationUUIDProject operation middleware
+func (sh *strictHandler) GetFederationUUIDProject(ctx echo.Context, uUID Uuid, params GetFederationUUIDProjectParams) error {
+	var request GetFederationUUIDProjectRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDFieldsEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDProject(ctx.Request().Context(), request.(GetFederationUUIDProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDFieldsEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDProject")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDFieldsEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDProjectResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDProjectResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutCompanyUUIDFieldsEntityUUID operation middleware
-func (sh *strictHandler) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PutCompanyUUIDFieldsEntityUUIDRequestObject
+// PostFederationUUIDUser operation middleware
+func (sh *strictHandler) PostFederationUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDUserRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
-	var body PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+	var body PostFederationUUIDUserJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDFieldsEntityUUIDRequestObject))
+		return sh.ssi.PostFederationUUIDUser(ctx.Request().Context(), request.(PostFederationUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutCompanyUUIDFieldsEntityUUID")
+		handler = middleware(handler, "PostFederationUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutCompanyUUIDFieldsEntityUUIDResponseObject); ok {
-		return validResponse.VisitPutCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDUserResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDGroup operation middleware
-func (sh *strictHandler) GetCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDGroupRequestObject
+// DeleteFederationUUIDUserUserUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
+	var request DeleteFederationUUIDUserUserUUIDRequestObject
 
 	request.UUID = uUID
+	request.UserUUID = userUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDGroup(ctx.Request().Context(), request.(GetCompanyUUIDGroupRequestObject))
+		return sh.ssi.DeleteFederationUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteFederationUUIDUserUserUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDGroup")
+		handler = middleware(handler, "DeleteFederationUUIDUserUserUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDGroupResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDUserUserUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDUserUserUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDGroup operation middleware
-func (sh *strictHandler) PostCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDGroupRequestObject
+// GetFederationUUIDWebhook operation middleware
+func (sh *strictHandler) GetFederationUUIDWebhook(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDWebhookRequestObject
 
 	request.UUID = uUID
 
-	var body PostCompanyUUIDGroupJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDGroup(ctx.Request().Context(), request.(PostCompanyUUIDGroupRequestObject))
+		return sh.ssi.GetFederationUUIDWebhook(ctx.Request().Context(), request.(GetFederationUUIDWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDGroup")
+		handler = middleware(handler, "GetFederationUUIDWebhook")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDGroupResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDWebhookResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDWebhookResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDGroupEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDGroupEntityUUIDRequestObject
+// PostFederationUUIDWebhook operation middleware
+func (sh *strictHandler) PostFederationUUIDWebhook(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDWebhookRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+
+	var body PostFederationUUIDWebhookJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDGroupEntityUUIDRequestObject))
+		return sh.ssi.PostFederationUUIDWebhook(ctx.Request().Context(), request.(PostFederationUUIDWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDGroupEntityUUID")
+		handler = middleware(handler, "PostFederationUUIDWebhook")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDGroupEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDWebhookResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDWebhookResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDGroupEntityUUID operation middleware
-func (sh *strictHandler) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchCompanyUUIDGroupEntityUUIDRequestObject
+// DeleteGroupUUIDUser operation middleware
+func (sh *strictHandler) DeleteGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request DeleteGroupUUIDUserRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
-	var body PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
+	var body DeleteGroupUUIDUserJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDGroupEntityUUIDRequestObject))
+		return sh.ssi.DeleteGroupUUIDUser(ctx.Request().Context(), request.(DeleteGroupUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDGroupEntityUUID")
+		handler = middleware(handler, "DeleteGroupUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDGroupEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteGroupUUIDUserResponseObject); ok {
+		return validResponse.VisitDeleteGroupUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDName operation middleware
-func (sh *strictHandler) PatchCompanyUUIDName(ctx echo.Context, uUID Uuid) error {
-	var request PatchCompanyUUIDNameRequestObject
+// GetGroupUUIDUser operation middleware
+func (sh *strictHandler) GetGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request GetGroupUUIDUserRequestObject
 
 	request.UUID = uUID
 
-	var body PatchCompanyUUIDNameJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDName(ctx.Request().Context(), request.(PatchCompanyUUIDNameRequestObject))
+		return sh.ssi.GetGroupUUIDUser(ctx.Request().Context(), request.(GetGroupUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDName")
+		handler = middleware(handler, "GetGroupUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDNameResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetGroupUUIDUserResponseObject); ok {
+		return validResponse.VisitGetGroupUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDPriorities operation middleware
-func (sh *strictHandler) GetCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDPrioritiesRequestObject
+// PostGroupUUIDUser operation middleware
+func (sh *strictHandler) PostGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request PostGroupUUIDUserRequestObject
 
 	request.UUID = uUID
 
+	var body PostGroupUUIDUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDPriorities(ctx.Request().Context(), request.(GetCompanyUUIDPrioritiesRequestObject))
+		return sh.ssi.PostGroupUUIDUser(ctx.Request().Context(), request.(PostGroupUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDPriorities")
+		handler = middleware(handler, "PostGroupUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDPrioritiesResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostGroupUUIDUserResponseObject); ok {
+		return validResponse.VisitPostGroupUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDPriorities operation middleware
-func (sh *strictHandler) PostCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDPrioritiesRequestObject
-
-	request.UUID = uUID
+// PostPermissions operation middleware
+func (sh *strictHandler) PostPermissions(ctx echo.Context) error {
+	var request PostPermissionsRequestObject
 
-	var body PostCompanyUUIDPrioritiesJSONRequestBody
+	var body PostPermissionsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDPriorities(ctx.Request().Context(), request.(PostCompanyUUIDPrioritiesRequestObject))
+		return sh.ssi.PostPermissions(ctx.Request().Context(), request.(PostPermissionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDPriorities")
+		handler = middleware(handler, "PostPermissions")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDPrioritiesResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostPermissionsResponseObject); ok {
+		return validResponse.VisitPostPermissionsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDPrioritiesEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject
-
-	request.UUID = uUID
-	request.EntityUUID = entityUUID
+// GetPermissionsRoles operation middleware
+func (sh *strictHandler) GetPermissionsRoles(ctx echo.Context) error {
+	var request GetPermissionsRolesRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject))
+		return sh.ssi.GetPermissionsRoles(ctx.Request().Context(), request.(GetPermissionsRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDPrioritiesEntityUUID")
+		handler = middleware(handler, "GetPermissionsRoles")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPermissionsRolesResponseObject); ok {
+		return validResponse.VisitGetPermissionsRolesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDPrioritiesEntityUUID operation middleware
-func (sh *strictHandler) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject
+// DeletePermissionsUUID operation middleware
+func (sh *strictHandler) DeletePermissionsUUID(ctx echo.Context, uUID Uuid, params DeletePermissionsUUIDParams) error {
+	var request DeletePermissionsUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
-
-	var body PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDPrioritiesEntityUUIDRequestObject))
+		return sh.ssi.DeletePermissionsUUID(ctx.Request().Context(), request.(DeletePermissionsUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDPrioritiesEntityUUID")
+		handler = middleware(handler, "DeletePermissionsUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeletePermissionsUUIDResponseObject); ok {
+		return validResponse.VisitDeletePermissionsUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDProjectCatalogEntityName operation middleware
-func (sh *strictHandler) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
-	var request GetCompanyUUIDProjectCatalogEntityNameRequestObject
+// GetPermissionsUUID operation middleware
+func (sh *strictHandler) GetPermissionsUUID(ctx echo.Context, uUID Uuid, params GetPermissionsUUIDParams) error {
+	var request GetPermissionsUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityName = entityName
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDProjectCatalogEntityName(ctx.Request().Context(), request.(GetCompanyUUIDProjectCatalogEntityNameRequestObject))
+		return sh.ssi.GetPermissionsUUID(ctx.Request().Context(), request.(GetPermissionsUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDProjectCatalogEntityName")
+		handler = middleware(handler, "GetPermissionsUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDProjectCatalogEntityNameResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDProjectCatalogEntityNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPermissionsUUIDResponseObject); ok {
+		return validResponse.VisitGetPermissionsUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDSms operation middleware
-func (sh *strictHandler) GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error {
-	var request GetCompanyUUIDSmsRequestObject
+// GetPermissionsUUIDEffective operation middleware
+func (sh *strictHandler) GetPermissionsUUIDEffective(ctx echo.Context, uUID Uuid, params GetPermissionsUUIDEffectiveParams) error {
+	var request GetPermissionsUUIDEffectiveRequestObject
 
 	request.UUID = uUID
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDSms(ctx.Request().Context(), request.(GetCompanyUUIDSmsRequestObject))
+		return sh.ssi.GetPermissionsUUIDEffective(ctx.Request().Context(), request.(GetPermissionsUUIDEffectiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDSms")
+		handler = middleware(handler, "GetPermissionsUUIDEffective")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDSmsResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDSmsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPermissionsUUIDEffectiveResponseObject); ok {
+		return validResponse.VisitGetPermissionsUUIDEffectiveResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDSmsCost operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsCost(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDSmsCostRequestObject
+// GetPermissionsUUIDRoles operation middleware
+func (sh *strictHandler) GetPermissionsUUIDRoles(ctx echo.Context, uUID Uuid, params GetPermissionsUUIDRolesParams) error {
+	var request GetPermissionsUUIDRolesRequestObject
 
 	request.UUID = uUID
-
-	var body PostCompanyUUIDSmsCostJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsCost(ctx.Request().Context(), request.(PostCompanyUUIDSmsCostRequestObject))
+		return sh.ssi.GetPermissionsUUIDRoles(ctx.Request().Context(), request.(GetPermissionsUUIDRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsCost")
+		handler = middleware(handler, "GetPermissionsUUIDRoles")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsCostResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsCostResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPermissionsUUIDRolesResponseObject); ok {
+		return validResponse.VisitGetPermissionsUUIDRolesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
-}
-
-// PostCompanyUUIDSmsOptions operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsOptions(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDSmsOptionsRequestObject
+}
+
+// PostPermissionsUUIDRoles operation middleware
+func (sh *strictHandler) PostPermissionsUUIDRoles(ctx echo.Context, uUID Uuid) error {
+	var request PostPermissionsUUIDRolesRequestObject
 
 	request.UUID = uUID
 
-	var body PostCompanyUUIDSmsOptionsJSONRequestBody
+	var body PostPermissionsUUIDRolesJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsOptions(ctx.Request().Context(), request.(PostCompanyUUIDSmsOptionsRequestObject))
+		return sh.ssi.PostPermissionsUUIDRoles(ctx.Request().Context(), request.(PostPermissionsUUIDRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsOptions")
+		handler = middleware(handler, "PostPermissionsUUIDRoles")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsOptionsResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsOptionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostPermissionsUUIDRolesResponseObject); ok {
+		return validResponse.VisitPostPermissionsUUIDRolesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDSmsSend operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsSend(ctx echo.Context, uUID Uuid, params PostCompanyUUIDSmsSendParams) error {
-	var request PostCompanyUUIDSmsSendRequestObject
+// DeletePermissionsUUIDRolesEntityUUID operation middleware
+func (sh *strictHandler) DeletePermissionsUUIDRolesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeletePermissionsUUIDRolesEntityUUIDRequestObject
 
 	request.UUID = uUID
-	request.Params = params
-
-	var body PostCompanyUUIDSmsSendJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsSend(ctx.Request().Context(), request.(PostCompanyUUIDSmsSendRequestObject))
+		return sh.ssi.DeletePermissionsUUIDRolesEntityUUID(ctx.Request().Context(), request.(DeletePermissionsUUIDRolesEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsSend")
+		handler = middleware(handler, "DeletePermissionsUUIDRolesEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsSendResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsSendResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeletePermissionsUUIDRolesEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeletePermissionsUUIDRolesEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDUser operation middleware
-func (sh *strictHandler) PostCompanyUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDUserRequestObject
-
-	request.UUID = uUID
+// PostProfileSurvey operation middleware
+func (sh *strictHandler) PostProfileSurvey(ctx echo.Context) error {
+	var request PostProfileSurveyRequestObject
 
-	var body PostCompanyUUIDUserJSONRequestBody
+	var body PostProfileSurveyJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDUser(ctx.Request().Context(), request.(PostCompanyUUIDUserRequestObject))
+		return sh.ssi.PostProfileSurvey(ctx.Request().Context(), request.(PostProfileSurveyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDUser")
+		handler = middleware(handler, "PostProfileSurvey")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDUserResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfileSurveyResponseObject); ok {
+		return validResponse.VisitPostProfileSurveyResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDUserUserUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
-	var request DeleteCompanyUUIDUserUserUUIDRequestObject
+// DeleteProfileSurveyUUID operation middleware
+func (sh *strictHandler) DeleteProfileSurveyUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProfileSurveyUUIDRequestObject
 
 	request.UUID = uUID
-	request.UserUUID = userUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDUserUserUUIDRequestObject))
+		return sh.ssi.DeleteProfileSurveyUUID(ctx.Request().Context(), request.(DeleteProfileSurveyUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDUserUserUUID")
+		handler = middleware(handler, "DeleteProfileSurveyUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDUserUserUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDUserUserUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProfileSurveyUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProfileSurveyUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederation operation middleware
-func (sh *strictHandler) PostFederation(ctx echo.Context) error {
-	var request PostFederationRequestObject
+// GetProfileSurveyUUID operation middleware
+func (sh *strictHandler) GetProfileSurveyUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetProfileSurveyUUIDRequestObject
 
-	var body PostFederationJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederation(ctx.Request().Context(), request.(PostFederationRequestObject))
+		return sh.ssi.GetProfileSurveyUUID(ctx.Request().Context(), request.(GetProfileSurveyUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederation")
+		handler = middleware(handler, "GetProfileSurveyUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationResponseObject); ok {
-		return validResponse.VisitPostFederationResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileSurveyUUIDResponseObject); ok {
+		return validResponse.VisitGetProfileSurveyUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteFederationUUIDRequestObject
+// PostProject operation middleware
+func (sh *strictHandler) PostProject(ctx echo.Context) error {
+	var request PostProjectRequestObject
 
-	request.UUID = uUID
+	var body PostProjectJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUID(ctx.Request().Context(), request.(DeleteFederationUUIDRequestObject))
+		return sh.ssi.PostProject(ctx.Request().Context(), request.(PostProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUID")
+		handler = middleware(handler, "PostProject")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectResponseObject); ok {
+		return validResponse.VisitPostProjectResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUID operation middleware
-func (sh *strictHandler) GetFederationUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetFederationUUIDRequestObject
+// DeleteProjectUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProjectUUIDRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUID(ctx.Request().Context(), request.(GetFederationUUIDRequestObject))
+		return sh.ssi.DeleteProjectUUID(ctx.Request().Context(), request.(DeleteProjectUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUID")
+		handler = middleware(handler, "DeleteProjectUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUIDAgent operation middleware
-func (sh *strictHandler) GetFederationUUIDAgent(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentParams) error {
-	var request GetFederationUUIDAgentRequestObject
+// GetProjectUUID operation middleware
+func (sh *strictHandler) GetProjectUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDRequestObject
 
 	request.UUID = uUID
-	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUIDAgent(ctx.Request().Context(), request.(GetFederationUUIDAgentRequestObject))
+		return sh.ssi.GetProjectUUID(ctx.Request().Context(), request.(GetProjectUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUIDAgent")
+		handler = middleware(handler, "GetProjectUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDAgentResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDAgentResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederationUUIDAgent operation middleware
-func (sh *strictHandler) PostFederationUUIDAgent(ctx echo.Context, uUID Uuid) error {
-	var request PostFederationUUIDAgentRequestObject
+// PatchProjectUUID operation middleware
+func (sh *strictHandler) PatchProjectUUID(ctx echo.Context, uUID Uuid) error {
+	var request PatchProjectUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PostFederationUUIDAgentJSONRequestBody
+	var body PatchProjectUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederationUUIDAgent(ctx.Request().Context(), request.(PostFederationUUIDAgentRequestObject))
+		return sh.ssi.PatchProjectUUID(ctx.Request().Context(), request.(PatchProjectUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederationUUIDAgent")
+		handler = middleware(handler, "PatchProjectUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationUUIDAgentResponseObject); ok {
-		return validResponse.VisitPostFederationUUIDAgentResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUIDAgentEntityUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteFederationUUIDAgentEntityUUIDRequestObject
+// GetProjectUUIDCatalog operation middleware
+func (sh *strictHandler) GetProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDCatalogRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDAgentEntityUUIDRequestObject))
+		return sh.ssi.GetProjectUUIDCatalog(ctx.Request().Context(), request.(GetProjectUUIDCatalogRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUIDAgentEntityUUID")
+		handler = middleware(handler, "GetProjectUUIDCatalog")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDAgentEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDAgentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDCatalogResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDCatalogResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchFederationUUIDAgentEntityUUID operation middleware
-func (sh *strictHandler) PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchFederationUUIDAgentEntityUUIDRequestObject
+// PostProjectUUIDCatalog operation middleware
+func (sh *strictHandler) PostProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDCatalogRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
-	var body PatchFederationUUIDAgentEntityUUIDJSONRequestBody
+	var body PostProjectUUIDCatalogJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(PatchFederationUUIDAgentEntityUUIDRequestObject))
+		return sh.ssi.PostProjectUUIDCatalog(ctx.Request().Context(), request.(PostProjectUUIDCatalogRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchFederationUUIDAgentEntityUUID")
+		handler = middleware(handler, "PostProjectUUIDCatalog")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchFederationUUIDAgentEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchFederationUUIDAgentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDCatalogResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDCatalogResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUIDInvite operation middleware
-func (sh *strictHandler) GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
-	var request GetFederationUUIDInviteRequestObject
+// GetProjectUUIDCatalogEntityName operation middleware
+func (sh *strictHandler) GetProjectUUIDCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
+	var request GetProjectUUIDCatalogEntityNameRequestObject
 
 	request.UUID = uUID
+	request.EntityName = entityName
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUIDInvite(ctx.Request().Context(), request.(GetFederationUUIDInviteRequestObject))
+		return sh.ssi.GetProjectUUIDCatalogEntityName(ctx.Request().Context(), request.(GetProjectUUIDCatalogEntityNameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUIDInvite")
+		handler = middleware(handler, "GetProjectUUIDCatalogEntityName")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDInviteResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDCatalogEntityNameResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDCatalogEntityNameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederationUUIDInvite operation middleware
-func (sh *strictHandler) PostFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
-	var request PostFederationUUIDInviteRequestObject
+// DeleteProjectUUIDCatalogEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDCatalogEntityUUIDRequestObject
 
 	request.UUID = uUID
-
-	var body PostFederationUUIDInviteJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederationUUIDInvite(ctx.Request().Context(), request.(PostFederationUUIDInviteRequestObject))
+		return sh.ssi.DeleteProjectUUIDCatalogEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDCatalogEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederationUUIDInvite")
+		handler = middleware(handler, "DeleteProjectUUIDCatalogEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationUUIDInviteResponseObject); ok {
-		return validResponse.VisitPostFederationUUIDInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDCatalogEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDCatalogEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUIDInviteEntityUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteFederationUUIDInviteEntityUUIDRequestObject
+// PatchProjectUUIDDescription operation middleware
+func (sh *strictHandler) PatchProjectUUIDDescription(ctx echo.Context, uUID Uuid) error {
+	var request PatchProjectUUIDDescriptionRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+
+	var body PatchProjectUUIDDescriptionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUIDInviteEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDInviteEntityUUIDRequestObject))
+		return sh.ssi.PatchProjectUUIDDescription(ctx.Request().Context(), request.(PatchProjectUUIDDescriptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUIDInviteEntityUUID")
+		handler = middleware(handler, "PatchProjectUUIDDescription")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDInviteEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDInviteEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDDescriptionResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDDescriptionResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchFederationUUIDName operation middleware
-func (sh *strictHandler) PatchFederationUUIDName(ctx echo.Context, uUID Uuid) error {
-	var request PatchFederationUUIDNameRequestObject
+// DeleteProjectUUIDFieldEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDFieldEntityUUIDRequestObject
 
 	request.UUID = uUID
-
-	var body PatchFederationUUIDNameJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchFederationUUIDName(ctx.Request().Context(), request.(PatchFederationUUIDNameRequestObject))
+		return sh.ssi.DeleteProjectUUIDFieldEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDFieldEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchFederationUUIDName")
+		handler = middleware(handler, "DeleteProjectUUIDFieldEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchFederationUUIDNameResponseObject); ok {
-		return validResponse.VisitPatchFederationUUIDNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDFieldEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDFieldEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUIDProject operation middleware
-func (sh *strictHandler) GetFederationUUIDProject(ctx echo.Context, uUID Uuid, params GetFederationUUIDProjectParams) error {
-	var request GetFederationUUIDProjectRequestObject
+// PostProjectUUIDFieldEntityUUID operation middleware
+func (sh *strictHandler) PostProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostProjectUUIDFieldEntityUUIDRequestObject
 
 	request.UUID = uUID
-	request.Params = params
+	request.EntityUUID = entityUUID
+
+	var body PostProjectUUIDFieldEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUIDProject(ctx.Request().Context(), request.(GetFederationUUIDProjectRequestObject))
+		return sh.ssi.PostProjectUUIDFieldEntityUUID(ctx.Request().Context(), request.(PostProjectUUIDFieldEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUIDProject")
+		handler = middleware(handler, "PostProjectUUIDFieldEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDProjectResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDProjectResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDFieldEntityUUIDResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDFieldEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederationUUIDUser operation middleware
-func (sh *strictHandler) PostFederationUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request PostFederationUUIDUserRequestObject
+// PatchProjectUUIDGraph operation middleware
+func (sh *strictHandler) PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) error {
+	var request PatchProjectUUIDGraphRequestObject
 
 	request.UUID = uUID
 
-	var body PostFederationUUIDUserJSONRequestBody
+	var body PatchProjectUUIDGraphJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederationUUIDUser(ctx.Request().Context(), request.(PostFederationUUIDUserRequestObject))
+		return sh.ssi.PatchProjectUUIDGraph(ctx.Request().Context(), request.(PatchProjectUUIDGraphRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederationUUIDUser")
+		handler = middleware(handler, "PatchProjectUUIDGraph")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationUUIDUserResponseObject); ok {
-		return validResponse.VisitPostFederationUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDGraphResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDGraphResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUIDUserUserUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
-	var request DeleteFederationUUIDUserUserUUIDRequestObject
+// PatchProjectUUIDName operation middleware
+func (sh *strictHandler) PatchProjectUUIDName(ctx echo.Context, uUID Uuid) error {
+	var request PatchProjectUUIDNameRequestObject
 
 	request.UUID = uUID
-	request.UserUUID = userUUID
+
+	var body PatchProjectUUIDNameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteFederationUUIDUserUserUUIDRequestObject))
+		return sh.ssi.PatchProjectUUIDName(ctx.Request().Context(), request.(PatchProjectUUIDNameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUIDUserUserUUID")
+		handler = middleware(handler, "PatchProjectUUIDName")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDUserUserUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDUserUserUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDNameResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDNameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteGroupUUIDUser operation middleware
-func (sh *strictHandler) DeleteGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request DeleteGroupUUIDUserRequestObject
+// PatchProjectUUIDOptions operation middleware
+func (sh *strictHandler) PatchProjectUUIDOptions(ctx echo.Context, uUID Uuid) error {
+	var request PatchProjectUUIDOptionsRequestObject
 
 	request.UUID = uUID
 
-	var body DeleteGroupUUIDUserJSONRequestBody
+	var body PatchProjectUUIDOptionsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteGroupUUIDUser(ctx.Request().Context(), request.(DeleteGroupUUIDUserRequestObject))
+		return sh.ssi.PatchProjectUUIDOptions(ctx.Request().Context(), request.(PatchProjectUUIDOptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteGroupUUIDUser")
+		handler = middleware(handler, "PatchProjectUUIDOptions")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteGroupUUIDUserResponseObject); ok {
-		return validResponse.VisitDeleteGroupUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDOptionsResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDOptionsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetGroupUUIDUser operation middleware
-func (sh *strictHandler) GetGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request GetGroupUUIDUserRequestObject
+// GetProjectUUIDRecurrence operation middleware
+func (sh *strictHandler) GetProjectUUIDRecurrence(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDRecurrenceRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGroupUUIDUser(ctx.Request().Context(), request.(GetGroupUUIDUserRequestObject))
+		return sh.ssi.GetProjectUUIDRecurrence(ctx.Request().Context(), request.(GetProjectUUIDRecurrenceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGroupUUIDUser")
+		handler = middleware(handler, "GetProjectUUIDRecurrence")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetGroupUUIDUserResponseObject); ok {
-		return validResponse.VisitGetGroupUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDRecurrenceResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDRecurrenceResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostGroupUUIDUser operation middleware
-func (sh *strictHandler) PostGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request PostGroupUUIDUserRequestObject
+// GetProjectUUIDStatus operation middleware
+func (sh *strictHandler) GetProjectUUIDStatus(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDStatusRequestObject
 
 	request.UUID = uUID
 
-	var body PostGroupUUIDUserJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostGroupUUIDUser(ctx.Request().Context(), request.(PostGroupUUIDUserRequestObject))
+		return sh.ssi.GetProjectUUIDStatus(ctx.Request().Context(), request.(GetProjectUUIDStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostGroupUUIDUser")
+		handler = middleware(handler, "GetProjectUUIDStatus")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostGroupUUIDUserResponseObject); ok {
-		return validResponse.VisitPostGroupUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDStatusResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDStatusResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostPermissions operation middleware
-func (sh *strictHandler) PostPermissions(ctx echo.Context) error {
-	var request PostPermissionsRequestObject
+// PostProjectUUIDStatus operation middleware
+func (sh *strictHandler) PostProjectUUIDStatus(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDStatusRequestObject
 
-	var body PostPermissionsJSONRequestBody
+	request.UUID = uUID
+
+	var body PostProjectUUIDStatusJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPermissions(ctx.Request().Context(), request.(PostPermissionsRequestObject))
+		return sh.ssi.PostProjectUUIDStatus(ctx.Request().Context(), request.(PostProjectUUIDStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPermissions")
+		handler = middleware(handler, "PostProjectUUIDStatus")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPermissionsResponseObject); ok {
-		return validResponse.VisitPostPermissionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDStatusResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDStatusResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeletePermissionsUUID operation middleware
-func (sh *strictHandler) DeletePermissionsUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeletePermissionsUUIDRequestObject
+// DeleteProjectUUIDStatusEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDStatusEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePermissionsUUID(ctx.Request().Context(), request.(DeletePermissionsUUIDRequestObject))
+		return sh.ssi.DeleteProjectUUIDStatusEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDStatusEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePermissionsUUID")
+		handler = middleware(handler, "DeleteProjectUUIDStatusEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeletePermissionsUUIDResponseObject); ok {
-		return validResponse.VisitDeletePermissionsUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDStatusEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDStatusEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetPermissionsUUID operation middleware
-func (sh *strictHandler) GetPermissionsUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetPermissionsUUIDRequestObject
+// PatchProjectUUIDStatusEntityUUID operation middleware
+func (sh *strictHandler) PatchProjectUUIDStatusEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchProjectUUIDStatusEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PatchProjectUUIDStatusEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPermissionsUUID(ctx.Request().Context(), request.(GetPermissionsUUIDRequestObject))
+		return sh.ssi.PatchProjectUUIDStatusEntityUUID(ctx.Request().Context(), request.(PatchProjectUUIDStatusEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPermissionsUUID")
+		handler = middleware(handler, "PatchProjectUUIDStatusEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetPermissionsUUIDResponseObject); ok {
-		return validResponse.VisitGetPermissionsUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDStatusEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDStatusEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProfileSurvey operation middleware
-func (sh *strictHandler) PostProfileSurvey(ctx echo.Context) error {
-	var request PostProfileSurveyRequestObject
+// PostProjectUUIDTaskImport operation middleware
+func (sh *strictHandler) PostProjectUUIDTaskImport(ctx echo.Context, uUID Uuid, params PostProjectUUIDTaskImportParams) error {
+	var request PostProjectUUIDTaskImportRequestObject
 
-	var body PostProfileSurveyJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	request.UUID = uUID
+	request.Params = params
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
 		return err
+	} else {
+		request.Body = reader
 	}
-	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProfileSurvey(ctx.Request().Context(), request.(PostProfileSurveyRequestObject))
+		return sh.ssi.PostProjectUUIDTaskImport(ctx.Request().Context(), request.(PostProjectUUIDTaskImportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProfileSurvey")
+		handler = middleware(handler, "PostProjectUUIDTaskImport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProfileSurveyResponseObject); ok {
-		return validResponse.VisitPostProfileSurveyResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDTaskImportResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDTaskImportResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProfileSurveyUUID operation middleware
-func (sh *strictHandler) DeleteProfileSurveyUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteProfileSurveyUUIDRequestObject
+// GetProjectUUIDTemplate operation middleware
+func (sh *strictHandler) GetProjectUUIDTemplate(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDTemplateRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProfileSurveyUUID(ctx.Request().Context(), request.(DeleteProfileSurveyUUIDRequestObject))
+		return sh.ssi.GetProjectUUIDTemplate(ctx.Request().Context(), request.(GetProjectUUIDTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProfileSurveyUUID")
+		handler = middleware(handler, "GetProjectUUIDTemplate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProfileSurveyUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProfileSurveyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDTemplateResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDTemplateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProfileSurveyUUID operation middleware
-func (sh *strictHandler) GetProfileSurveyUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetProfileSurveyUUIDRequestObject
+// PostProjectUUIDTemplate operation middleware
+func (sh *strictHandler) PostProjectUUIDTemplate(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDTemplateRequestObject
 
 	request.UUID = uUID
 
+	var body PostProjectUUIDTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfileSurveyUUID(ctx.Request().Context(), request.(GetProfileSurveyUUIDRequestObject))
+		return sh.ssi.PostProjectUUIDTemplate(ctx.Request().Context(), request.(PostProjectUUIDTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfileSurveyUUID")
+		handler = middleware(handler, "PostProjectUUIDTemplate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProfileSurveyUUIDResponseObject); ok {
-		return validResponse.VisitGetProfileSurveyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDTemplateResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDTemplateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProject operation middleware
-func (sh *strictHandler) PostProject(ctx echo.Context) error {
-	var request PostProjectRequestObject
+// PostProjectUUIDUser operation middleware
+func (sh *strictHandler) PostProjectUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDUserRequestObject
 
-	var body PostProjectJSONRequestBody
+	request.UUID = uUID
+
+	var body PostProjectUUIDUserJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProject(ctx.Request().Context(), request.(PostProjectRequestObject))
+		return sh.ssi.PostProjectUUIDUser(ctx.Request().Context(), request.(PostProjectUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProject")
+		handler = middleware(handler, "PostProjectUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProjectResponseObject); ok {
-		return validResponse.VisitPostProjectResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDUserResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProjectUUID operation middleware
-func (sh *strictHandler) DeleteProjectUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteProjectUUIDRequestObject
+// DeleteProjectUUIDUserUserUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
+	var request DeleteProjectUUIDUserUserUUIDRequestObject
 
 	request.UUID = uUID
+	request.UserUUID = userUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectUUID(ctx.Request().Context(), request.(DeleteProjectUUIDRequestObject))
+		return sh.ssi.DeleteProjectUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteProjectUUIDUserUserUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectUUID")
+		handler = middleware(handler, "DeleteProjectUUIDUserUserUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProjectUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProjectUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDUserUserUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDUserUserUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProjectUUID operation middleware
-func (sh *strictHandler) GetProjectUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetProjectUUIDRequestObject
+// GetProjectUUIDView operation middleware
+func (sh *strictHandler) GetProjectUUIDView(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDViewRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectUUID(ctx.Request().Context(), request.(GetProjectUUIDRequestObject))
+		return sh.ssi.GetProjectUUIDView(ctx.Request().Context(), request.(GetProjectUUIDViewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectUUID")
+		handler = middleware(handler, "GetProjectUUIDView")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProjectUUIDResponseObject); ok {
-		return validResponse.VisitGetProjectUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDViewResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDViewResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUID operation middleware
-func (sh *strictHandler) PatchProjectUUID(ctx echo.Context, uUID Uuid) error {
-	var request PatchProjectUUIDRequestObject
+// PostProjectUUIDView operation middleware
+func (sh *strictHandler) PostProjectUUIDView(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDViewRequestObject
 
 	request.UUID = uUID
 
-	var body PatchProjectUUIDJSONRequestBody
+	var body PostProjectUUIDViewJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUID(ctx.Request().Context(), request.(PatchProjectUUIDRequestObject))
+		return sh.ssi.PostProjectUUIDView(ctx.Request().Context(), request.(PostProjectUUIDViewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUID")
+		handler = middleware(handler, "PostProjectUUIDView")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDViewResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDViewResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProjectUUIDCatalog operation middleware
-func (sh *strictHandler) GetProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
-	var request GetProjectUUIDCatalogRequestObject
+// DeleteProjectUUIDViewEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDViewEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDViewEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectUUIDCatalog(ctx.Request().Context(), request.(GetProjectUUIDCatalogRequestObject))
+		return sh.ssi.DeleteProjectUUIDViewEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDViewEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectUUIDCatalog")
+		handler = middleware(handler, "DeleteProjectUUIDViewEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProjectUUIDCatalogResponseObject); ok {
-		return validResponse.VisitGetProjectUUIDCatalogResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDViewEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDViewEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProjectUUIDCatalog operation middleware
-func (sh *strictHandler) PostProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
-	var request PostProjectUUIDCatalogRequestObject
+// PutProjectUUIDViewEntityUUID operation middleware
+func (sh *strictHandler) PutProjectUUIDViewEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutProjectUUIDViewEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PostProjectUUIDCatalogJSONRequestBody
+	var body PutProjectUUIDViewEntityUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProjectUUIDCatalog(ctx.Request().Context(), request.(PostProjectUUIDCatalogRequestObject))
+		return sh.ssi.PutProjectUUIDViewEntityUUID(ctx.Request().Context(), request.(PutProjectUUIDViewEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProjectUUIDViewEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutProjectUUIDViewEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutProjectUUIDViewEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProjectUUIDWorklog operation middleware
+func (sh *strictHandler) GetProjectUUIDWorklog(ctx echo.Context, uUID Uuid, params GetProjectUUIDWorklogParams) error {
+	var request GetProjectUUIDWorklogRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDWorklog(ctx.Request().Context(), request.(GetProjectUUIDWorklogRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProjectUUIDCatalog")
+		handler = middleware(handler, "GetProjectUUIDWorklog")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProjectUUIDCatalogResponseObject); ok {
-		return validResponse.VisitPostProjectUUIDCatalogResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDWorklogResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDWorklogResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProjectUUIDCatalogEntityName operation middleware
-func (sh *strictHandler) GetProjectUUIDCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
-	var request GetProjectUUIDCatalogEntityNameRequestObject
+// DeleteRecurrenceUUID operation middleware
+func (sh *strictHandler) DeleteRecurrenceUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteRecurrenceUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityName = entityName
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectUUIDCatalogEntityName(ctx.Request().Context(), request.(GetProjectUUIDCatalogEntityNameRequestObject))
+		return sh.ssi.DeleteRecurrenceUUID(ctx.Request().Context(), request.(DeleteRecurrenceUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectUUIDCatalogEntityName")
+		handler = middleware(handler, "DeleteRecurrenceUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProjectUUIDCatalogEntityNameResponseObject); ok {
-		return validResponse.VisitGetProjectUUIDCatalogEntityNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteRecurrenceUUIDResponseObject); ok {
+		return validResponse.VisitDeleteRecurrenceUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProjectUUIDCatalogEntityUUID operation middleware
-func (sh *strictHandler) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteProjectUUIDCatalogEntityUUIDRequestObject
+// GetRecurrenceUUID operation middleware
+func (sh *strictHandler) GetRecurrenceUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetRecurrenceUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectUUIDCatalogEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDCatalogEntityUUIDRequestObject))
+		return sh.ssi.GetRecurrenceUUID(ctx.Request().Context(), request.(GetRecurrenceUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectUUIDCatalogEntityUUID")
+		handler = middleware(handler, "GetRecurrenceUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProjectUUIDCatalogEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProjectUUIDCatalogEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetRecurrenceUUIDResponseObject); ok {
+		return validResponse.VisitGetRecurrenceUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUIDDescription operation middleware
-func (sh *strictHandler) PatchProjectUUIDDescription(ctx echo.Context, uUID Uuid) error {
-	var request PatchProjectUUIDDescriptionRequestObject
+// PutRecurrenceUUID operation middleware
+func (sh *strictHandler) PutRecurrenceUUID(ctx echo.Context, uUID Uuid) error {
+	var request PutRecurrenceUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PatchProjectUUIDDescriptionJSONRequestBody
+	var body PutRecurrenceUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUIDDescription(ctx.Request().Context(), request.(PatchProjectUUIDDescriptionRequestObject))
+		return sh.ssi.PutRecurrenceUUID(ctx.Request().Context(), request.(PutRecurrenceUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUIDDescription")
+		handler = middleware(handler, "PutRecurrenceUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDDescriptionResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDDescriptionResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutRecurrenceUUIDResponseObject); ok {
+		return validResponse.VisitPutRecurrenceUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProjectUUIDFieldEntityUUID operation middleware
-func (sh *strictHandler) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteProjectUUIDFieldEntityUUIDRequestObject
+// GetSearch operation middleware
+func (sh *strictHandler) GetSearch(ctx echo.Context, params GetSearchParams) error {
+	var request GetSearchRequestObject
 
-	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectUUIDFieldEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDFieldEntityUUIDRequestObject))
+		return sh.ssi.GetSearch(ctx.Request().Context(), request.(GetSearchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectUUIDFieldEntityUUID")
+		handler = middleware(handler, "GetSearch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProjectUUIDFieldEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProjectUUIDFieldEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetSearchResponseObject); ok {
+		return validResponse.VisitGetSearchResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProjectUUIDFieldEntityUUID operation middleware
-func (sh *strictHandler) PostProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PostProjectUUIDFieldEntityUUIDRequestObject
-
-	request.UUID = uUID
-	request.EntityUUID = entityUUID
+// GetTag operation middleware
+func (sh *strictHandler) GetTag(ctx echo.Context, params GetTagParams) error {
+	var request GetTagRequestObject
 
-	var body PostProjectUUIDFieldEntityUUIDJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProjectUUIDFieldEntityUUID(ctx.Request().Context(), request.(PostProjectUUIDFieldEntityUUIDRequestObject))
+		return sh.ssi.GetTag(ctx.Request().Context(), request.(GetTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProjectUUIDFieldEntityUUID")
+		handler = middleware(handler, "GetTag")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProjectUUIDFieldEntityUUIDResponseObject); ok {
-		return validResponse.VisitPostProjectUUIDFieldEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTagResponseObject); ok {
+		return validResponse.VisitGetTagResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUIDGraph operation middleware
-func (sh *strictHandler) PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) error {
-	var request PatchProjectUUIDGraphRequestObject
-
-	request.UUID = uUID
+// PostTag operation middleware
+func (sh *strictHandler) PostTag(ctx echo.Context) error {
+	var request PostTagRequestObject
 
-	var body PatchProjectUUIDGraphJSONRequestBody
+	var body PostTagJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUIDGraph(ctx.Request().Context(), request.(PatchProjectUUIDGraphRequestObject))
+		return sh.ssi.PostTag(ctx.Request().Context(), request.(PostTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUIDGraph")
+		handler = middleware(handler, "PostTag")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDGraphResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDGraphResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTagResponseObject); ok {
+		return validResponse.VisitPostTagResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUIDName operation middleware
-func (sh *strictHandler) PatchProjectUUIDName(ctx echo.Context, uUID Uuid) error {
-	var request PatchProjectUUIDNameRequestObject
+// DeleteTagUUID operation middleware
+func (sh *strictHandler) DeleteTagUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteTagUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PatchProjectUUIDNameJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUIDName(ctx.Request().Context(), request.(PatchProjectUUIDNameRequestObject))
+		return sh.ssi.DeleteTagUUID(ctx.Request().Context(), request.(DeleteTagUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUIDName")
+		handler = middleware(handler, "DeleteTagUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDNameResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTagUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTagUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUIDOptions operation middleware
-func (sh *strictHandler) PatchProjectUUIDOptions(ctx echo.Context, uUID Uuid) error {
-	var request PatchProjectUUIDOptionsRequestObject
+// PatchTagUUID operation middleware
+func (sh *strictHandler) PatchTagUUID(ctx echo.Context, uUID Uuid) error {
+	var request PatchTagUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PatchProjectUUIDOptionsJSONRequestBody
+	var body PatchTagUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUIDOptions(ctx.Request().Context(), request.(PatchProjectUUIDOptionsRequestObject))
+		return sh.ssi.PatchTagUUID(ctx.Request().Context(), request.(PatchTagUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUIDOptions")
+		handler = middleware(handler, "PatchTagUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDOptionsResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDOptionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTagUUIDResponseObject); ok {
+		return validResponse.VisitPatchTagUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProjectUUIDStatus operation middleware
-func (sh *strictHandler) GetProjectUUIDStatus(ctx echo.Context, uUID Uuid) error {
-	var request GetProjectUUIDStatusRequestObject
+// DeleteTemplateUUID operation middleware
+func (sh *strictHandler) DeleteTemplateUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteTemplateUUIDRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectUUIDStatus(ctx.Request().Context(), request.(GetProjectUUIDStatusRequestObject))
+		return sh.ssi.DeleteTemplateUUID(ctx.Request().Context(), request.(DeleteTemplateUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectUUIDStatus")
+		handler = middleware(handler, "DeleteTemplateUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProjectUUIDStatusResponseObject); ok {
-		return validResponse.VisitGetProjectUUIDStatusResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTemplateUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTemplateUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProjectUUIDStatus operation middleware
-func (sh *strictHandler) PostProjectUUIDStatus(ctx echo.Context, uUID Uuid) error {
-	var request PostProjectUUIDStatusRequestObject
+// GetTemplateUUID operation middleware
+func (sh *strictHandler) GetTemplateUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetTemplateUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PostProjectUUIDStatusJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProjectUUIDStatus(ctx.Request().Context(), request.(PostProjectUUIDStatusRequestObject))
+		return sh.ssi.GetTemplateUUID(ctx.Request().Context(), request.(GetTemplateUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProjectUUIDStatus")
+		handler = middleware(handler, "GetTemplateUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProjectUUIDStatusResponseObject); ok {
-		return validResponse.VisitPostProjectUUIDStatusResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTemplateUUIDResponseObject); ok {
+		return validResponse.VisitGetTemplateUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProjectUUIDStatusEntityUUID operation middleware
-func (sh *strictHandler) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteProjectUUIDStatusEntityUUIDRequestObject
+// PutTemplateUUID operation middleware
+func (sh *strictHandler) PutTemplateUUID(ctx echo.Context, uUID Uuid) error {
+	var request PutTemplateUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+
+	var body PutTemplateUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectUUIDStatusEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDStatusEntityUUIDRequestObject))
+		return sh.ssi.PutTemplateUUID(ctx.Request().Context(), request.(PutTemplateUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectUUIDStatusEntityUUID")
+		handler = middleware(handler, "PutTemplateUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProjectUUIDStatusEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProjectUUIDStatusEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutTemplateUUIDResponseObject); ok {
+		return validResponse.VisitPutTemplateUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUIDStatusEntityUUID operation middleware
-func (sh *strictHandler) PatchProjectUUIDStatusEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchProjectUUIDStatusEntityUUIDRequestObject
+// PostTemplateUUIDRecurrence operation middleware
+func (sh *strictHandler) PostTemplateUUIDRecurrence(ctx echo.Context, uUID Uuid) error {
+	var request PostTemplateUUIDRecurrenceRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
-	var body PatchProjectUUIDStatusEntityUUIDJSONRequestBody
+	var body PostTemplateUUIDRecurrenceJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUIDStatusEntityUUID(ctx.Request().Context(), request.(PatchProjectUUIDStatusEntityUUIDRequestObject))
+		return sh.ssi.PostTemplateUUIDRecurrence(ctx.Request().Context(), request.(PostTemplateUUIDRecurrenceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUIDStatusEntityUUID")
+		handler = middleware(handler, "PostTemplateUUIDRecurrence")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDStatusEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDStatusEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTemplateUUIDRecurrenceResponseObject); ok {
+		return validResponse.VisitPostTemplateUUIDRecurrenceResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProjectUUIDUser operation middleware
-func (sh *strictHandler) PostProjectUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request PostProjectUUIDUserRequestObject
+// PostTemplateUUIDTask operation middleware
+func (sh *strictHandler) PostTemplateUUIDTask(ctx echo.Context, uUID Uuid) error {
+	var request PostTemplateUUIDTaskRequestObject
 
 	request.UUID = uUID
 
-	var body PostProjectUUIDUserJSONRequestBody
+	var body PostTemplateUUIDTaskJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProjectUUIDUser(ctx.Request().Context(), request.(PostProjectUUIDUserRequestObject))
+		return sh.ssi.PostTemplateUUIDTask(ctx.Request().Context(), request.(PostTemplateUUIDTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProjectUUIDUser")
+		handler = middleware(handler, "PostTemplateUUIDTask")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProjectUUIDUserResponseObject); ok {
-		return validResponse.VisitPostProjectUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTemplateUUIDTaskResponseObject); ok {
+		return validResponse.VisitPostTemplateUUIDTaskResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProjectUUIDUserUserUUID operation middleware
-func (sh *strictHandler) DeleteProjectUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
-	var request DeleteProjectUUIDUserUserUUIDRequestObject
+// GetTimesheet operation middleware
+func (sh *strictHandler) GetTimesheet(ctx echo.Context, params GetTimesheetParams) error {
+	var request GetTimesheetRequestObject
 
-	request.UUID = uUID
-	request.UserUUID = userUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteProjectUUIDUserUserUUIDRequestObject))
+		return sh.ssi.GetTimesheet(ctx.Request().Context(), request.(GetTimesheetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectUUIDUserUserUUID")
+		handler = middleware(handler, "GetTimesheet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProjectUUIDUserUserUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProjectUUIDUserUserUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTimesheetResponseObject); ok {
+		return validResponse.VisitGetTimesheetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTag operation middleware
-func (sh *strictHandler) GetTag(ctx echo.Context, params GetTagParams) error {
-	var request GetTagRequestObject
+// GetUser operation middleware
+func (sh *strictHandler) GetUser(ctx echo.Context) error {
+	var request GetUserRequestObject
 
-	request.Params = params
+	var body GetUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTag(ctx.Request().Context(), request.(GetTagRequestObject))
+		return sh.ssi.GetUser(ctx.Request().Context(), request.(GetUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTag")
+		handler = middleware(handler, "GetUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTagResponseObject); ok {
-		return validResponse.VisitGetTagResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetUserResponseObject); ok {
+		return validResponse.VisitGetUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTag operation middleware
-func (sh *strictHandler) PostTag(ctx echo.Context) error {
-	var request PostTagRequestObject
+// DeleteWebhookUUID operation middleware
+func (sh *strictHandler) DeleteWebhookUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteWebhookUUIDRequestObject
 
-	var body PostTagJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTag(ctx.Request().Context(), request.(PostTagRequestObject))
+		return sh.ssi.DeleteWebhookUUID(ctx.Request().Context(), request.(DeleteWebhookUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTag")
+		handler = middleware(handler, "DeleteWebhookUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTagResponseObject); ok {
-		return validResponse.VisitPostTagResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteWebhookUUIDResponseObject); ok {
+		return validResponse.VisitDeleteWebhookUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTagUUID operation middleware
-func (sh *strictHandler) DeleteTagUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteTagUUIDRequestObject
+// GetWebhookUUID operation middleware
+func (sh *strictHandler) GetWebhookUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetWebhookUUIDRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTagUUID(ctx.Request().Context(), request.(DeleteTagUUIDRequestObject))
+		return sh.ssi.GetWebhookUUID(ctx.Request().Context(), request.(GetWebhookUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTagUUID")
+		handler = middleware(handler, "GetWebhookUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTagUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTagUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetWebhookUUIDResponseObject); ok {
+		return validResponse.VisitGetWebhookUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTagUUID operation middleware
-func (sh *strictHandler) PatchTagUUID(ctx echo.Context, uUID Uuid) error {
-	var request PatchTagUUIDRequestObject
+// PutWebhookUUID operation middleware
+func (sh *strictHandler) PutWebhookUUID(ctx echo.Context, uUID Uuid) error {
+	var request PutWebhookUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PatchTagUUIDJSONRequestBody
+	var body PutWebhookUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTagUUID(ctx.Request().Context(), request.(PatchTagUUIDRequestObject))
+		return sh.ssi.PutWebhookUUID(ctx.Request().Context(), request.(PutWebhookUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTagUUID")
+		handler = middleware(handler, "PutWebhookUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTagUUIDResponseObject); ok {
-		return validResponse.VisitPatchTagUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutWebhookUUIDResponseObject); ok {
+		return validResponse.VisitPutWebhookUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetUser operation middleware
-func (sh *strictHandler) GetUser(ctx echo.Context) error {
-	var request GetUserRequestObject
+// GetWebhookUUIDDelivery operation middleware
+func (sh *strictHandler) GetWebhookUUIDDelivery(ctx echo.Context, uUID Uuid, params GetWebhookUUIDDeliveryParams) error {
+	var request GetWebhookUUIDDeliveryRequestObject
 
-	var body GetUserJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhookUUIDDelivery(ctx.Request().Context(), request.(GetWebhookUUIDDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhookUUIDDelivery")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(GetWebhookUUIDDeliveryResponseObject); ok {
+		return validResponse.VisitGetWebhookUUIDDeliveryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// PostWebhookUUIDDeliveryEntityUUIDRedeliver operation middleware
+func (sh *strictHandler) PostWebhookUUIDDeliveryEntityUUIDRedeliver(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostWebhookUUIDDeliveryEntityUUIDRedeliverRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUser(ctx.Request().Context(), request.(GetUserRequestObject))
+		return sh.ssi.PostWebhookUUIDDeliveryEntityUUIDRedeliver(ctx.Request().Context(), request.(PostWebhookUUIDDeliveryEntityUUIDRedeliverRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUser")
+		handler = middleware(handler, "PostWebhookUUIDDeliveryEntityUUIDRedeliver")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetUserResponseObject); ok {
-		return validResponse.VisitGetUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostWebhookUUIDDeliveryEntityUUIDRedeliverResponseObject); ok {
+		return validResponse.VisitPostWebhookUUIDDeliveryEntityUUIDRedeliverResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -53,6 +54,9 @@ type FederationDTOs = dto.FederationDTOs
 // InviteDTO defines model for InviteDTO.
 type InviteDTO = dto.InviteDTO
 
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences = domain.NotificationPreferences
+
 // NotificationReminderDTO defines model for NotificationReminderDTO.
 type NotificationReminderDTO = dto.NotificationReminderDTO
 
@@ -223,6 +227,9 @@ type PatchProfilePhotoMultipartRequestBody PatchProfilePhotoMultipartBody
 // PatchProfilePreferencesJSONRequestBody defines body for PatchProfilePreferences for application/json ContentType.
 type PatchProfilePreferencesJSONRequestBody PatchProfilePreferencesJSONBody
 
+// PutProfilePreferencesNotificationsJSONRequestBody defines body for PutProfilePreferencesNotifications for application/json ContentType.
+type PutProfilePreferencesNotificationsJSONRequestBody = NotificationPreferences
+
 // PostProfileResetJSONRequestBody defines body for PostProfileReset for application/json ContentType.
 type PostProfileResetJSONRequestBody = ProfileResetRequest
 
@@ -316,6 +323,12 @@ type ServerInterface interface {
 	// (PATCH /profile/preferences)
 	PatchProfilePreferences(ctx echo.Context) error
 
+	// (GET /profile/preferences/notifications)
+	GetProfilePreferencesNotifications(ctx echo.Context) error
+
+	// (PUT /profile/preferences/notifications)
+	PutProfilePreferencesNotifications(ctx echo.Context) error
+
 	// (POST /profile/reset)
 	PostProfileReset(ctx echo.Context) error
 
@@ -639,6 +652,28 @@ func (w *ServerInterfaceWrapper) PatchProfilePreferences(ctx echo.Context) error
 	return err
 }
 
+// GetProfilePreferencesNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfilePreferencesNotifications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfilePreferencesNotifications(ctx)
+	return err
+}
+
+// PutProfilePreferencesNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProfilePreferencesNotifications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutProfilePreferencesNotifications(ctx)
+	return err
+}
+
 // PostProfileReset converts echo context to params.
 func (w *ServerInterfaceWrapper) PostProfileReset(ctx echo.Context) error {
 	var err error
@@ -757,6 +792,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/profile/photo", wrapper.DeleteProfilePhoto)
 	router.PATCH(baseURL+"/profile/photo", wrapper.PatchProfilePhoto)
 	router.PATCH(baseURL+"/profile/preferences", wrapper.PatchProfilePreferences)
+	router.GET(baseURL+"/profile/preferences/notifications", wrapper.GetProfilePreferencesNotifications)
+	router.PUT(baseURL+"/profile/preferences/notifications", wrapper.PutProfilePreferencesNotifications)
 	router.POST(baseURL+"/profile/reset", wrapper.PostProfileReset)
 	router.POST(baseURL+"/profile/reset/send", wrapper.PostProfileResetSend)
 	router.POST(baseURL+"/profile/validate", wrapper.PostProfileValidate)
@@ -1213,6 +1250,38 @@ func (response PatchProfilePreferences200Response) VisitPatchProfilePreferencesR
 	return nil
 }
 
+type GetProfilePreferencesNotificationsRequestObject struct {
+}
+
+type GetProfilePreferencesNotificationsResponseObject interface {
+	VisitGetProfilePreferencesNotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetProfilePreferencesNotifications200JSONResponse NotificationPreferences
+
+func (response GetProfilePreferencesNotifications200JSONResponse) VisitGetProfilePreferencesNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProfilePreferencesNotificationsRequestObject struct {
+	Body *PutProfilePreferencesNotificationsJSONRequestBody
+}
+
+type PutProfilePreferencesNotificationsResponseObject interface {
+	VisitPutProfilePreferencesNotificationsResponse(w http.ResponseWriter) error
+}
+
+type PutProfilePreferencesNotifications200Response struct {
+}
+
+func (response PutProfilePreferencesNotifications200Response) VisitPutProfilePreferencesNotificationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
 type PostProfileResetRequestObject struct {
 	Body *PostProfileResetJSONRequestBody
 }
@@ -1402,6 +1471,12 @@ type StrictServerInterface interface {
 	// (PATCH /profile/preferences)
 	PatchProfilePreferences(ctx context.Context, request PatchProfilePreferencesRequestObject) (PatchProfilePreferencesResponseObject, error)
 
+	// (GET /profile/preferences/notifications)
+	GetProfilePreferencesNotifications(ctx context.Context, request GetProfilePreferencesNotificationsRequestObject) (GetProfilePreferencesNotificationsResponseObject, error)
+
+	// (PUT /profile/preferences/notifications)
+	PutProfilePreferencesNotifications(ctx context.Context, request PutProfilePreferencesNotificationsRequestObject) (PutProfilePreferencesNotificationsResponseObject, error)
+
 	// (POST /profile/reset)
 	PostProfileReset(ctx context.Context, request PostProfileResetRequestObject) (PostProfileResetResponseObject, error)
 
@@ -2061,6 +2136,58 @@ func (sh *strictHandler) PatchProfilePreferences(ctx echo.Context) error {
 	return nil
 }
 
+// GetProfilePreferencesNotifications operation middleware
+func (sh *strictHandler) GetProfilePreferencesNotifications(ctx echo.Context) error {
+	var request GetProfilePreferencesNotificationsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfilePreferencesNotifications(ctx.Request().Context(), request.(GetProfilePreferencesNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfilePreferencesNotifications")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfilePreferencesNotificationsResponseObject); ok {
+		return validResponse.VisitGetProfilePreferencesNotificationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutProfilePreferencesNotifications operation middleware
+func (sh *strictHandler) PutProfilePreferencesNotifications(ctx echo.Context) error {
+	var request PutProfilePreferencesNotificationsRequestObject
+
+	var body PutProfilePreferencesNotificationsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProfilePreferencesNotifications(ctx.Request().Context(), request.(PutProfilePreferencesNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProfilePreferencesNotifications")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutProfilePreferencesNotificationsResponseObject); ok {
+		return validResponse.VisitPutProfilePreferencesNotificationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostProfileReset operation middleware
 func (sh *strictHandler) PostProfileReset(ctx echo.Context) error {
 	var request PostProfileResetRequestObject
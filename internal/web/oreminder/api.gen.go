@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -26,6 +27,7 @@ type ReminderCreateRequest struct {
 	DateFrom    *time.Time          `json:"date_from,omitempty"`
 	DateTo      *time.Time          `json:"date_to,omitempty"`
 	Description string              `json:"description" validate:"trim,name,min=0,max=2000"`
+	Recurrence  *ReminderRecurrence `json:"recurrence,omitempty"`
 	TaskUuid    openapi_types.UUID  `json:"task_uuid" validate:"uuid"`
 	Type        string              `json:"type" validate:"trim,name,min=0,max=50"`
 	UserUuid    *openapi_types.UUID `json:"user_uuid,omitempty"`
@@ -40,10 +42,14 @@ type ReminderPutRequest struct {
 	DateFrom    *time.Time          `json:"date_from,omitempty"`
 	DateTo      *time.Time          `json:"date_to,omitempty"`
 	Description string              `json:"description" validate:"trim,name,min=0,max=2000"`
+	Recurrence  *ReminderRecurrence `json:"recurrence,omitempty"`
 	Type        string              `json:"type" validate:"trim,name,min=0,max=50"`
 	UserUuid    *openapi_types.UUID `json:"user_uuid,omitempty"`
 }
 
+// ReminderRecurrence defines model for ReminderRecurrence.
+type ReminderRecurrence = domain.ReminderRecurrence
+
 // StatusRequest defines model for StatusRequest.
 type StatusRequest struct {
 	Comment string `json:"comment" validate:"trim,min=0,max=300"`
@@ -61,6 +67,12 @@ type EntityUUID = openapi_types.UUID
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// GetReminderParams defines parameters for GetReminder.
+type GetReminderParams struct {
+	DateFrom *time.Time `form:"date_from,omitempty" json:"date_from,omitempty"`
+	DateTo   *time.Time `form:"date_to,omitempty" json:"date_to,omitempty"`
+}
+
 // PostReminderJSONRequestBody defines body for PostReminder for application/json ContentType.
 type PostReminderJSONRequestBody = ReminderCreateRequest
 
@@ -74,7 +86,7 @@ type PatchReminderUUIDStatusJSONRequestBody = StatusRequest
 type ServerInterface interface {
 
 	// (GET /reminder)
-	GetReminder(ctx echo.Context) error
+	GetReminder(ctx echo.Context, params GetReminderParams) error
 
 	// (POST /reminder)
 	PostReminder(ctx echo.Context) error
@@ -100,8 +112,24 @@ func (w *ServerInterfaceWrapper) GetReminder(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReminderParams
+	// ------------- Optional query parameter "date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date_from", ctx.QueryParams(), &params.DateFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date_to", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_to: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetReminder(ctx)
+	err = w.Handler.GetReminder(ctx, params)
 	return err
 }
 
@@ -207,6 +235,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 }
 
 type GetReminderRequestObject struct {
+	Params GetReminderParams
 }
 
 type GetReminderResponseObject interface {
@@ -324,9 +353,11 @@ type strictHandler struct {
 }
 
 // GetReminder operation middleware
-func (sh *strictHandler) GetReminder(ctx echo.Context) error {
+func (sh *strictHandler) GetReminder(ctx echo.Context, params GetReminderParams) error {
 	var request GetReminderRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetReminder(ctx.Request().Context(), request.(GetReminderRequestObject))
 	}
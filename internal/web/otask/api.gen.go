@@ -27,6 +27,9 @@ const (
 // ActivityDTO defines model for ActivityDTO.
 type ActivityDTO = dto.ActivityDTO
 
+// ChecklistProgressDTO defines model for ChecklistProgressDTO.
+type ChecklistProgressDTO = dto.ChecklistProgressDTO
+
 // CommentDTO defines model for CommentDTO.
 type CommentDTO = dto.CommentDTO
 
@@ -41,8 +44,27 @@ type StatusRequest struct {
 	Status  int    `json:"status" validate:"gte=0,lte=20"`
 }
 
+// TaskChecklistItemDTO defines model for TaskChecklistItemDTO.
+type TaskChecklistItemDTO = dto.TaskChecklistItemDTO
+
+// TaskChecklistItemRequest defines model for TaskChecklistItemRequest.
+type TaskChecklistItemRequest struct {
+	AssignedTo *string `json:"assigned_to,omitempty" validate:"omitempty,email"`
+
+	// Done False when empty
+	Done  *bool      `json:"done,omitempty"`
+	DueAt *time.Time `json:"due_at,omitempty"`
+	Text  string     `json:"text" validate:"trim,min=1,max=500"`
+}
+
+// TaskChecklistOrderRequest defines model for TaskChecklistOrderRequest.
+type TaskChecklistOrderRequest struct {
+	Uuids []openapi_types.UUID `json:"uuids" validate:"min=1,max=500"`
+}
+
 // TaskCreateRequest defines model for TaskCreateRequest.
 type TaskCreateRequest struct {
+	Agents        *[]openapi_types.UUID  `json:"agents,omitempty" validate:"omitempty,dive,uuid"`
 	CoworkersBy   []string               `json:"coworkers_by" validate:"dive,email"`
 	Description   string                 `json:"description" validate:"trim,max=5000"`
 	Fields        map[string]interface{} `json:"fields"`
@@ -65,8 +87,12 @@ type TaskDTO = dto.TaskDTO
 // TaskDTOs defines model for TaskDTOs.
 type TaskDTOs = dto.TaskDTOs
 
+// TaskLinkDTO defines model for TaskLinkDTO.
+type TaskLinkDTO = dto.TaskLinkDTO
+
 // TaskPutRequest defines model for TaskPutRequest.
 type TaskPutRequest struct {
+	Agents      *[]openapi_types.UUID   `json:"agents,omitempty" validate:"omitempty,dive,uuid"`
 	Description *string                 `json:"description,omitempty" validate:"trim,max=5000"`
 	Fields      *map[string]interface{} `json:"fields,omitempty"`
 	FinishTo    *time.Time              `json:"finish_to,omitempty"`
@@ -76,12 +102,48 @@ type TaskPutRequest struct {
 	Tags        *[]string               `json:"tags,omitempty" validate:"dive,trim,name,max=40"`
 }
 
+// TaskRecurrenceDTO defines model for TaskRecurrenceDTO.
+type TaskRecurrenceDTO = dto.TaskRecurrenceDTO
+
+// TaskRecurrenceRequest defines model for TaskRecurrenceRequest.
+type TaskRecurrenceRequest struct {
+	Paused   *bool        `json:"paused,omitempty"`
+	Schedule TaskSchedule `json:"schedule"`
+	StartAt  *time.Time   `json:"start_at,omitempty"`
+
+	// Vars only for a template series
+	Vars *map[string]string `json:"vars,omitempty"`
+}
+
+// TaskSchedule defines model for TaskSchedule.
+type TaskSchedule = domain.TaskSchedule
+
+// TaskTimerDTO defines model for TaskTimerDTO.
+type TaskTimerDTO = dto.TaskTimerDTO
+
+// TaskWorklogDTO defines model for TaskWorklogDTO.
+type TaskWorklogDTO = dto.TaskWorklogDTO
+
+// TaskWorklogRequest defines model for TaskWorklogRequest.
+type TaskWorklogRequest struct {
+	// Billable True when empty
+	Billable *bool   `json:"billable,omitempty"`
+	Comment  *string `json:"comment,omitempty" validate:"omitempty,max=1000"`
+
+	// Duration Minutes
+	Duration  int       `json:"duration" validate:"min=1,max=1440"`
+	StartedAt time.Time `json:"started_at"`
+}
+
 // UploadDTO defines model for UploadDTO.
 type UploadDTO = dto.UploadDTO
 
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
+// WorklogTotalDTO defines model for WorklogTotalDTO.
+type WorklogTotalDTO = dto.WorklogTotalDTO
+
 // EntityUUID defines model for entityUUID.
 type EntityUUID = openapi_types.UUID
 
@@ -104,10 +166,24 @@ type GetTaskParams struct {
 	Tags           *[]string          `form:"tags,omitempty" json:"tags,omitempty"`
 	Path           *string            `form:"path,omitempty" json:"path,omitempty"`
 	Name           *string            `form:"name,omitempty" json:"name,omitempty"`
-	Fields         *string            `form:"fields,omitempty" json:"fields,omitempty"`
-	Order          *string            `form:"order,omitempty" json:"order,omitempty"`
-	By             *string            `form:"by,omitempty" json:"by,omitempty"`
-	Format         *string            `form:"format,omitempty" json:"format,omitempty"`
+
+	// Fields JSON object {"<field>": value} or {"<field>": {"op": "<operator>", "value": value}}. Operators: =, !=, <, <=, >, >=, between, in, not in, contains, starts with, is null, is not null. Dates also take today, yesterday, tomorrow, this week, this month, last|next N days|weeks|months. These and dates without time are [from, to) ranges, = of a date means its whole day, between of dates excludes its end
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+	Order  *string `form:"order,omitempty" json:"order,omitempty"`
+	By     *string `form:"by,omitempty" json:"by,omitempty"`
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+
+	// ViewUuid Saved view, its filter and sort fill the params missing in the request
+	ViewUuid *openapi_types.UUID `form:"view_uuid,omitempty" json:"view_uuid,omitempty"`
+
+	// Overdue Open tasks past their finish_to, false excludes them
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
+
+	// DueBefore finish_to is before the time
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
+
+	// DueAfter finish_to is at or after the time
+	DueAfter *time.Time `form:"due_after,omitempty" json:"due_after,omitempty"`
 }
 
 // GetTaskUUIDActivityParams defines parameters for GetTaskUUIDActivity.
@@ -132,6 +208,14 @@ type PatchTaskUUIDCommentEntityUUIDMultipartBody struct {
 	ReplyUuid *openapi_types.UUID `json:"reply_uuid,omitempty"`
 }
 
+// PostTaskUUIDLinkJSONBody defines parameters for PostTaskUUIDLink.
+type PostTaskUUIDLinkJSONBody struct {
+	TaskUuid openapi_types.UUID `json:"task_uuid" validate:"uuid"`
+
+	// Type blocks, blocked_by, relates, duplicates or duplicated_by
+	Type string `json:"type" validate:"oneof=blocks blocked_by relates duplicates duplicated_by"`
+}
+
 // PatchTaskUUIDParentJSONBody defines parameters for PatchTaskUUIDParent.
 type PatchTaskUUIDParentJSONBody struct {
 	Uuid *openapi_types.UUID `json:"uuid,omitempty" validate:"omitempty,uuid"`
@@ -169,12 +253,24 @@ type PostTaskJSONRequestBody = TaskCreateRequest
 // PutTaskUUIDJSONRequestBody defines body for PutTaskUUID for application/json ContentType.
 type PutTaskUUIDJSONRequestBody = TaskPutRequest
 
+// PostTaskUUIDChecklistJSONRequestBody defines body for PostTaskUUIDChecklist for application/json ContentType.
+type PostTaskUUIDChecklistJSONRequestBody = TaskChecklistItemRequest
+
+// PutTaskUUIDChecklistJSONRequestBody defines body for PutTaskUUIDChecklist for application/json ContentType.
+type PutTaskUUIDChecklistJSONRequestBody = TaskChecklistOrderRequest
+
+// PutTaskUUIDChecklistEntityUUIDJSONRequestBody defines body for PutTaskUUIDChecklistEntityUUID for application/json ContentType.
+type PutTaskUUIDChecklistEntityUUIDJSONRequestBody = TaskChecklistItemRequest
+
 // PostTaskUUIDCommentMultipartRequestBody defines body for PostTaskUUIDComment for multipart/form-data ContentType.
 type PostTaskUUIDCommentMultipartRequestBody PostTaskUUIDCommentMultipartBody
 
 // PatchTaskUUIDCommentEntityUUIDMultipartRequestBody defines body for PatchTaskUUIDCommentEntityUUID for multipart/form-data ContentType.
 type PatchTaskUUIDCommentEntityUUIDMultipartRequestBody PatchTaskUUIDCommentEntityUUIDMultipartBody
 
+// PostTaskUUIDLinkJSONRequestBody defines body for PostTaskUUIDLink for application/json ContentType.
+type PostTaskUUIDLinkJSONRequestBody PostTaskUUIDLinkJSONBody
+
 // PatchTaskUUIDNameJSONRequestBody defines body for PatchTaskUUIDName for application/json ContentType.
 type PatchTaskUUIDNameJSONRequestBody = NameRequest
 
@@ -184,6 +280,9 @@ type PatchTaskUUIDParentJSONRequestBody PatchTaskUUIDParentJSONBody
 // PatchTaskUUIDProjectJSONRequestBody defines body for PatchTaskUUIDProject for application/json ContentType.
 type PatchTaskUUIDProjectJSONRequestBody PatchTaskUUIDProjectJSONBody
 
+// PostTaskUUIDRecurrenceJSONRequestBody defines body for PostTaskUUIDRecurrence for application/json ContentType.
+type PostTaskUUIDRecurrenceJSONRequestBody = TaskRecurrenceRequest
+
 // PatchTaskUUIDStatusJSONRequestBody defines body for PatchTaskUUIDStatus for application/json ContentType.
 type PatchTaskUUIDStatusJSONRequestBody = StatusRequest
 
@@ -196,6 +295,12 @@ type PatchTaskUUIDUploadMultipartRequestBody PatchTaskUUIDUploadMultipartBody
 // PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody defines body for PostTaskUUIDUploadEntityUUIDRename for application/json ContentType.
 type PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody PostTaskUUIDUploadEntityUUIDRenameJSONBody
 
+// PostTaskUUIDWorklogJSONRequestBody defines body for PostTaskUUIDWorklog for application/json ContentType.
+type PostTaskUUIDWorklogJSONRequestBody = TaskWorklogRequest
+
+// PutTaskUUIDWorklogEntityUUIDJSONRequestBody defines body for PutTaskUUIDWorklogEntityUUID for application/json ContentType.
+type PutTaskUUIDWorklogEntityUUIDJSONRequestBody = TaskWorklogRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -217,6 +322,24 @@ type ServerInterface interface {
 	// (GET /task/{UUID}/activity)
 	GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params GetTaskUUIDActivityParams) error
 
+	// (GET /task/{UUID}/checklist)
+	GetTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error
+
+	// (POST /task/{UUID}/checklist)
+	PostTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /task/{UUID}/checklist)
+	PutTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /task/{UUID}/checklist/{entityUUID})
+	DeleteTaskUUIDChecklistEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /task/{UUID}/checklist/{entityUUID})
+	PutTaskUUIDChecklistEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/checklist/{entityUUID}/task)
+	PostTaskUUIDChecklistEntityUUIDTask(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (GET /task/{UUID}/comment)
 	GetTaskUUIDComment(ctx echo.Context, uUID Uuid) error
 
@@ -238,6 +361,12 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (POST /task/{UUID}/link)
+	PostTaskUUIDLink(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /task/{UUID}/link/{entityUUID})
+	DeleteTaskUUIDLinkEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error
 
@@ -247,6 +376,9 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/project)
 	PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error
 
+	// (POST /task/{UUID}/recurrence)
+	PostTaskUUIDRecurrence(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /task/{UUID}/status)
 	PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error
 
@@ -256,6 +388,9 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/team)
 	PatchTaskUUIDTeam(ctx echo.Context, uUID Uuid) error
 
+	// (POST /task/{UUID}/timer)
+	PostTaskUUIDTimer(ctx echo.Context, uUID Uuid) error
+
 	// (GET /task/{UUID}/upload)
 	GetTaskUUIDUpload(ctx echo.Context, uUID Uuid) error
 
@@ -270,6 +405,24 @@ type ServerInterface interface {
 
 	// (POST /task/{UUID}/upload/{entityUUID}/rename)
 	PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /task/{UUID}/worklog)
+	GetTaskUUIDWorklog(ctx echo.Context, uUID Uuid) error
+
+	// (POST /task/{UUID}/worklog)
+	PostTaskUUIDWorklog(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /task/{UUID}/worklog/{entityUUID})
+	DeleteTaskUUIDWorklogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /task/{UUID}/worklog/{entityUUID})
+	PutTaskUUIDWorklogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (DELETE /timer)
+	DeleteTimer(ctx echo.Context) error
+
+	// (GET /timer)
+	GetTimer(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -390,6 +543,34 @@ func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "view_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view_uuid", ctx.QueryParams(), &params.ViewUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", ctx.QueryParams(), &params.DueAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_after: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetTask(ctx, params)
 	return err
@@ -494,6 +675,138 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDActivity(ctx echo.Context) error {
 	return err
 }
 
+// GetTaskUUIDChecklist converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDChecklist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDChecklist(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDChecklist converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDChecklist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDChecklist(ctx, uUID)
+	return err
+}
+
+// PutTaskUUIDChecklist converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTaskUUIDChecklist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTaskUUIDChecklist(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDChecklistEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDChecklistEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDChecklistEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PutTaskUUIDChecklistEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTaskUUIDChecklistEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTaskUUIDChecklistEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostTaskUUIDChecklistEntityUUIDTask converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDChecklistEntityUUIDTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDChecklistEntityUUIDTask(ctx, uUID, entityUUID)
+	return err
+}
+
 // GetTaskUUIDComment converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTaskUUIDComment(ctx echo.Context) error {
 	var err error
@@ -668,6 +981,50 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Cont
 	return err
 }
 
+// PostTaskUUIDLink converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDLink(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDLinkEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDLinkEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDLinkEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
 // PatchTaskUUIDName converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchTaskUUIDName(ctx echo.Context) error {
 	var err error
@@ -722,6 +1079,24 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDProject(ctx echo.Context) error {
 	return err
 }
 
+// PostTaskUUIDRecurrence converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDRecurrence(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDRecurrence(ctx, uUID)
+	return err
+}
+
 // PatchTaskUUIDStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchTaskUUIDStatus(ctx echo.Context) error {
 	var err error
@@ -784,6 +1159,24 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDTeam(ctx echo.Context) error {
 	return err
 }
 
+// PostTaskUUIDTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDTimer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDTimer(ctx, uUID)
+	return err
+}
+
 // GetTaskUUIDUpload converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTaskUUIDUpload(ctx echo.Context) error {
 	var err error
@@ -898,14 +1291,124 @@ func (w *ServerInterfaceWrapper) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Con
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// GetTaskUUIDWorklog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDWorklog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDWorklog(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDWorklog converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDWorklog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDWorklog(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDWorklogEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDWorklogEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDWorklogEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PutTaskUUIDWorklogEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTaskUUIDWorklogEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTaskUUIDWorklogEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// DeleteTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTimer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTimer(ctx)
+	return err
+}
+
+// GetTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTimer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTimer(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -932,6 +1435,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/task/:UUID", wrapper.GetTaskUUID)
 	router.PUT(baseURL+"/task/:UUID", wrapper.PutTaskUUID)
 	router.GET(baseURL+"/task/:UUID/activity", wrapper.GetTaskUUIDActivity)
+	router.GET(baseURL+"/task/:UUID/checklist", wrapper.GetTaskUUIDChecklist)
+	router.POST(baseURL+"/task/:UUID/checklist", wrapper.PostTaskUUIDChecklist)
+	router.PUT(baseURL+"/task/:UUID/checklist", wrapper.PutTaskUUIDChecklist)
+	router.DELETE(baseURL+"/task/:UUID/checklist/:entityUUID", wrapper.DeleteTaskUUIDChecklistEntityUUID)
+	router.PUT(baseURL+"/task/:UUID/checklist/:entityUUID", wrapper.PutTaskUUIDChecklistEntityUUID)
+	router.POST(baseURL+"/task/:UUID/checklist/:entityUUID/task", wrapper.PostTaskUUIDChecklistEntityUUIDTask)
 	router.GET(baseURL+"/task/:UUID/comment", wrapper.GetTaskUUIDComment)
 	router.POST(baseURL+"/task/:UUID/comment", wrapper.PostTaskUUIDComment)
 	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.DeleteTaskUUIDCommentEntityUUID)
@@ -939,17 +1448,27 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID/file/:fileUUID", wrapper.DeleteTaskUUIDCommentEntityUUIDFileFileUUID)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/like", wrapper.PatchTaskUUIDCommentEntityUUIDLike)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/pin", wrapper.PatchTaskUUIDCommentEntityUUIDPin)
+	router.POST(baseURL+"/task/:UUID/link", wrapper.PostTaskUUIDLink)
+	router.DELETE(baseURL+"/task/:UUID/link/:entityUUID", wrapper.DeleteTaskUUIDLinkEntityUUID)
 	router.PATCH(baseURL+"/task/:UUID/name", wrapper.PatchTaskUUIDName)
 	router.PATCH(baseURL+"/task/:UUID/parent", wrapper.PatchTaskUUIDParent)
 	router.PATCH(baseURL+"/task/:UUID/project", wrapper.PatchTaskUUIDProject)
+	router.POST(baseURL+"/task/:UUID/recurrence", wrapper.PostTaskUUIDRecurrence)
 	router.PATCH(baseURL+"/task/:UUID/status", wrapper.PatchTaskUUIDStatus)
 	router.DELETE(baseURL+"/task/:UUID/stop/:entityUUID", wrapper.DeleteTaskUUIDStopEntityUUID)
 	router.PATCH(baseURL+"/task/:UUID/team", wrapper.PatchTaskUUIDTeam)
+	router.POST(baseURL+"/task/:UUID/timer", wrapper.PostTaskUUIDTimer)
 	router.GET(baseURL+"/task/:UUID/upload", wrapper.GetTaskUUIDUpload)
 	router.PATCH(baseURL+"/task/:UUID/upload", wrapper.PatchTaskUUIDUpload)
 	router.DELETE(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.DeleteTaskUUIDUploadEntityUUID)
 	router.GET(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.GetTaskUUIDUploadEntityUUID)
 	router.POST(baseURL+"/task/:UUID/upload/:entityUUID/rename", wrapper.PostTaskUUIDUploadEntityUUIDRename)
+	router.GET(baseURL+"/task/:UUID/worklog", wrapper.GetTaskUUIDWorklog)
+	router.POST(baseURL+"/task/:UUID/worklog", wrapper.PostTaskUUIDWorklog)
+	router.DELETE(baseURL+"/task/:UUID/worklog/:entityUUID", wrapper.DeleteTaskUUIDWorklogEntityUUID)
+	router.PUT(baseURL+"/task/:UUID/worklog/:entityUUID", wrapper.PutTaskUUIDWorklogEntityUUID)
+	router.DELETE(baseURL+"/timer", wrapper.DeleteTimer)
+	router.GET(baseURL+"/timer", wrapper.GetTimer)
 
 }
 
@@ -1109,6 +1628,120 @@ func (response GetTaskUUIDActivity200JSONResponse) VisitGetTaskUUIDActivityRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetTaskUUIDChecklistRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDChecklistResponseObject interface {
+	VisitGetTaskUUIDChecklistResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDChecklist200JSONResponse struct {
+	Count    int                    `json:"count"`
+	Items    []TaskChecklistItemDTO `json:"items"`
+	Progress ChecklistProgressDTO   `json:"progress"`
+}
+
+func (response GetTaskUUIDChecklist200JSONResponse) VisitGetTaskUUIDChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDChecklistRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDChecklistJSONRequestBody
+}
+
+type PostTaskUUIDChecklistResponseObject interface {
+	VisitPostTaskUUIDChecklistResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDChecklist200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDChecklist200JSONResponse) VisitPostTaskUUIDChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTaskUUIDChecklistRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutTaskUUIDChecklistJSONRequestBody
+}
+
+type PutTaskUUIDChecklistResponseObject interface {
+	VisitPutTaskUUIDChecklistResponse(w http.ResponseWriter) error
+}
+
+type PutTaskUUIDChecklist200Response struct {
+}
+
+func (response PutTaskUUIDChecklist200Response) VisitPutTaskUUIDChecklistResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteTaskUUIDChecklistEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDChecklistEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDChecklistEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDChecklistEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDChecklistEntityUUID200Response) VisitDeleteTaskUUIDChecklistEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutTaskUUIDChecklistEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutTaskUUIDChecklistEntityUUIDJSONRequestBody
+}
+
+type PutTaskUUIDChecklistEntityUUIDResponseObject interface {
+	VisitPutTaskUUIDChecklistEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutTaskUUIDChecklistEntityUUID200Response struct {
+}
+
+func (response PutTaskUUIDChecklistEntityUUID200Response) VisitPutTaskUUIDChecklistEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostTaskUUIDChecklistEntityUUIDTaskRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PostTaskUUIDChecklistEntityUUIDTaskResponseObject interface {
+	VisitPostTaskUUIDChecklistEntityUUIDTaskResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDChecklistEntityUUIDTask200JSONResponse struct {
+	Id   int                `json:"id"`
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDChecklistEntityUUIDTask200JSONResponse) VisitPostTaskUUIDChecklistEntityUUIDTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetTaskUUIDCommentRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
@@ -1251,6 +1884,43 @@ func (response PatchTaskUUIDCommentEntityUUIDPin200Response) VisitPatchTaskUUIDC
 	return nil
 }
 
+type PostTaskUUIDLinkRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDLinkJSONRequestBody
+}
+
+type PostTaskUUIDLinkResponseObject interface {
+	VisitPostTaskUUIDLinkResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDLink200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDLink200JSONResponse) VisitPostTaskUUIDLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDLinkEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDLinkEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDLinkEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDLinkEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDLinkEntityUUID200Response) VisitDeleteTaskUUIDLinkEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
 type PatchTaskUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchTaskUUIDNameJSONRequestBody
@@ -1318,6 +1988,24 @@ func (response PatchTaskUUIDProject200Response) VisitPatchTaskUUIDProjectRespons
 	return nil
 }
 
+type PostTaskUUIDRecurrenceRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDRecurrenceJSONRequestBody
+}
+
+type PostTaskUUIDRecurrenceResponseObject interface {
+	VisitPostTaskUUIDRecurrenceResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDRecurrence200JSONResponse TaskRecurrenceDTO
+
+func (response PostTaskUUIDRecurrence200JSONResponse) VisitPostTaskUUIDRecurrenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchTaskUUIDStatusRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchTaskUUIDStatusJSONRequestBody
@@ -1380,6 +2068,25 @@ func (response PatchTaskUUIDTeam200JSONResponse) VisitPatchTaskUUIDTeamResponse(
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostTaskUUIDTimerRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PostTaskUUIDTimerResponseObject interface {
+	VisitPostTaskUUIDTimerResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDTimer200JSONResponse struct {
+	Stopped *TaskWorklogDTO `json:"stopped,omitempty"`
+}
+
+func (response PostTaskUUIDTimer200JSONResponse) VisitPostTaskUUIDTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetTaskUUIDUploadRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
@@ -1476,6 +2183,115 @@ func (response PostTaskUUIDUploadEntityUUIDRename200Response) VisitPostTaskUUIDU
 	return nil
 }
 
+type GetTaskUUIDWorklogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDWorklogResponseObject interface {
+	VisitGetTaskUUIDWorklogResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDWorklog200JSONResponse struct {
+	Count   int              `json:"count"`
+	Items   []TaskWorklogDTO `json:"items"`
+	Subtree WorklogTotalDTO  `json:"subtree"`
+	Total   WorklogTotalDTO  `json:"total"`
+}
+
+func (response GetTaskUUIDWorklog200JSONResponse) VisitGetTaskUUIDWorklogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDWorklogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDWorklogJSONRequestBody
+}
+
+type PostTaskUUIDWorklogResponseObject interface {
+	VisitPostTaskUUIDWorklogResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDWorklog200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDWorklog200JSONResponse) VisitPostTaskUUIDWorklogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDWorklogEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDWorklogEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDWorklogEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDWorklogEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDWorklogEntityUUID200Response) VisitDeleteTaskUUIDWorklogEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutTaskUUIDWorklogEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutTaskUUIDWorklogEntityUUIDJSONRequestBody
+}
+
+type PutTaskUUIDWorklogEntityUUIDResponseObject interface {
+	VisitPutTaskUUIDWorklogEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutTaskUUIDWorklogEntityUUID200Response struct {
+}
+
+func (response PutTaskUUIDWorklogEntityUUID200Response) VisitPutTaskUUIDWorklogEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteTimerRequestObject struct {
+}
+
+type DeleteTimerResponseObject interface {
+	VisitDeleteTimerResponse(w http.ResponseWriter) error
+}
+
+type DeleteTimer200JSONResponse TaskWorklogDTO
+
+func (response DeleteTimer200JSONResponse) VisitDeleteTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimerRequestObject struct {
+}
+
+type GetTimerResponseObject interface {
+	VisitGetTimerResponse(w http.ResponseWriter) error
+}
+
+type GetTimer200JSONResponse TaskTimerDTO
+
+func (response GetTimer200JSONResponse) VisitGetTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
@@ -1497,6 +2313,24 @@ type StrictServerInterface interface {
 	// (GET /task/{UUID}/activity)
 	GetTaskUUIDActivity(ctx context.Context, request GetTaskUUIDActivityRequestObject) (GetTaskUUIDActivityResponseObject, error)
 
+	// (GET /task/{UUID}/checklist)
+	GetTaskUUIDChecklist(ctx context.Context, request GetTaskUUIDChecklistRequestObject) (GetTaskUUIDChecklistResponseObject, error)
+
+	// (POST /task/{UUID}/checklist)
+	PostTaskUUIDChecklist(ctx context.Context, request PostTaskUUIDChecklistRequestObject) (PostTaskUUIDChecklistResponseObject, error)
+
+	// (PUT /task/{UUID}/checklist)
+	PutTaskUUIDChecklist(ctx context.Context, request PutTaskUUIDChecklistRequestObject) (PutTaskUUIDChecklistResponseObject, error)
+
+	// (DELETE /task/{UUID}/checklist/{entityUUID})
+	DeleteTaskUUIDChecklistEntityUUID(ctx context.Context, request DeleteTaskUUIDChecklistEntityUUIDRequestObject) (DeleteTaskUUIDChecklistEntityUUIDResponseObject, error)
+
+	// (PUT /task/{UUID}/checklist/{entityUUID})
+	PutTaskUUIDChecklistEntityUUID(ctx context.Context, request PutTaskUUIDChecklistEntityUUIDRequestObject) (PutTaskUUIDChecklistEntityUUIDResponseObject, error)
+
+	// (POST /task/{UUID}/checklist/{entityUUID}/task)
+	PostTaskUUIDChecklistEntityUUIDTask(ctx context.Context, request PostTaskUUIDChecklistEntityUUIDTaskRequestObject) (PostTaskUUIDChecklistEntityUUIDTaskResponseObject, error)
+
 	// (GET /task/{UUID}/comment)
 	GetTaskUUIDComment(ctx context.Context, request GetTaskUUIDCommentRequestObject) (GetTaskUUIDCommentResponseObject, error)
 
@@ -1518,6 +2352,12 @@ type StrictServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDPinRequestObject) (PatchTaskUUIDCommentEntityUUIDPinResponseObject, error)
 
+	// (POST /task/{UUID}/link)
+	PostTaskUUIDLink(ctx context.Context, request PostTaskUUIDLinkRequestObject) (PostTaskUUIDLinkResponseObject, error)
+
+	// (DELETE /task/{UUID}/link/{entityUUID})
+	DeleteTaskUUIDLinkEntityUUID(ctx context.Context, request DeleteTaskUUIDLinkEntityUUIDRequestObject) (DeleteTaskUUIDLinkEntityUUIDResponseObject, error)
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx context.Context, request PatchTaskUUIDNameRequestObject) (PatchTaskUUIDNameResponseObject, error)
 
@@ -1527,6 +2367,9 @@ type StrictServerInterface interface {
 	// (PATCH /task/{UUID}/project)
 	PatchTaskUUIDProject(ctx context.Context, request PatchTaskUUIDProjectRequestObject) (PatchTaskUUIDProjectResponseObject, error)
 
+	// (POST /task/{UUID}/recurrence)
+	PostTaskUUIDRecurrence(ctx context.Context, request PostTaskUUIDRecurrenceRequestObject) (PostTaskUUIDRecurrenceResponseObject, error)
+
 	// (PATCH /task/{UUID}/status)
 	PatchTaskUUIDStatus(ctx context.Context, request PatchTaskUUIDStatusRequestObject) (PatchTaskUUIDStatusResponseObject, error)
 
@@ -1536,6 +2379,9 @@ type StrictServerInterface interface {
 	// (PATCH /task/{UUID}/team)
 	PatchTaskUUIDTeam(ctx context.Context, request PatchTaskUUIDTeamRequestObject) (PatchTaskUUIDTeamResponseObject, error)
 
+	// (POST /task/{UUID}/timer)
+	PostTaskUUIDTimer(ctx context.Context, request PostTaskUUIDTimerRequestObject) (PostTaskUUIDTimerResponseObject, error)
+
 	// (GET /task/{UUID}/upload)
 	GetTaskUUIDUpload(ctx context.Context, request GetTaskUUIDUploadRequestObject) (GetTaskUUIDUploadResponseObject, error)
 
@@ -1550,6 +2396,24 @@ type StrictServerInterface interface {
 
 	// (POST /task/{UUID}/upload/{entityUUID}/rename)
 	PostTaskUUIDUploadEntityUUIDRename(ctx context.Context, request PostTaskUUIDUploadEntityUUIDRenameRequestObject) (PostTaskUUIDUploadEntityUUIDRenameResponseObject, error)
+
+	// (GET /task/{UUID}/worklog)
+	GetTaskUUIDWorklog(ctx context.Context, request GetTaskUUIDWorklogRequestObject) (GetTaskUUIDWorklogResponseObject, error)
+
+	// (POST /task/{UUID}/worklog)
+	PostTaskUUIDWorklog(ctx context.Context, request PostTaskUUIDWorklogRequestObject) (PostTaskUUIDWorklogResponseObject, error)
+
+	// (DELETE /task/{UUID}/worklog/{entityUUID})
+	DeleteTaskUUIDWorklogEntityUUID(ctx context.Context, request DeleteTaskUUIDWorklogEntityUUIDRequestObject) (DeleteTaskUUIDWorklogEntityUUIDResponseObject, error)
+
+	// (PUT /task/{UUID}/worklog/{entityUUID})
+	PutTaskUUIDWorklogEntityUUID(ctx context.Context, request PutTaskUUIDWorklogEntityUUIDRequestObject) (PutTaskUUIDWorklogEntityUUIDResponseObject, error)
+
+	// (DELETE /timer)
+	DeleteTimer(ctx context.Context, request DeleteTimerRequestObject) (DeleteTimerResponseObject, error)
+
+	// (GET /timer)
+	GetTimer(ctx context.Context, request GetTimerRequestObject) (GetTimerResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -1564,161 +2428,332 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
-// GetTask operation middleware
-func (sh *strictHandler) GetTask(ctx echo.Context, params GetTaskParams) error {
-	var request GetTaskRequestObject
+// GetTask operation middleware
+func (sh *strictHandler) GetTask(ctx echo.Context, params GetTaskParams) error {
+	var request GetTaskRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTask(ctx.Request().Context(), request.(GetTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTask")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskResponseObject); ok {
+		return validResponse.VisitGetTaskResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTask operation middleware
+func (sh *strictHandler) PostTask(ctx echo.Context) error {
+	var request PostTaskRequestObject
+
+	var body PostTaskJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTask(ctx.Request().Context(), request.(PostTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTask")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskResponseObject); ok {
+		return validResponse.VisitPostTaskResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTaskUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteTaskUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUID(ctx.Request().Context(), request.(DeleteTaskUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUID operation middleware
+func (sh *strictHandler) GetTaskUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUID(ctx.Request().Context(), request.(GetTaskUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutTaskUUID operation middleware
+func (sh *strictHandler) PutTaskUUID(ctx echo.Context, uUID Uuid) error {
+	var request PutTaskUUIDRequestObject
+
+	request.UUID = uUID
+
+	var body PutTaskUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTaskUUID(ctx.Request().Context(), request.(PutTaskUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTaskUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutTaskUUIDResponseObject); ok {
+		return validResponse.VisitPutTaskUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDActivity operation middleware
+func (sh *strictHandler) GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params GetTaskUUIDActivityParams) error {
+	var request GetTaskUUIDActivityRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDActivity(ctx.Request().Context(), request.(GetTaskUUIDActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDActivity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDActivityResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDActivityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDChecklist operation middleware
+func (sh *strictHandler) GetTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDChecklistRequestObject
 
-	request.Params = params
+	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTask(ctx.Request().Context(), request.(GetTaskRequestObject))
+		return sh.ssi.GetTaskUUIDChecklist(ctx.Request().Context(), request.(GetTaskUUIDChecklistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTask")
+		handler = middleware(handler, "GetTaskUUIDChecklist")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskResponseObject); ok {
-		return validResponse.VisitGetTaskResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTaskUUIDChecklistResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDChecklistResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTask operation middleware
-func (sh *strictHandler) PostTask(ctx echo.Context) error {
-	var request PostTaskRequestObject
+// PostTaskUUIDChecklist operation middleware
+func (sh *strictHandler) PostTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDChecklistRequestObject
 
-	var body PostTaskJSONRequestBody
+	request.UUID = uUID
+
+	var body PostTaskUUIDChecklistJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTask(ctx.Request().Context(), request.(PostTaskRequestObject))
+		return sh.ssi.PostTaskUUIDChecklist(ctx.Request().Context(), request.(PostTaskUUIDChecklistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTask")
+		handler = middleware(handler, "PostTaskUUIDChecklist")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTaskResponseObject); ok {
-		return validResponse.VisitPostTaskResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskUUIDChecklistResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDChecklistResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTaskUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteTaskUUIDRequestObject
+// PutTaskUUIDChecklist operation middleware
+func (sh *strictHandler) PutTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error {
+	var request PutTaskUUIDChecklistRequestObject
 
 	request.UUID = uUID
 
+	var body PutTaskUUIDChecklistJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTaskUUID(ctx.Request().Context(), request.(DeleteTaskUUIDRequestObject))
+		return sh.ssi.PutTaskUUIDChecklist(ctx.Request().Context(), request.(PutTaskUUIDChecklistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTaskUUID")
+		handler = middleware(handler, "PutTaskUUIDChecklist")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTaskUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTaskUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutTaskUUIDChecklistResponseObject); ok {
+		return validResponse.VisitPutTaskUUIDChecklistResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTaskUUID operation middleware
-func (sh *strictHandler) GetTaskUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetTaskUUIDRequestObject
+// DeleteTaskUUIDChecklistEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDChecklistEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDChecklistEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaskUUID(ctx.Request().Context(), request.(GetTaskUUIDRequestObject))
+		return sh.ssi.DeleteTaskUUIDChecklistEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDChecklistEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaskUUID")
+		handler = middleware(handler, "DeleteTaskUUIDChecklistEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskUUIDResponseObject); ok {
-		return validResponse.VisitGetTaskUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTaskUUIDChecklistEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDChecklistEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutTaskUUID operation middleware
-func (sh *strictHandler) PutTaskUUID(ctx echo.Context, uUID Uuid) error {
-	var request PutTaskUUIDRequestObject
+// PutTaskUUIDChecklistEntityUUID operation middleware
+func (sh *strictHandler) PutTaskUUIDChecklistEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutTaskUUIDChecklistEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PutTaskUUIDJSONRequestBody
+	var body PutTaskUUIDChecklistEntityUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutTaskUUID(ctx.Request().Context(), request.(PutTaskUUIDRequestObject))
+		return sh.ssi.PutTaskUUIDChecklistEntityUUID(ctx.Request().Context(), request.(PutTaskUUIDChecklistEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutTaskUUID")
+		handler = middleware(handler, "PutTaskUUIDChecklistEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutTaskUUIDResponseObject); ok {
-		return validResponse.VisitPutTaskUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutTaskUUIDChecklistEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutTaskUUIDChecklistEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTaskUUIDActivity operation middleware
-func (sh *strictHandler) GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params GetTaskUUIDActivityParams) error {
-	var request GetTaskUUIDActivityRequestObject
+// PostTaskUUIDChecklistEntityUUIDTask operation middleware
+func (sh *strictHandler) PostTaskUUIDChecklistEntityUUIDTask(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDChecklistEntityUUIDTaskRequestObject
 
 	request.UUID = uUID
-	request.Params = params
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaskUUIDActivity(ctx.Request().Context(), request.(GetTaskUUIDActivityRequestObject))
+		return sh.ssi.PostTaskUUIDChecklistEntityUUIDTask(ctx.Request().Context(), request.(PostTaskUUIDChecklistEntityUUIDTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaskUUIDActivity")
+		handler = middleware(handler, "PostTaskUUIDChecklistEntityUUIDTask")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskUUIDActivityResponseObject); ok {
-		return validResponse.VisitGetTaskUUIDActivityResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskUUIDChecklistEntityUUIDTaskResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDChecklistEntityUUIDTaskResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -1918,6 +2953,63 @@ func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUI
 	return nil
 }
 
+// PostTaskUUIDLink operation middleware
+func (sh *strictHandler) PostTaskUUIDLink(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDLinkRequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDLinkJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDLink(ctx.Request().Context(), request.(PostTaskUUIDLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDLink")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDLinkResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDLinkResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTaskUUIDLinkEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDLinkEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDLinkEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDLinkEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDLinkEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDLinkEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDLinkEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDLinkEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchTaskUUIDName operation middleware
 func (sh *strictHandler) PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error {
 	var request PatchTaskUUIDNameRequestObject
@@ -2011,6 +3103,37 @@ func (sh *strictHandler) PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error
 	return nil
 }
 
+// PostTaskUUIDRecurrence operation middleware
+func (sh *strictHandler) PostTaskUUIDRecurrence(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDRecurrenceRequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDRecurrenceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDRecurrence(ctx.Request().Context(), request.(PostTaskUUIDRecurrenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDRecurrence")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDRecurrenceResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDRecurrenceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchTaskUUIDStatus operation middleware
 func (sh *strictHandler) PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error {
 	var request PatchTaskUUIDStatusRequestObject
@@ -2099,6 +3222,31 @@ func (sh *strictHandler) PatchTaskUUIDTeam(ctx echo.Context, uUID Uuid) error {
 	return nil
 }
 
+// PostTaskUUIDTimer operation middleware
+func (sh *strictHandler) PostTaskUUIDTimer(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDTimerRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDTimer(ctx.Request().Context(), request.(PostTaskUUIDTimerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDTimer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDTimerResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDTimerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetTaskUUIDUpload operation middleware
 func (sh *strictHandler) GetTaskUUIDUpload(ctx echo.Context, uUID Uuid) error {
 	var request GetTaskUUIDUploadRequestObject
@@ -2238,3 +3386,163 @@ func (sh *strictHandler) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uU
 	}
 	return nil
 }
+
+// GetTaskUUIDWorklog operation middleware
+func (sh *strictHandler) GetTaskUUIDWorklog(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDWorklogRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDWorklog(ctx.Request().Context(), request.(GetTaskUUIDWorklogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDWorklog")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDWorklogResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDWorklogResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskUUIDWorklog operation middleware
+func (sh *strictHandler) PostTaskUUIDWorklog(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDWorklogRequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDWorklogJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDWorklog(ctx.Request().Context(), request.(PostTaskUUIDWorklogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDWorklog")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDWorklogResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDWorklogResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTaskUUIDWorklogEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDWorklogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDWorklogEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDWorklogEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDWorklogEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDWorklogEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDWorklogEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDWorklogEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutTaskUUIDWorklogEntityUUID operation middleware
+func (sh *strictHandler) PutTaskUUIDWorklogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutTaskUUIDWorklogEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PutTaskUUIDWorklogEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTaskUUIDWorklogEntityUUID(ctx.Request().Context(), request.(PutTaskUUIDWorklogEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTaskUUIDWorklogEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutTaskUUIDWorklogEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutTaskUUIDWorklogEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTimer operation middleware
+func (sh *strictHandler) DeleteTimer(ctx echo.Context) error {
+	var request DeleteTimerRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTimer(ctx.Request().Context(), request.(DeleteTimerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTimer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTimerResponseObject); ok {
+		return validResponse.VisitDeleteTimerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTimer operation middleware
+func (sh *strictHandler) GetTimer(ctx echo.Context) error {
+	var request GetTimerRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTimer(ctx.Request().Context(), request.(GetTimerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTimer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTimerResponseObject); ok {
+		return validResponse.VisitGetTimerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -2,22 +2,37 @@ package web
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/jwt"
 	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
 	"github.com/samber/lo"
 )
 
 func (a *Web) DeleteFederationUUIDAgentEntityUUID(ctx context.Context, request oapi.DeleteFederationUUIDAgentEntityUUIDRequestObject) (oapi.DeleteFederationUUIDAgentEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.AgentsService.Delete(ctx, request.EntityUUID)
+	err := a.app.GateService.AgentDelete(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.federationAgent(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.AgentsService.Delete(ctx, request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -45,32 +60,28 @@ func (a *Web) GetFederationUUIDAgent(ctx context.Context, request oapi.GetFedera
 	return oapi.GetFederationUUIDAgent200JSONResponse{
 		Count: len(dms),
 		Items: lo.Map(dms, func(item domain.Agent, i int) dto.AgentDTO {
-			return dto.AgentDTO{
-				UUID:           item.UUID,
-				FederationUUID: item.FederationUUID,
-				CompanyUUID:    item.CompanyUUID,
-
-				Name: item.Name,
-				Contacts: lo.Map(item.Contacts, func(c domain.AgentContacts, _ int) dto.AgentContactsDTO {
-					return dto.AgentContactsDTO{
-						Type: c.Type,
-						Val:  c.Val,
-					}
-				}),
-				CreatedAt: item.CreatedAt,
-				UpdatedAt: item.UpdatedAt,
-			}
+			return dto.NewAgentDTO(item)
 		}),
 		Total: total,
 	}, nil
 }
 
 func (a *Web) PatchFederationUUIDAgentEntityUUID(ctx context.Context, request oapi.PatchFederationUUIDAgentEntityUUIDRequestObject) (oapi.PatchFederationUUIDAgentEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.AgentPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.federationAgent(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
 	ac := []domain.AgentContacts{}
 	for c := range request.Body.Contacts {
 		if request.Body.Contacts[c].Type == "" || request.Body.Contacts[c].Value == "" {
@@ -83,7 +94,7 @@ func (a *Web) PatchFederationUUIDAgentEntityUUID(ctx context.Context, request oa
 		})
 	}
 
-	err := a.app.AgentsService.Update(ctx, &domain.Agent{
+	err = a.app.AgentsService.Update(ctx, &domain.Agent{
 		UUID:     request.EntityUUID,
 		Name:     request.Body.Name,
 		Contacts: ac,
@@ -101,6 +112,18 @@ func (a *Web) PostFederationUUIDAgent(ctx context.Context, request oapi.PostFede
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.AgentCreate(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Body.CompanyUuid != nil {
+		company, found := a.app.DictionaryService.FindCompany(*request.Body.CompanyUuid)
+		if !found || company.FederationUUID != request.UUID {
+			return nil, dto.NotFoundErr("компания не найдена")
+		}
+	}
+
 	ac := []domain.AgentContacts{}
 	for c := range request.Body.Contacts {
 		if request.Body.Contacts[c].Type == "" || request.Body.Contacts[c].Value == "" {
@@ -118,7 +141,7 @@ func (a *Web) PostFederationUUIDAgent(ctx context.Context, request oapi.PostFede
 		UUID:  claims.UUID,
 	}, request.Body.Name, ac)
 
-	err := a.app.AgentsService.Create(ctx, dm)
+	err = a.app.AgentsService.Create(ctx, dm)
 	if err != nil {
 		return nil, err
 	}
@@ -127,3 +150,210 @@ func (a *Web) PostFederationUUIDAgent(ctx context.Context, request oapi.PostFede
 		Uuid: dm.UUID,
 	}, nil
 }
+
+func (a *Web) GetFederationUUIDAgentEntityUUIDTimeline(ctx context.Context, request oapi.GetFederationUUIDAgentEntityUUIDTimelineRequestObject) (oapi.GetFederationUUIDAgentEntityUUIDTimelineResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.AgentTimeline(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := lo.Clamp(lo.FromPtrOr(request.Params.Limit, 20), 1, 200)
+	offset := lo.Clamp(lo.FromPtrOr(request.Params.Offset, 0), 0, 1000)
+
+	companyUUIDs := a.app.DictionaryService.GetUserCompanies(claims.UUID)
+
+	dms, total, err := a.app.AgregateService.GetAgentTimeline(ctx, request.UUID, request.EntityUUID, companyUUIDs, request.Params.From, request.Params.To, limit, offset, func(t domain.Task) bool {
+		return a.app.GateService.TaskView(t, claims.UUID) == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentEntityUUIDTimeline200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.AgentTimelineItem, _ int) dto.AgentTimelineItemDTO {
+			return dto.NewAgentTimelineItemDTO(item, a.app.DictionaryService)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) GetFederationUUIDAgentDuplicates(ctx context.Context, request oapi.GetFederationUUIDAgentDuplicatesRequestObject) (oapi.GetFederationUUIDAgentDuplicatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.AgentDuplicates(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.AgentsService.GetDuplicates(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentDuplicates200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.AgentDuplicates, _ int) dto.AgentDuplicatesDTO {
+			return dto.NewAgentDuplicatesDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostFederationUUIDAgentEntityUUIDMerge(ctx context.Context, request oapi.PostFederationUUIDAgentEntityUUIDMergeRequestObject) (oapi.PostFederationUUIDAgentEntityUUIDMergeResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.AgentMerge(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, taskUUIDs, err := a.app.AgentsService.Merge(ctx, request.UUID, request.EntityUUID, request.Body.Agents)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range taskUUIDs {
+		a.app.TaskService.ResetCache(uid)
+	}
+
+	return oapi.PostFederationUUIDAgentEntityUUIDMerge200JSONResponse(dto.NewAgentDTO(dm)), nil
+}
+
+func (a *Web) PostFederationUUIDAgentImport(ctx context.Context, request oapi.PostFederationUUIDAgentImportRequestObject) (oapi.PostFederationUUIDAgentImportResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.AgentImport(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := request.Body.ReadForm(32 << 20)
+	if err != nil {
+		return nil, err
+	}
+	defer form.RemoveAll() //nolint
+
+	if len(form.File["file"]) == 0 {
+		return nil, errors.New("файл не передан")
+	}
+
+	mapping := map[string]string{}
+	if len(form.Value["mapping"]) > 0 && form.Value["mapping"][0] != "" {
+		if err := json.Unmarshal([]byte(form.Value["mapping"][0]), &mapping); err != nil {
+			return nil, fmt.Errorf("неверный формат mapping: %w", err)
+		}
+	}
+
+	var companyUUID *uuid.UUID
+	if len(form.Value["company_uuid"]) > 0 && form.Value["company_uuid"][0] != "" {
+		uid, err := uuid.Parse(form.Value["company_uuid"][0])
+		if err != nil {
+			return nil, err
+		}
+
+		company, found := a.app.DictionaryService.FindCompany(uid)
+		if !found || company.FederationUUID != request.UUID {
+			return nil, dto.NotFoundErr("компания не найдена")
+		}
+
+		companyUUID = &uid
+	}
+
+	fh := form.File["file"][0]
+	file, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	rows, err := agents.ReadRows(file, fh.Filename)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.app.AgentsService.Import(ctx, request.UUID, companyUUID, domain.Me{
+		Email: claims.Email,
+		UUID:  claims.UUID,
+	}, rows, mapping, lo.FromPtrOr(request.Params.DryRun, false))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostFederationUUIDAgentImport200JSONResponse(dto.NewAgentImportDTO(res)), nil
+}
+
+func (a *Web) GetFederationUUIDAgentExport(ctx context.Context, request oapi.GetFederationUUIDAgentExportRequestObject) (oapi.GetFederationUUIDAgentExportResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.AgentExport(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	format := lo.FromPtrOr(request.Params.Format, agents.FormatXLSX)
+	if format != agents.FormatXLSX && format != agents.FormatCSV {
+		return nil, fmt.Errorf("неподдерживаемый формат %s, нужен csv или xlsx", format)
+	}
+
+	filter := domain.AgentFilter{
+		FederationUUID: request.UUID,
+		CompanyUUID:    request.Params.CompanyUuid,
+		Name:           request.Params.Name,
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(a.app.AgentsService.Export(ctx, filter, format, pw))
+	}()
+
+	headers := oapi.GetFederationUUIDAgentExport200ResponseHeaders{
+		CacheControl:       "no-cache",
+		ContentType:        "application/octet-stream",
+		ContentDisposition: fmt.Sprintf("attachment; filename=\"agents.%s\";", format),
+	}
+
+	if format == agents.FormatCSV {
+		headers.ContentType = "text/csv; charset=utf-8"
+
+		return oapi.GetFederationUUIDAgentExport200TextcsvResponse{
+			Body:    pr,
+			Headers: headers,
+		}, nil
+	}
+
+	return oapi.GetFederationUUIDAgentExport200ApplicationxlsxResponse{
+		Body:    pr,
+		Headers: headers,
+	}, nil
+}
+
+// federationAgent - the agent of the url is of the url federation.
+func (a *Web) federationAgent(ctx context.Context, federationUUID, agentUUID uuid.UUID) error {
+	agent, err := a.app.AgentsService.GetByUUID(ctx, agentUUID)
+	if err != nil {
+		return err
+	}
+
+	if agent.FederationUUID != federationUUID {
+		return dto.NotFoundErr("агент не найден")
+	}
+
+	return nil
+}
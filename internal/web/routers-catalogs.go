@@ -29,12 +29,17 @@ func initOpenAPIcatalogRouters(a *Web, e *echo.Echo) {
 }
 
 func (a *Web) DeleteCatalogUUID(ctx context.Context, request oapi.DeleteCatalogUUIDRequestObject) (oapi.DeleteCatalogUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CatalogService.DeleteCatalog(request.UUID)
+	_, err := a.catalogPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CatalogService.DeleteCatalog(request.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +62,11 @@ func (a *Web) PostCatalog(ctx context.Context, request oapi.PostCatalogRequestOb
 		return nil, dto.NotFoundErr("Компания или федерация не найдены")
 	}
 
+	err = a.app.GateService.CatalogPatch(request.Body.CompanyUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	if helpers.InArray(request.Body.Name, catalogs.GetReservedNames()) {
 		return nil, errors.New("название каталога зарезервировано (он уже создан)")
 	}
@@ -184,6 +194,11 @@ func (a *Web) PostCatalogUUIDFields(ctx context.Context, request oapi.PostCatalo
 		return nil, ErrInvalidAuthHeader
 	}
 
+	_, err := a.catalogPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	pf := domain.NewCatalogFiled(request.Body.Name, "", request.Body.DataType, request.Body.DataUuid, request.UUID, claims.Email)
 
 	dt, err := a.app.CatalogService.CreateCatalogField(pf)
@@ -207,6 +222,11 @@ func (a *Web) PostCatalogUUIDFieldsNamed(ctx context.Context, request oapi.PostC
 		return nil, ErrInvalidAuthHeader
 	}
 
+	_, err := a.catalogPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	pf := domain.NewCatalogFiled(request.Body.Name, request.Body.Hash, request.Body.DataType, nil, request.UUID, claims.Email)
 
 	dt, err := a.app.CatalogService.CreateCatalogField(pf)
@@ -223,18 +243,27 @@ func (a *Web) PostCatalogUUIDFieldsNamed(ctx context.Context, request oapi.PostC
 }
 
 func (a *Web) PutCatalogUUIDFieldsEntityUUID(ctx context.Context, request oapi.PutCatalogUUIDFieldsEntityUUIDRequestObject) (oapi.PutCatalogUUIDFieldsEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	catalog, err := a.catalogPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lo.ContainsBy(catalog.Fields, func(f domain.CatalogFiled) bool { return f.UUID == request.EntityUUID }) {
+		return nil, dto.NotFoundErr("поле не найдено")
+	}
+
 	pf := &domain.CatalogFiled{
 		CatalogUUID: request.UUID,
 		UUID:        request.EntityUUID,
 		Name:        request.Body.Name,
 	}
 
-	err := a.app.CatalogService.PutCatalogField(pf)
+	err = a.app.CatalogService.PutCatalogField(pf)
 	if err != nil {
 		return nil, err
 	}
@@ -271,12 +300,17 @@ func (a *Web) GetCatalogUUIDFields(ctx context.Context, request oapi.GetCatalogU
 }
 
 func (a *Web) PatchCatalogUUIDName(ctx context.Context, request oapi.PatchCatalogUUIDNameRequestObject) (oapi.PatchCatalogUUIDNameResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CatalogService.ChangeCatalogName(request.UUID, request.Body.Name)
+	_, err := a.catalogPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CatalogService.ChangeCatalogName(request.UUID, request.Body.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -285,12 +319,21 @@ func (a *Web) PatchCatalogUUIDName(ctx context.Context, request oapi.PatchCatalo
 }
 
 func (a *Web) DeleteCatalogUUIDFieldsEntityUUID(ctx context.Context, request oapi.DeleteCatalogUUIDFieldsEntityUUIDRequestObject) (oapi.DeleteCatalogUUIDFieldsEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CatalogService.DeleteCatalogField(request.EntityUUID)
+	catalog, err := a.catalogPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lo.ContainsBy(catalog.Fields, func(f domain.CatalogFiled) bool { return f.UUID == request.EntityUUID }) {
+		return nil, dto.NotFoundErr("поле не найдено")
+	}
+
+	err = a.app.CatalogService.DeleteCatalogField(request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -309,6 +352,11 @@ func (a *Web) PostCatalogUUIDData(ctx context.Context, request oapi.PostCatalogU
 		return nil, err
 	}
 
+	err = a.app.GateService.CatalogDataCreate(catalog.CompanyUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	catalogData := domain.CatalogData{
 		UUID:           uuid.New(),
 		FederationUUID: catalog.FederationUUID,
@@ -420,3 +468,13 @@ func (a *Web) GetCatalogUUIDData(ctx context.Context, request oapi.GetCatalogUUI
 		},
 	}, nil
 }
+
+// catalogPatch - loads the catalog and checks the stored rules of its company.
+func (a *Web) catalogPatch(catalogUUID, userUUID uuid.UUID) (domain.Catalog, error) {
+	catalog, err := a.app.CatalogService.GetCatalog(catalogUUID)
+	if err != nil {
+		return catalog, err
+	}
+
+	return catalog, a.app.GateService.CatalogPatch(catalog.CompanyUUID, userUUID)
+}
@@ -11,11 +11,16 @@ import (
 )
 
 func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompanyUUIDFieldsRequestObject) (oapi.PostCompanyUUIDFieldsResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	pf := &domain.CompanyField{
 		CompanyUUID: request.UUID,
 		Name:        request.Body.Name,
@@ -39,11 +44,16 @@ func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompan
 }
 
 func (a *Web) PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request oapi.PutCompanyUUIDFieldsEntityUUIDRequestObject) (oapi.PutCompanyUUIDFieldsEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	pf := &domain.CompanyField{
 		CompanyUUID:        request.UUID,
 		UUID:               request.EntityUUID,
@@ -53,7 +63,7 @@ func (a *Web) PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request oapi.P
 		RequiredOnStatuses: request.Body.RequiredOnStatuses,
 	}
 
-	err := a.app.FederationService.PutCompanyField(pf)
+	err = a.app.FederationService.PutCompanyField(pf)
 	if err != nil {
 		return nil, err
 	}
@@ -98,12 +108,17 @@ func (a *Web) GetCompanyUUIDFields(ctx context.Context, request oapi.GetCompanyU
 
 // DeleteCompanyUUIDFieldsEntityUUID implements ofederation.StrictServerInterface.
 func (a *Web) DeleteCompanyUUIDFieldsEntityUUID(ctx context.Context, request oapi.DeleteCompanyUUIDFieldsEntityUUIDRequestObject) (oapi.DeleteCompanyUUIDFieldsEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteCompanyField(request.EntityUUID)
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteCompanyField(request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -12,12 +12,17 @@ import (
 )
 
 func (a *Web) DeleteCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request oapi.DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject) (oapi.DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CompanyService.DeleteCompanyPriority(request.EntityUUID)
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CompanyService.DeleteCompanyPriority(request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -50,12 +55,17 @@ func (a *Web) GetCompanyUUIDPriorities(ctx context.Context, request oapi.GetComp
 }
 
 func (a *Web) PatchCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request oapi.PatchCompanyUUIDPrioritiesEntityUUIDRequestObject) (oapi.PatchCompanyUUIDPrioritiesEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CompanyService.UpdateCompanyPriority(request.EntityUUID, request.Body.Name, request.Body.Color)
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CompanyService.UpdateCompanyPriority(request.EntityUUID, request.Body.Name, request.Body.Color)
 	if err != nil {
 		return nil, err
 	}
@@ -64,11 +74,16 @@ func (a *Web) PatchCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request
 }
 
 func (a *Web) PostCompanyUUIDPriorities(ctx context.Context, request oapi.PostCompanyUUIDPrioritiesRequestObject) (oapi.PostCompanyUUIDPrioritiesResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	dm := domain.CompanyPriority{
 		UUID:        uuid.New(),
 		CompanyUUID: request.UUID,
@@ -77,7 +92,7 @@ func (a *Web) PostCompanyUUIDPriorities(ctx context.Context, request oapi.PostCo
 		Color:       request.Body.Color,
 	}
 
-	err := a.app.CompanyService.CreateCompanyPriority(dm)
+	err = a.app.CompanyService.CreateCompanyPriority(dm)
 	if err != nil {
 		return nil, err
 	}
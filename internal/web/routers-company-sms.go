@@ -47,12 +47,17 @@ func (a *Web) PostCompanyUUIDSmsCost(ctx context.Context, request oapi.PostCompa
 }
 
 func (a *Web) PostCompanyUUIDSmsOptions(ctx context.Context, request oapi.PostCompanyUUIDSmsOptionsRequestObject) (oapi.PostCompanyUUIDSmsOptionsResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CompanyService.CreateSmsOptions(request.UUID, company.SmsOptions{
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CompanyService.CreateSmsOptions(request.UUID, company.SmsOptions{
 		API:  request.Body.Api,
 		From: request.Body.From,
 	})
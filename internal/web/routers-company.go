@@ -18,9 +18,14 @@ func (a *Web) PostCompany(ctx context.Context, request oapi.PostCompanyRequestOb
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.CompanyCreate(request.Body.FederationUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	company := domain.NewCompany(request.Body.Name, request.Body.FederationUuid, claims.Email, claims.UUID)
 
-	err := a.app.FederationService.CreateCompany(company, true)
+	err = a.app.FederationService.CreateCompany(company, true)
 	if err != nil {
 		return nil, err
 	}
@@ -91,12 +96,17 @@ func (a *Web) GetCompanyUUID(ctx context.Context, request oapi.GetCompanyUUIDReq
 }
 
 func (a *Web) PatchCompanyUUIDName(ctx context.Context, request oapi.PatchCompanyUUIDNameRequestObject) (oapi.PatchCompanyUUIDNameResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.ChangeCompanyName(request.UUID, request.Body.Name)
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.ChangeCompanyName(request.UUID, request.Body.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -105,12 +115,17 @@ func (a *Web) PatchCompanyUUIDName(ctx context.Context, request oapi.PatchCompan
 }
 
 func (a *Web) DeleteCompanyUUID(ctx context.Context, request oapi.DeleteCompanyUUIDRequestObject) (oapi.DeleteCompanyUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteCompany(request.UUID)
+	err := a.app.GateService.CompanyDelete(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteCompany(request.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -119,11 +134,16 @@ func (a *Web) DeleteCompanyUUID(ctx context.Context, request oapi.DeleteCompanyU
 }
 
 func (a *Web) PostCompanyUUIDUser(ctx context.Context, request oapi.PostCompanyUUIDUserRequestObject) (oapi.PostCompanyUUIDUserResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.CompanyAddUser(request.UUID, request.Body.UserUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	federationDTO, err := a.app.FederationService.GetCompanyFederation(ctx, request.UUID)
 	if err != nil {
 		return nil, err
@@ -146,12 +166,17 @@ func (a *Web) PostCompanyUUIDUser(ctx context.Context, request oapi.PostCompanyU
 }
 
 func (a *Web) DeleteCompanyUUIDUserUserUUID(ctx context.Context, request oapi.DeleteCompanyUUIDUserUserUUIDRequestObject) (oapi.DeleteCompanyUUIDUserUserUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteUserFromCompany(request.UUID, request.UserUUID)
+	err := a.app.GateService.CompanyRemoveUser(request.UUID, request.UserUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteUserFromCompany(request.UUID, request.UserUUID)
 	if err != nil {
 		return nil, err
 	}
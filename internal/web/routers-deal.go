@@ -0,0 +1,489 @@
+package web
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/odeal"
+	echo "github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+func initOpenAPIDealRouters(a *Web, e *echo.Echo) {
+	logrus.WithField("route", "oDeal").Debug("routes initialization")
+
+	midlewares := []oapi.StrictMiddlewareFunc{
+		ValidateStructMiddeware,
+		AuthMiddeware(a.app, []string{}),
+	}
+
+	handlers := oapi.NewStrictHandler(a, midlewares)
+	oapi.RegisterHandlers(e, handlers)
+}
+
+func (a *Web) PostDeal(ctx context.Context, request oapi.PostDealRequestObject) (oapi.PostDealResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	company, ok := a.app.DictionaryService.FindCompany(request.Body.CompanyUuid)
+	if !ok || company.FederationUUID != request.Body.FederationUuid {
+		return nil, fmt.Errorf("компания не найдена")
+	}
+
+	deal, err := domain.NewDeal(
+		request.Body.Name,
+		lo.FromPtr(request.Body.Description),
+		request.Body.FederationUuid,
+		request.Body.CompanyUuid,
+		claims.Email,
+		lo.FromPtr(request.Body.Tags),
+		lo.FromPtr(request.Body.Priority),
+		lo.FromPtr(request.Body.Fields),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deal.Amount = lo.FromPtr(request.Body.Amount)
+	deal.StageUUID = request.Body.StageUuid
+
+	err = a.app.GateService.DealCreate(deal, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := a.app.DealsService.CreateDeal(domain.NewCreatorFromUser(&claims), deal)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostDeal200JSONResponse{
+		Id:   id,
+		Uuid: deal.UUID,
+	}, nil
+}
+
+func (a *Web) GetDeal(ctx context.Context, request oapi.GetDealRequestObject) (oapi.GetDealResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.DealsSearch(request.Params.CompanyUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	filterDto, err := dto.NewFilterDTO(request.Params.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dto.DealSearchDTO{
+		FederationUUID: request.Params.FederationUuid,
+		CompanyUUID:    request.Params.CompanyUuid,
+		MyEmail:        &claims.Email,
+
+		Name:     request.Params.Name,
+		Status:   request.Params.Status,
+		Tags:     request.Params.Tags,
+		IsMy:     request.Params.IsMy,
+		Offset:   request.Params.Offset,
+		Limit:    request.Params.Limit,
+		Priority: request.Params.Priority,
+		Fields:   filterDto,
+
+		StageUUID: request.Params.StageUuid,
+
+		Order: request.Params.Order,
+		By:    request.Params.By,
+	}
+
+	err = filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	dms, total, err := a.app.DealsService.GetDeals(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetDeal200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Deal, _ int) dto.DealDTO {
+			return dto.NewDealDTO(item, a.app.DictionaryService)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) GetDealUUID(ctx context.Context, request oapi.GetDealUUIDRequestObject) (oapi.GetDealUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	deal, err := a.app.DealsService.GetDeal(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.DealGet(deal, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetDealUUID200JSONResponse(dto.NewDealDTO(deal, a.app.DictionaryService)), nil
+}
+
+func (a *Web) PatchDealUUID(ctx context.Context, request oapi.PatchDealUUIDRequestObject) (oapi.PatchDealUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	deal, err := a.app.DealsService.GetDeal(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.DealPatch(deal, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	shouldUpdate := []string{}
+
+	if request.Body.Name != nil {
+		err = deal.PatchName(*request.Body.Name)
+		if err != nil {
+			return nil, err
+		}
+		shouldUpdate = append(shouldUpdate, "name")
+	}
+
+	if request.Body.Description != nil {
+		deal.Description = *request.Body.Description
+		shouldUpdate = append(shouldUpdate, "description")
+	}
+
+	if request.Body.Tags != nil {
+		deal.Tags = lo.Uniq(*request.Body.Tags)
+		shouldUpdate = append(shouldUpdate, "tags")
+	}
+
+	if request.Body.Priority != nil {
+		deal.Priority = *request.Body.Priority
+		shouldUpdate = append(shouldUpdate, "priority")
+	}
+
+	if request.Body.Amount != nil {
+		deal.Amount = *request.Body.Amount
+		shouldUpdate = append(shouldUpdate, "amount")
+	}
+
+	if request.Body.Status != nil && *request.Body.Status != deal.Status {
+		err = deal.PatchStatus(*request.Body.Status)
+		if err != nil {
+			return nil, err
+		}
+		shouldUpdate = append(shouldUpdate, "status", "finished_at")
+	}
+
+	if request.Body.Fields != nil {
+		deal.RawFields = *request.Body.Fields
+		shouldUpdate = append(shouldUpdate, "fields")
+	}
+
+	err = a.app.DealsService.UpdateDeal(domain.NewCreatorFromUser(&claims), deal, shouldUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchDealUUID200Response{}, nil
+}
+
+func (a *Web) DeleteDealUUID(ctx context.Context, request oapi.DeleteDealUUIDRequestObject) (oapi.DeleteDealUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	deal, err := a.app.DealsService.GetDeal(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.DealDelete(deal, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.DealsService.DeleteDeal(domain.NewCreatorFromUser(&claims), request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteDealUUID200Response{}, nil
+}
+
+func (a *Web) GetDealUUIDActivity(ctx context.Context, request oapi.GetDealUUIDActivityRequestObject) (oapi.GetDealUUIDActivityResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	deal, err := a.app.DealsService.GetDeal(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.DealGet(deal, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := lo.FromPtr(request.Params.Offset)
+	limit := lo.FromPtr(request.Params.Limit)
+
+	dms, total, err := a.app.DealsService.GetActivities(request.UUID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetDealUUIDActivity200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Activity, _ int) dto.ActivityDTO {
+			createdBy, f := a.app.DictionaryService.FindUser(item.CreatedBy.Email)
+			if !f {
+				logrus.Errorf("activity created by not found: %s", item.CreatedBy.Email)
+			}
+
+			return *dto.NewActivityDTO(item, *createdBy)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) GetDealBoard(ctx context.Context, request oapi.GetDealBoardRequestObject) (oapi.GetDealBoardResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.DealsSearch(request.Params.CompanyUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dto.DealSearchDTO{
+		FederationUUID: request.Params.FederationUuid,
+		CompanyUUID:    request.Params.CompanyUuid,
+		MyEmail:        &claims.Email,
+
+		Name:   request.Params.Name,
+		Status: request.Params.Status,
+		Tags:   request.Params.Tags,
+		IsMy:   request.Params.IsMy,
+	}
+
+	if filter.Status == nil {
+		filter.Status = lo.ToPtr(domain.DealStatusOpen)
+	}
+
+	err = filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := lo.FromPtr(request.Params.Limit)
+	if limit == 0 {
+		limit = 20
+	}
+
+	board, err := a.app.DealsService.GetBoard(filter, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetDealBoard200JSONResponse(dto.NewDealBoardDTO(board, a.app.DictionaryService)), nil
+}
+
+func (a *Web) PatchDealUUIDStage(ctx context.Context, request oapi.PatchDealUUIDStageRequestObject) (oapi.PatchDealUUIDStageResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	deal, err := a.app.DealsService.GetDeal(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.DealPatch(deal, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.DealsService.MoveDeal(domain.NewCreatorFromUser(&claims), deal, request.Body.StageUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchDealUUIDStage200Response{}, nil
+}
+
+func (a *Web) GetCompanyUUIDDealStage(ctx context.Context, request oapi.GetCompanyUUIDDealStageRequestObject) (oapi.GetCompanyUUIDDealStageResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.DealStages(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.DealsService.GetStages(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDDealStage200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.DealStage, _ int) dto.DealStageDTO {
+			return dto.NewDealStageDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDDealStage(ctx context.Context, request oapi.PostCompanyUUIDDealStageRequestObject) (oapi.PostCompanyUUIDDealStageResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.DealStagesEdit(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	stage, err := domain.NewDealStage(
+		request.UUID,
+		request.Body.Name,
+		lo.FromPtr(request.Body.Color),
+		request.Body.Probability,
+		lo.FromPtr(request.Body.Transitions),
+		claims.Email,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.DealsService.CreateStage(stage)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDDealStage200JSONResponse{
+		Uuid: stage.UUID,
+	}, nil
+}
+
+func (a *Web) PatchCompanyUUIDDealStageSort(ctx context.Context, request oapi.PatchCompanyUUIDDealStageSortRequestObject) (oapi.PatchCompanyUUIDDealStageSortResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.DealStagesEdit(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.DealsService.SortStages(request.UUID, request.Body.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchCompanyUUIDDealStageSort200Response{}, nil
+}
+
+func (a *Web) PatchCompanyUUIDDealStageEntityUUID(ctx context.Context, request oapi.PatchCompanyUUIDDealStageEntityUUIDRequestObject) (oapi.PatchCompanyUUIDDealStageEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.DealStagesEdit(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	stage, err := a.app.DealsService.GetStage(request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if stage.CompanyUUID != request.UUID {
+		return nil, domain.ErrDealStageNotFound
+	}
+
+	if request.Body.Name != nil {
+		stage.Name = *request.Body.Name
+	}
+
+	if request.Body.Color != nil {
+		stage.Color = *request.Body.Color
+	}
+
+	if request.Body.Probability != nil {
+		stage.Probability = *request.Body.Probability
+	}
+
+	if request.Body.Transitions != nil {
+		stage.Transitions = lo.Uniq(*request.Body.Transitions)
+	}
+
+	err = a.app.DealsService.UpdateStage(stage)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchCompanyUUIDDealStageEntityUUID200Response{}, nil
+}
+
+func (a *Web) DeleteCompanyUUIDDealStageEntityUUID(ctx context.Context, request oapi.DeleteCompanyUUIDDealStageEntityUUIDRequestObject) (oapi.DeleteCompanyUUIDDealStageEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.DealStagesEdit(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	stage, err := a.app.DealsService.GetStage(request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if stage.CompanyUUID != request.UUID {
+		return nil, domain.ErrDealStageNotFound
+	}
+
+	err = a.app.DealsService.DeleteStage(stage)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteCompanyUUIDDealStageEntityUUID200Response{}, nil
+}
@@ -3,6 +3,7 @@ package web
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/jwt"
@@ -63,14 +64,19 @@ func (a *Web) GetFederationUUIDInvite(ctx context.Context, request oapi.GetFeder
 }
 
 func (a *Web) PostFederationUUIDInvite(ctx context.Context, request oapi.PostFederationUUIDInviteRequestObject) (oapi.PostFederationUUIDInviteResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.FederationAddUser(request.UUID, uuid.Nil, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	invite := domain.NewInvite(request.Body.Email, request.UUID, request.Body.CompanyUuid)
 
-	err := a.app.FederationService.InviteUser(invite)
+	err = a.app.FederationService.InviteUser(invite)
 	if err != nil {
 		return nil, err
 	}
@@ -81,12 +87,17 @@ func (a *Web) PostFederationUUIDInvite(ctx context.Context, request oapi.PostFed
 }
 
 func (a *Web) DeleteFederationUUIDInviteEntityUUID(ctx context.Context, request oapi.DeleteFederationUUIDInviteEntityUUIDRequestObject) (oapi.DeleteFederationUUIDInviteEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteInvite(request.EntityUUID)
+	err := a.app.GateService.FederationAddUser(request.UUID, uuid.Nil, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteInvite(request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
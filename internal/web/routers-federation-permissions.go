@@ -8,10 +8,11 @@ import (
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/jwt"
 	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
 )
 
 func (a *Web) PostPermissions(ctx context.Context, request oapi.PostPermissionsRequestObject) (oapi.PostPermissionsResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
@@ -49,7 +50,12 @@ func (a *Web) PostPermissions(ctx context.Context, request oapi.PostPermissionsR
 		},
 	}
 
-	err := a.app.GateService.CreateOrUpdatePermisson(&perm)
+	err := a.app.GateService.PermissionGrant(perm.FederationUUID, claims.UUID, perm.UserUUID, perm.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.CreateOrUpdatePermisson(&perm)
 	if err != nil {
 		return nil, err
 	}
@@ -61,12 +67,17 @@ func (a *Web) PostPermissions(ctx context.Context, request oapi.PostPermissionsR
 }
 
 func (a *Web) GetPermissionsUUID(ctx context.Context, request oapi.GetPermissionsUUIDRequestObject) (oapi.GetPermissionsUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	dm, err := a.app.GateService.GetPermisson(request.UUID)
+	err := a.app.GateService.RolesView(request.Params.FederationUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.GateService.GetPermisson(request.Params.FederationUuid, request.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -81,15 +92,152 @@ func (a *Web) GetPermissionsUUID(ctx context.Context, request oapi.GetPermission
 }
 
 func (a *Web) DeletePermissionsUUID(ctx context.Context, request oapi.DeletePermissionsUUIDRequestObject) (oapi.DeletePermissionsUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.GateService.DeletePermission(request.UUID)
+	err := a.app.GateService.PermissionGrant(request.Params.FederationUuid, claims.UUID, request.UUID, domain.PermissionRules{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.DeletePermission(request.Params.FederationUuid, request.UUID)
 	if err != nil {
 		return nil, err
 	}
 
 	return oapi.DeletePermissionsUUID200Response{}, nil
 }
+
+func (a *Web) GetPermissionsRoles(ctx context.Context, _ oapi.GetPermissionsRolesRequestObject) (oapi.GetPermissionsRolesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	roles := a.app.PermissionsService.Roles()
+
+	return oapi.GetPermissionsRoles200JSONResponse{
+		Count: len(roles),
+		Items: lo.Map(roles, func(item domain.PermissionRole, _ int) dto.PermissionRoleDTO {
+			return dto.NewPermissionRoleDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) GetPermissionsUUIDEffective(ctx context.Context, request oapi.GetPermissionsUUIDEffectiveRequestObject) (oapi.GetPermissionsUUIDEffectiveResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.GateService.Scope(request.Params.EntityUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.RolesView(scope.FederationUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, superuser, err := a.app.GateService.EffectiveRules(scope, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetPermissionsUUIDEffective200JSONResponse{
+		UserUUID:   request.UUID,
+		EntityUUID: scope.EntityUUID(),
+		Scope:      scope.Type(),
+		Superuser:  superuser,
+		Rules:      dto.PermissionRulesDTO(rules),
+	}, nil
+}
+
+func (a *Web) GetPermissionsUUIDRoles(ctx context.Context, request oapi.GetPermissionsUUIDRolesRequestObject) (oapi.GetPermissionsUUIDRolesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.RolesView(request.Params.FederationUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.PermissionsService.GetUserRoles(request.UUID, request.Params.FederationUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetPermissionsUUIDRoles200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.PermissionRoleAssignment, _ int) dto.PermissionRoleAssignmentDTO {
+			return dto.NewPermissionRoleAssignmentDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostPermissionsUUIDRoles(ctx context.Context, request oapi.PostPermissionsUUIDRolesRequestObject) (oapi.PostPermissionsUUIDRolesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.GateService.Scope(request.Body.EntityUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := domain.NewPermissionRoleAssignment(
+		request.UUID,
+		scope,
+		lo.FromPtr(request.Body.Role),
+		lo.FromPtr(request.Body.Allow),
+		lo.FromPtr(request.Body.Deny),
+		claims.Email,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.RoleAssign(scope, claims.UUID, request.UUID, dm.Apply(domain.PermissionRules{}))
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.PermissionsService.AssignRole(dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostPermissionsUUIDRoles200JSONResponse{
+		Uuid: dm.UUID,
+	}, nil
+}
+
+func (a *Web) DeletePermissionsUUIDRolesEntityUUID(ctx context.Context, request oapi.DeletePermissionsUUIDRolesEntityUUIDRequestObject) (oapi.DeletePermissionsUUIDRolesEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.GateService.Scope(request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.RoleRemove(scope, claims.UUID, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.PermissionsService.RemoveRole(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeletePermissionsUUIDRolesEntityUUID200Response{}, nil
+}
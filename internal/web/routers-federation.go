@@ -98,12 +98,17 @@ func (a *Web) GetFederationUUID(ctx context.Context, request oapi.GetFederationU
 }
 
 func (a *Web) DeleteFederationUUID(ctx context.Context, request oapi.DeleteFederationUUIDRequestObject) (oapi.DeleteFederationUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteFederation(request.UUID)
+	err := a.app.GateService.FederationDelete(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteFederation(request.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -112,14 +117,19 @@ func (a *Web) DeleteFederationUUID(ctx context.Context, request oapi.DeleteFeder
 }
 
 func (a *Web) PostFederationUUIDUser(ctx context.Context, request oapi.PostFederationUUIDUserRequestObject) (oapi.PostFederationUUIDUserResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.FederationAddUser(request.UUID, request.Body.UserUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	fu := domain.NewFederationUser(request.UUID, request.Body.UserUuid)
 
-	err := a.app.FederationService.AddUser(*fu)
+	err = a.app.FederationService.AddUser(*fu)
 	if err != nil {
 		return nil, err
 	}
@@ -130,12 +140,17 @@ func (a *Web) PostFederationUUIDUser(ctx context.Context, request oapi.PostFeder
 }
 
 func (a *Web) DeleteFederationUUIDUserUserUUID(ctx context.Context, request oapi.DeleteFederationUUIDUserUserUUIDRequestObject) (oapi.DeleteFederationUUIDUserUserUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteUser(request.UUID, request.UserUUID)
+	err := a.app.GateService.FederationRemoveUser(request.UUID, request.UserUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteUser(request.UUID, request.UserUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -145,12 +160,17 @@ func (a *Web) DeleteFederationUUIDUserUserUUID(ctx context.Context, request oapi
 
 // PatchFederationUUIDName implements ofederation.StrictServerInterface.
 func (a *Web) PatchFederationUUIDName(ctx context.Context, request oapi.PatchFederationUUIDNameRequestObject) (oapi.PatchFederationUUIDNameResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.ChangeName(request.UUID, request.Body.Name)
+	err := a.app.GateService.FederationPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.ChangeName(request.UUID, request.Body.Name)
 	if err != nil {
 		return nil, err
 	}
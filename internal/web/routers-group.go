@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
+
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/jwt"
@@ -14,11 +16,16 @@ import (
 /// GROUPS
 
 func (a *Web) PostCompanyUUIDGroup(ctx context.Context, request oapi.PostCompanyUUIDGroupRequestObject) (oapi.PostCompanyUUIDGroupResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	company, found := a.app.DictionaryService.FindCompany(request.UUID)
 	if !found {
 		return nil, errors.New("компания не найдена")
@@ -26,7 +33,7 @@ func (a *Web) PostCompanyUUIDGroup(ctx context.Context, request oapi.PostCompany
 
 	group := domain.NewGroup(request.Body.Name, company.FederationUUID, company.UUID)
 
-	err := a.app.FederationService.CreateGroup(group)
+	err = a.app.FederationService.CreateGroup(group)
 	if err != nil {
 		return nil, err
 	}
@@ -59,12 +66,21 @@ func (a *Web) GetCompanyUUIDGroup(ctx context.Context, request oapi.GetCompanyUU
 }
 
 func (a *Web) PatchCompanyUUIDGroupEntityUUID(ctx context.Context, request oapi.PatchCompanyUUIDGroupEntityUUIDRequestObject) (oapi.PatchCompanyUUIDGroupEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.ChangeGroupName(request.EntityUUID, request.Body.Name)
+	group, err := a.companyGroup(request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if group.CompanyUUID != request.UUID {
+		return nil, dto.NotFoundErr("группа не найдена")
+	}
+
+	err = a.app.FederationService.ChangeGroupName(request.EntityUUID, request.Body.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +89,21 @@ func (a *Web) PatchCompanyUUIDGroupEntityUUID(ctx context.Context, request oapi.
 }
 
 func (a *Web) DeleteCompanyUUIDGroupEntityUUID(ctx context.Context, request oapi.DeleteCompanyUUIDGroupEntityUUIDRequestObject) (oapi.DeleteCompanyUUIDGroupEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteGroup(request.EntityUUID)
+	group, err := a.companyGroup(request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if group.CompanyUUID != request.UUID {
+		return nil, dto.NotFoundErr("группа не найдена")
+	}
+
+	err = a.app.FederationService.DeleteGroup(request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +143,16 @@ func (a *Web) PostGroupUUIDUser(ctx context.Context, request oapi.PostGroupUUIDU
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.AddUserToGroup(request.Body.Uuid, request.UUID, claims.Email, claims.UUID)
+	group, err := a.companyGroup(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if lo.IndexOf(a.app.DictionaryService.GetUserCompanies(request.Body.Uuid), group.CompanyUUID) == -1 {
+		return nil, dto.NotFoundErr("пользователь не найден в компании")
+	}
+
+	err = a.app.FederationService.AddUserToGroup(request.Body.Uuid, request.UUID, claims.Email, claims.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -127,15 +161,30 @@ func (a *Web) PostGroupUUIDUser(ctx context.Context, request oapi.PostGroupUUIDU
 }
 
 func (a *Web) DeleteGroupUUIDUser(ctx context.Context, request oapi.DeleteGroupUUIDUserRequestObject) (oapi.DeleteGroupUUIDUserResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.RemoveUserFromGroups(request.UUID, request.Body.Uuid)
+	_, err := a.companyGroup(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.RemoveUserFromGroups(request.UUID, request.Body.Uuid)
 	if err != nil {
 		return nil, err
 	}
 
 	return oapi.DeleteGroupUUIDUser200Response{}, nil
 }
+
+// companyGroup - loads the group and checks the stored rules of its company.
+func (a *Web) companyGroup(groupUUID, userUUID uuid.UUID) (domain.Group, error) {
+	group, err := a.app.FederationService.GetGroup(groupUUID)
+	if err != nil {
+		return group, err
+	}
+
+	return group, a.app.GateService.CompanyPatch(group.CompanyUUID, userUUID)
+}
@@ -32,6 +32,8 @@ func initOpenAPIProfileRouters(a *Web, e *echo.Echo) {
 			"PostProfileValidate",
 			"PostProfileValidateSend",
 			"PatchProfilePreferences",
+			"GetProfilePreferencesNotifications",
+			"PutProfilePreferencesNotifications",
 			"PatchProfilePassword",
 			"PatchProfilePhoto",
 			"DeleteProfilePhoto",
@@ -663,3 +665,41 @@ func (a *Web) PatchProfilePreferences(ctx context.Context, request oapi.PatchPro
 
 	return oapi.PatchProfilePreferences200Response{}, nil
 }
+
+func (a *Web) GetProfilePreferencesNotifications(ctx context.Context, _ oapi.GetProfilePreferencesNotificationsRequestObject) (oapi.GetProfilePreferencesNotificationsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.ProfileService.GetUser(ctx, claims.UUID, "preferences")
+	if err != nil {
+		return nil, err
+	}
+
+	prefs := lo.FromPtr(dm.Preferences.Notifications).WithDefaults()
+
+	return oapi.GetProfilePreferencesNotifications200JSONResponse(prefs), nil
+}
+
+func (a *Web) PutProfilePreferencesNotifications(ctx context.Context, request oapi.PutProfilePreferencesNotificationsRequestObject) (oapi.PutProfilePreferencesNotificationsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("preferences is nil")
+	}
+
+	prefs := *request.Body
+
+	err := a.app.ProfileService.ChangePreferences(claims.UUID, domain.ProfilePreferences{
+		Notifications: &prefs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutProfilePreferencesNotifications200Response{}, nil
+}
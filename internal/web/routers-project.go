@@ -30,12 +30,17 @@ func initOpenAPIProjectRouters(a *Web, e *echo.Echo) {
 
 // DeleteProjectUUID is a method that needs to be added to the *Web struct to implement the oapi.StrictServerInterface interface.
 func (a *Web) DeleteProjectUUID(ctx context.Context, request oapi.DeleteProjectUUIDRequestObject) (oapi.DeleteProjectUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteProject(request.UUID.String())
+	err := a.app.GateService.ProjectDelete(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteProject(request.UUID.String())
 	if err != nil {
 		return nil, err
 	}
@@ -67,11 +72,17 @@ func (a *Web) PostProject(ctx context.Context, request oapi.PostProjectRequestOb
 			RequireDoneComment:        helpers.Ptr(false),
 			StatusEnable:              helpers.Ptr(false),
 			Color:                     helpers.Ptr("#000000"),
+			Private:                   helpers.Ptr(false),
 		},
 		StatusSort: request.Body.StatusSort,
 		FieldsSort: request.Body.FieldsSort,
 	}
 
+	err = a.app.GateService.ProjectCreate(*project, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	err = a.app.FederationService.CreateProgect(project)
 	if err != nil {
 		return nil, err
@@ -336,12 +347,17 @@ func (a *Web) GetFederationUUIDProject(ctx context.Context, request oapi.GetFede
 }
 
 func (a *Web) PatchProjectUUIDName(ctx context.Context, request oapi.PatchProjectUUIDNameRequestObject) (oapi.PatchProjectUUIDNameResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.ChangeProjectName(request.UUID, request.Body.Name)
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.ChangeProjectName(request.UUID, request.Body.Name)
 	if err != nil {
 		return nil, ErrInvalidAuthHeader
 	}
@@ -350,12 +366,17 @@ func (a *Web) PatchProjectUUIDName(ctx context.Context, request oapi.PatchProjec
 }
 
 func (a *Web) PatchProjectUUIDDescription(ctx context.Context, request oapi.PatchProjectUUIDDescriptionRequestObject) (oapi.PatchProjectUUIDDescriptionResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.ChangeProjectDescription(request.UUID, request.Body.Description)
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.ChangeProjectDescription(request.UUID, request.Body.Description)
 	if err != nil {
 		return nil, ErrInvalidAuthHeader
 	}
@@ -364,20 +385,28 @@ func (a *Web) PatchProjectUUIDDescription(ctx context.Context, request oapi.Patc
 }
 
 func (a *Web) PatchProjectUUIDOptions(ctx context.Context, request oapi.PatchProjectUUIDOptionsRequestObject) (oapi.PatchProjectUUIDOptionsResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	if request.Body == nil {
 		return nil, errors.New("options is nil")
 	}
 
-	err := a.app.FederationService.ChangeProjectOptions(request.UUID, domain.ProjectOptions{
+	err = a.app.FederationService.ChangeProjectOptions(request.UUID, domain.ProjectOptions{
 		RequireCancelationComment: request.Body.RequireCancelationComment,
 		RequireDoneComment:        request.Body.RequireDoneComment,
 		StatusEnable:              request.Body.StatusEnable,
 		Color:                     request.Body.Color,
+		Private:                   request.Body.Private,
+		DeadlineLeadHours:         request.Body.DeadlineLeadHours,
+		DeadlineEscalateHours:     request.Body.DeadlineEscalateHours,
 	})
 	if err != nil {
 		return nil, ErrInvalidAuthHeader
@@ -392,11 +421,16 @@ func (a *Web) PatchProjectUUID(ctx context.Context, request oapi.PatchProjectUUI
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	if request.Body == nil {
 		return nil, errors.New("options is nil")
 	}
 
-	err := a.app.FederationService.ChangeProjectParams(domain.NewCreatorFromUser(&claims), request.UUID, domain.ProjectParams{
+	err = a.app.FederationService.ChangeProjectParams(domain.NewCreatorFromUser(&claims), request.UUID, domain.ProjectParams{
 		Status:        request.Body.Status,
 		StatusSort:    request.Body.StatusSort,
 		FieldsSort:    request.Body.FieldsSort,
@@ -410,11 +444,16 @@ func (a *Web) PatchProjectUUID(ctx context.Context, request oapi.PatchProjectUUI
 }
 
 func (a *Web) PatchProjectUUIDGraph(ctx context.Context, request oapi.PatchProjectUUIDGraphRequestObject) (oapi.PatchProjectUUIDGraphResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonStr, err := json.Marshal(request.Body.Graph)
 	if err != nil {
 		return nil, err
@@ -443,11 +482,16 @@ func (a *Web) PatchProjectUUIDGraph(ctx context.Context, request oapi.PatchProje
 }
 
 func (a *Web) PostProjectUUIDUser(ctx context.Context, request oapi.PostProjectUUIDUserRequestObject) (oapi.PostProjectUUIDUserResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.ProjectAddUser(request.UUID, request.Body.UserUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	projectDTO, err := a.app.FederationService.GetProject(request.UUID)
 	if err != nil {
 		return nil, err
@@ -466,12 +510,17 @@ func (a *Web) PostProjectUUIDUser(ctx context.Context, request oapi.PostProjectU
 }
 
 func (a *Web) DeleteProjectUUIDUserUserUUID(ctx context.Context, request oapi.DeleteProjectUUIDUserUserUUIDRequestObject) (oapi.DeleteProjectUUIDUserUserUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteUserFromProject(request.UUID, request.UserUUID)
+	err := a.app.GateService.ProjectRemoveUser(request.UUID, request.UserUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteUserFromProject(request.UUID, request.UserUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -480,12 +529,17 @@ func (a *Web) DeleteProjectUUIDUserUserUUID(ctx context.Context, request oapi.De
 }
 
 func (a *Web) DeleteProjectUUIDCatalogEntityUUID(ctx context.Context, request oapi.DeleteProjectUUIDCatalogEntityUUIDRequestObject) (oapi.DeleteProjectUUIDCatalogEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteProject(request.UUID.String())
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteProject(request.UUID.String())
 	if err != nil {
 		return nil, err
 	}
@@ -574,11 +628,16 @@ func (a *Web) GetProjectUUIDCatalogEntityName(ctx context.Context, request oapi.
 }
 
 func (a *Web) PostProjectUUIDCatalog(ctx context.Context, request oapi.PostProjectUUIDCatalogRequestObject) (oapi.PostProjectUUIDCatalogResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	project, found := a.app.DictionaryService.FindProject(request.UUID)
 	if !found {
 		return nil, dto.NotFoundErr("проект не найден")
@@ -593,7 +652,7 @@ func (a *Web) PostProjectUUIDCatalog(ctx context.Context, request oapi.PostProje
 		Value:          request.Body.Value,
 	}
 
-	err := a.app.FederationService.CreateCatalogData(cd)
+	err = a.app.FederationService.CreateCatalogData(cd)
 	if err != nil {
 		return nil, err
 	}
@@ -604,17 +663,22 @@ func (a *Web) PostProjectUUIDCatalog(ctx context.Context, request oapi.PostProje
 }
 
 func (a *Web) PostProjectUUIDFieldEntityUUID(ctx context.Context, request oapi.PostProjectUUIDFieldEntityUUIDRequestObject) (oapi.PostProjectUUIDFieldEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	project, f := a.app.DictionaryService.FindProject(request.UUID)
 	if !f {
 		return nil, dto.NotFoundErr("проект не найден")
 	}
 
-	err := a.app.FederationService.AddProjectField(request.UUID, project.CompanyUUID, request.EntityUUID, request.Body.RequiredOnStatuses, request.Body.Style)
+	err = a.app.FederationService.AddProjectField(request.UUID, project.CompanyUUID, request.EntityUUID, request.Body.RequiredOnStatuses, request.Body.Style)
 	if err != nil {
 		return nil, err
 	}
@@ -623,12 +687,17 @@ func (a *Web) PostProjectUUIDFieldEntityUUID(ctx context.Context, request oapi.P
 }
 
 func (a *Web) DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request oapi.DeleteProjectUUIDFieldEntityUUIDRequestObject) (oapi.DeleteProjectUUIDFieldEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.RemoveProjectField(request.UUID, request.EntityUUID)
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.RemoveProjectField(request.UUID, request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -639,12 +708,17 @@ func (a *Web) DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request oapi
 //
 
 func (a *Web) DeleteProjectUUIDStatusEntityUUID(ctx context.Context, request oapi.DeleteProjectUUIDStatusEntityUUIDRequestObject) (oapi.DeleteProjectUUIDStatusEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.DeleteProjectStatus(request.EntityUUID)
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.DeleteProjectStatus(request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -679,12 +753,17 @@ func (a *Web) GetProjectUUIDStatus(ctx context.Context, request oapi.GetProjectU
 }
 
 func (a *Web) PatchProjectUUIDStatusEntityUUID(ctx context.Context, request oapi.PatchProjectUUIDStatusEntityUUIDRequestObject) (oapi.PatchProjectUUIDStatusEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.FederationService.UpdateProjectStatus(request.EntityUUID, request.Body.Name, request.Body.Color, request.Body.Description)
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.FederationService.UpdateProjectStatus(request.EntityUUID, request.Body.Name, request.Body.Color, request.Body.Description)
 	if err != nil {
 		return nil, err
 	}
@@ -693,11 +772,16 @@ func (a *Web) PatchProjectUUIDStatusEntityUUID(ctx context.Context, request oapi
 }
 
 func (a *Web) PostProjectUUIDStatus(ctx context.Context, request oapi.PostProjectUUIDStatusRequestObject) (oapi.PostProjectUUIDStatusResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.app.GateService.ProjectPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	project, f := a.app.DictionaryService.FindProject(request.UUID)
 	if !f {
 		return nil, dto.NotFoundErr("проект не найден")
@@ -713,7 +797,7 @@ func (a *Web) PostProjectUUIDStatus(ctx context.Context, request oapi.PostProjec
 		Description: request.Body.Description,
 	}
 
-	err := a.app.FederationService.CreateProjectStatus(dm)
+	err = a.app.FederationService.CreateProjectStatus(dm)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,170 @@
+package web
+
+import (
+	"context"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDRecurrence(ctx context.Context, request oapi.GetProjectUUIDRecurrenceRequestObject) (oapi.GetProjectUUIDRecurrenceResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.TaskRecurrences(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.TaskService.GetRecurrences(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDRecurrence200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.TaskRecurrence, _ int) dto.TaskRecurrenceDTO {
+			return dto.NewTaskRecurrenceDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) GetRecurrenceUUID(ctx context.Context, request oapi.GetRecurrenceUUIDRequestObject) (oapi.GetRecurrenceUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetRecurrence(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskRecurrences(dm.ProjectUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetRecurrenceUUID200JSONResponse(dto.NewTaskRecurrenceDTO(dm)), nil
+}
+
+func (a *Web) PutRecurrenceUUID(ctx context.Context, request oapi.PutRecurrenceUUIDRequestObject) (oapi.PutRecurrenceUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetRecurrence(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskRecurrenceEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Body.Vars != nil && dm.TemplateUUID != nil {
+		dm.Vars = *request.Body.Vars
+	}
+
+	dm.Paused = lo.FromPtrOr(request.Body.Paused, dm.Paused)
+
+	err = dm.Reschedule(request.Body.Schedule, lo.FromPtrOr(request.Body.StartAt, dm.StartAt), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.UpdateRecurrence(dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutRecurrenceUUID200JSONResponse(dto.NewTaskRecurrenceDTO(dm)), nil
+}
+
+func (a *Web) DeleteRecurrenceUUID(ctx context.Context, request oapi.DeleteRecurrenceUUIDRequestObject) (oapi.DeleteRecurrenceUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetRecurrence(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskRecurrenceEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteRecurrence(dm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteRecurrenceUUID200Response{}, nil
+}
+
+func (a *Web) PostTemplateUUIDRecurrence(ctx context.Context, request oapi.PostTemplateUUIDRecurrenceRequestObject) (oapi.PostTemplateUUIDRecurrenceResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	tmpl, err := a.app.TaskService.GetTemplate(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskTemplateUse(tmpl, request.Body.ProjectUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.Body.ProjectUuid)
+	if !found {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	target := domain.TaskTemplateTarget{
+		FederationUUID: project.FederationUUID,
+		CompanyUUID:    project.CompanyUUID,
+		ProjectUUID:    project.UUID,
+		Path:           []string{},
+		CreatedBy:      claims.Email,
+		Vars:           lo.FromPtr(request.Body.Vars),
+	}
+
+	now := time.Now()
+
+	dm, err := domain.NewTemplateRecurrence(tmpl, target, request.Body.Schedule, lo.FromPtrOr(request.Body.StartAt, now), now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskRecurrenceEdit(*dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the placeholders are checked now rather than on every occurrence
+	_, err = tmpl.Instantiate(dm.Target(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.CreateRecurrence(*dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTemplateUUIDRecurrence200JSONResponse(dto.NewTaskRecurrenceDTO(*dm)), nil
+}
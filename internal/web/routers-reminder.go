@@ -26,12 +26,27 @@ func initOpenAPIReminderRouters(a *Web, e *echo.Echo) {
 }
 
 func (a *Web) DeleteReminderUUID(ctx context.Context, request oapi.DeleteReminderUUIDRequestObject) (oapi.DeleteReminderUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.RemindersService.DeleteByUUID(request.UUID)
+	dm, err := a.app.RemindersService.Get(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, dm.TaskUUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.ReminderEdit(task, dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.RemindersService.DeleteByUUID(request.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +60,16 @@ func (a *Web) PostReminder(ctx context.Context, request oapi.PostReminderRequest
 		return nil, ErrInvalidAuthHeader
 	}
 
+	task, err := a.app.TaskService.GetTask(ctx, request.Body.TaskUuid, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.ReminderCreate(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	// @todo: add type
 	dm := domain.Reminder{
 		UUID:          uuid.New(),
@@ -56,9 +81,10 @@ func (a *Web) PostReminder(ctx context.Context, request oapi.PostReminderRequest
 		DateTo:        request.Body.DateTo,
 		DateFrom:      request.Body.DateFrom,
 		UserUUID:      request.Body.UserUuid,
+		Recurrence:    request.Body.Recurrence,
 	}
 
-	err := a.app.RemindersService.Create(dm)
+	err = a.app.RemindersService.Create(dm)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +106,16 @@ func (a *Web) PutReminderUUID(ctx context.Context, request oapi.PutReminderUUIDR
 		return nil, err
 	}
 
+	task, err := a.app.TaskService.GetTask(ctx, dm.TaskUUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.ReminderEdit(task, dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	dm.Description = request.Body.Description
 	dm.Comment = request.Body.Comment
 	dm.DateFrom = request.Body.DateFrom
@@ -87,6 +123,16 @@ func (a *Web) PutReminderUUID(ctx context.Context, request oapi.PutReminderUUIDR
 	dm.Type = request.Body.Type
 	dm.UserUUID = request.Body.UserUuid
 
+	// the stored recurrence is anchored, a series is restarted only when it is another one
+	if request.Body.Recurrence != nil && request.Body.DateFrom != nil {
+		request.Body.Recurrence.Anchor(*request.Body.DateFrom)
+	}
+
+	if !dm.Recurrence.Equal(request.Body.Recurrence) {
+		dm.Recurrence = request.Body.Recurrence
+		dm.Occurrence = 0
+	}
+
 	err = a.app.RemindersService.Put(claims.Email, dm)
 	if err != nil {
 		return nil, err
@@ -106,6 +152,16 @@ func (a *Web) PatchReminderUUIDStatus(ctx context.Context, request oapi.PatchRem
 		return nil, err
 	}
 
+	task, err := a.app.TaskService.GetTask(ctx, dm.TaskUUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.ReminderStatus(task, dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	err = a.app.RemindersService.PatchStatus(claims.Email, dm, request.Body.Status)
 	if err != nil {
 		return nil, err
@@ -114,13 +170,13 @@ func (a *Web) PatchReminderUUIDStatus(ctx context.Context, request oapi.PatchRem
 	return oapi.PatchReminderUUIDStatus200Response{}, nil
 }
 
-func (a *Web) GetReminder(ctx context.Context, _ oapi.GetReminderRequestObject) (oapi.GetReminderResponseObject, error) {
+func (a *Web) GetReminder(ctx context.Context, request oapi.GetReminderRequestObject) (oapi.GetReminderResponseObject, error) {
 	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	dms, err := a.app.RemindersService.GetByUser(claims.UUID)
+	dms, err := a.app.RemindersService.GetByUser(claims.UUID, request.Params.DateFrom, request.Params.DateTo)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +210,8 @@ func (a *Web) GetReminder(ctx context.Context, _ oapi.GetReminderRequestObject)
 			User:        user,
 			CreatedBy:   createdBy,
 			Status:      dm.Status,
+			FiredAt:     dm.FiredAt,
+			Recurrence:  dm.Recurrence,
 		}
 	})
 
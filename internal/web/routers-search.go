@@ -0,0 +1,47 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetSearch(ctx context.Context, request oapi.GetSearchRequestObject) (oapi.GetSearchResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	q, err := domain.NewSearchQuery(
+		request.Params.FederationUuid,
+		request.Params.Q,
+		lo.FromPtr(request.Params.Types),
+		lo.FromPtrOr(request.Params.Limit, 25),
+		lo.FromPtrOr(request.Params.Offset, 0),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.Search(q, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, total, err := a.app.SearchService.Search(*q)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetSearch200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.SearchHit, _ int) dto.SearchHitDTO {
+			return dto.NewSearchHitDTO(item)
+		}),
+		Total: total,
+	}, nil
+}
@@ -23,6 +23,11 @@ func (a *Web) PostTag(ctx context.Context, request oapi.PostTagRequestObject) (o
 		return nil, fmt.Errorf("company not found. uuid: %s", request.Body.CompanyUuid)
 	}
 
+	err := a.app.GateService.TagPatch(company.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	tag := domain.Tag{
 		UUID:  uuid.New(),
 		Name:  request.Body.Name,
@@ -37,7 +42,7 @@ func (a *Web) PostTag(ctx context.Context, request oapi.PostTagRequestObject) (o
 		},
 	}
 
-	err := a.app.CompanyService.CreateTag(tag)
+	err = a.app.CompanyService.CreateTag(tag)
 	if err != nil {
 		return nil, err
 	}
@@ -48,12 +53,22 @@ func (a *Web) PostTag(ctx context.Context, request oapi.PostTagRequestObject) (o
 }
 
 func (a *Web) PatchTagUUID(ctx context.Context, request oapi.PatchTagUUIDRequestObject) (oapi.PatchTagUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CompanyService.UpdateTag(request.UUID, request.Body.Name, request.Body.Color)
+	tag, err := a.app.CompanyService.GetTag(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TagPatch(tag.CompanyUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CompanyService.UpdateTag(request.UUID, request.Body.Name, request.Body.Color)
 	if err != nil {
 		return nil, err
 	}
@@ -92,12 +107,22 @@ func (a *Web) GetTag(ctx context.Context, request oapi.GetTagRequestObject) (oap
 }
 
 func (a *Web) DeleteTagUUID(ctx context.Context, request oapi.DeleteTagUUIDRequestObject) (oapi.DeleteTagUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CompanyService.DeleteTag(request.UUID)
+	tag, err := a.app.CompanyService.GetTag(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TagPatch(tag.CompanyUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CompanyService.DeleteTag(request.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,213 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetTaskUUIDChecklist(ctx context.Context, request oapi.GetTaskUUIDChecklistRequestObject) (oapi.GetTaskUUIDChecklistResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.TaskService.GetChecklist(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDChecklist200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.TaskChecklistItem, _ int) dto.TaskChecklistItemDTO {
+			return dto.NewTaskChecklistItemDTO(item, a.app.DictionaryService)
+		}),
+		Progress: dto.NewChecklistProgressDTO(domain.NewChecklistProgress(dms)),
+	}, nil
+}
+
+func (a *Web) PostTaskUUIDChecklist(ctx context.Context, request oapi.PostTaskUUIDChecklistRequestObject) (oapi.PostTaskUUIDChecklistResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskPatch(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	crt := domain.NewCreatorFromUser(&claims)
+
+	dm, err := domain.NewTaskChecklistItem(task, crt, request.Body.Text, lo.FromPtr(request.Body.AssignedTo), request.Body.DueAt)
+	if err != nil {
+		return nil, err
+	}
+
+	dm.Done = lo.FromPtr(request.Body.Done)
+
+	err = a.app.TaskService.CreateChecklistItem(crt, *dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDChecklist200JSONResponse{
+		Uuid: dm.UUID,
+	}, nil
+}
+
+func (a *Web) PutTaskUUIDChecklist(ctx context.Context, request oapi.PutTaskUUIDChecklistRequestObject) (oapi.PutTaskUUIDChecklistResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = a.app.TaskService.ReorderChecklist(domain.NewCreatorFromUser(&claims), request.UUID, request.Body.Uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutTaskUUIDChecklist200Response{}, nil
+}
+
+func (a *Web) PutTaskUUIDChecklistEntityUUID(ctx context.Context, request oapi.PutTaskUUIDChecklistEntityUUIDRequestObject) (oapi.PutTaskUUIDChecklistEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	old, _, err := a.taskChecklistItem(ctx, request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm := old
+	dm.Text = request.Body.Text
+	dm.Done = lo.FromPtr(request.Body.Done)
+	dm.AssignedTo = lo.FromPtr(request.Body.AssignedTo)
+	dm.DueAt = request.Body.DueAt
+
+	err = a.app.TaskService.UpdateChecklistItem(domain.NewCreatorFromUser(&claims), old, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutTaskUUIDChecklistEntityUUID200Response{}, nil
+}
+
+func (a *Web) DeleteTaskUUIDChecklistEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDChecklistEntityUUIDRequestObject) (oapi.DeleteTaskUUIDChecklistEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, _, err := a.taskChecklistItem(ctx, request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteChecklistItem(domain.NewCreatorFromUser(&claims), dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTaskUUIDChecklistEntityUUID200Response{}, nil
+}
+
+func (a *Web) PostTaskUUIDChecklistEntityUUIDTask(ctx context.Context, request oapi.PostTaskUUIDChecklistEntityUUIDTaskRequestObject) (oapi.PostTaskUUIDChecklistEntityUUIDTaskResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, parent, err := a.taskChecklistItem(ctx, request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := dm.SubtaskName()
+	if err != nil {
+		return nil, err
+	}
+
+	subtask, err := domain.NewTask(
+		name,
+		parent.FederationUUID,
+		parent.CompanyUUID,
+		parent.ProjectUUID,
+		claims.Email,
+		nil,
+		nil,
+
+		"",
+		parent.Path,
+		nil,
+		dm.AssignedTo,
+		"",
+
+		parent.Priority,
+
+		dm.DueAt,
+		"",
+		"",
+
+		map[uuid.UUID][]string{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskCreate(subtask, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := a.app.TaskService.ConvertChecklistItem(domain.NewCreatorFromUser(&claims), dm, subtask)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDChecklistEntityUUIDTask200JSONResponse{
+		Uuid: subtask.UUID,
+		Id:   id,
+	}, nil
+}
+
+// taskChecklistItem loads the item of the task and checks the user can patch the task.
+func (a *Web) taskChecklistItem(ctx context.Context, taskUUID, itemUUID, userUUID uuid.UUID) (domain.TaskChecklistItem, domain.Task, error) {
+	dm, err := a.app.TaskService.GetChecklistItem(itemUUID)
+	if err != nil {
+		return dm, domain.Task{}, err
+	}
+
+	if dm.TaskUUID != taskUUID {
+		return dm, domain.Task{}, dto.NotFoundErr("пункт чек-листа не найден")
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return dm, task, err
+	}
+
+	return dm, task, a.app.GateService.TaskPatch(task, userUUID)
+}
@@ -0,0 +1,73 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/agents"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PostProjectUUIDTaskImport(ctx context.Context, request oapi.PostProjectUUIDTaskImportRequestObject) (oapi.PostProjectUUIDTaskImportResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.TaskCreate(domain.Task{ProjectUUID: request.UUID}, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.UUID)
+	if !found {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	form, err := request.Body.ReadForm(32 << 20)
+	if err != nil {
+		return nil, err
+	}
+	defer form.RemoveAll() //nolint
+
+	if len(form.File["file"]) == 0 {
+		return nil, errors.New("файл не передан")
+	}
+
+	mapping := map[string]string{}
+	if len(form.Value["mapping"]) > 0 && form.Value["mapping"][0] != "" {
+		if err := json.Unmarshal([]byte(form.Value["mapping"][0]), &mapping); err != nil {
+			return nil, fmt.Errorf("неверный формат mapping: %w", err)
+		}
+	}
+
+	fh := form.File["file"][0]
+	file, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	rows, err := agents.ReadRows(file, fh.Filename)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.app.TaskService.Import(domain.TaskImportTarget{
+		FederationUUID: project.FederationUUID,
+		CompanyUUID:    project.CompanyUUID,
+		ProjectUUID:    project.UUID,
+		CreatedBy:      claims.Email,
+	}, rows, mapping, lo.FromPtrOr(request.Params.DryRun, false))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProjectUUIDTaskImport200JSONResponse(dto.NewTaskImportDTO(res)), nil
+}
@@ -0,0 +1,95 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+)
+
+func (a *Web) PostTaskUUIDLink(ctx context.Context, request oapi.PostTaskUUIDLinkRequestObject) (oapi.PostTaskUUIDLinkResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskPatch(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	other, err := a.app.TaskService.GetTask(ctx, request.Body.TaskUuid, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskView(other, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := a.app.TaskService.CreateLink(domain.NewCreatorFromUser(&claims), task, other, request.Body.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDLink200JSONResponse{
+		Uuid: link.UUID,
+	}, nil
+}
+
+func (a *Web) DeleteTaskUUIDLinkEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDLinkEntityUUIDRequestObject) (oapi.DeleteTaskUUIDLinkEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	link, err := a.app.TaskService.GetLink(request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.FromUUID != request.UUID && link.ToUUID != request.UUID {
+		return nil, dto.NotFoundErr("связь не найдена")
+	}
+
+	err = a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteLink(ctx, domain.NewCreatorFromUser(&claims), link)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTaskUUIDLinkEntityUUID200Response{}, nil
+}
+
+// taskLinks - links are not cached with the task, the linked tasks the user can't see are skipped.
+func (a *Web) taskLinks(taskUUID, userUUID uuid.UUID) ([]dto.TaskLinkDTO, error) {
+	links, tasks, err := a.app.TaskService.GetLinks(taskUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []dto.TaskLinkDTO{}
+	for _, link := range links {
+		other := tasks[link.Other(taskUUID)]
+		if a.app.GateService.TaskView(other, userUUID) != nil {
+			continue
+		}
+
+		res = append(res, dto.NewTaskLinkDTO(link, taskUUID, other))
+	}
+
+	return res, nil
+}
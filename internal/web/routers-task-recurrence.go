@@ -0,0 +1,58 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PostTaskUUIDRecurrence(ctx context.Context, request oapi.PostTaskUUIDRecurrenceRequestObject) (oapi.PostTaskUUIDRecurrenceResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskPatch(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// a task of a running series is edited through the series
+	if task.RecurrenceUUID != nil {
+		if _, err := a.app.TaskService.GetRecurrence(*task.RecurrenceUUID); err == nil {
+			return nil, errors.New("задача уже повторяется")
+		}
+	}
+
+	now := time.Now()
+
+	dm, err := domain.NewTaskRecurrence(task, request.Body.Schedule, lo.FromPtrOr(request.Body.StartAt, now), claims.Email, now)
+	if err != nil {
+		return nil, err
+	}
+
+	dm.Paused = lo.FromPtr(request.Body.Paused)
+
+	err = a.app.GateService.TaskRecurrenceEdit(*dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.CreateRecurrence(*dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDRecurrence200JSONResponse(dto.NewTaskRecurrenceDTO(*dm)), nil
+}
@@ -0,0 +1,253 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetCompanyUUIDTemplate(ctx context.Context, request oapi.GetCompanyUUIDTemplateRequestObject) (oapi.GetCompanyUUIDTemplateResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.TaskTemplates(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.TaskService.GetTemplates(request.UUID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDTemplate200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.TaskTemplate, _ int) dto.TaskTemplateDTO {
+			return dto.NewTaskTemplateDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDTemplate(ctx context.Context, request oapi.PostCompanyUUIDTemplateRequestObject) (oapi.PostCompanyUUIDTemplateResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := domain.NewTaskTemplate(request.UUID, nil, request.Body.Name, request.Body.Root, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskTemplateEdit(*dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.CreateTemplate(*dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDTemplate200JSONResponse(dto.NewTaskTemplateDTO(*dm)), nil
+}
+
+func (a *Web) GetProjectUUIDTemplate(ctx context.Context, request oapi.GetProjectUUIDTemplateRequestObject) (oapi.GetProjectUUIDTemplateResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.TaskViews(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.UUID)
+	if !found {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	dms, err := a.app.TaskService.GetTemplates(project.CompanyUUID, &request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDTemplate200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.TaskTemplate, _ int) dto.TaskTemplateDTO {
+			return dto.NewTaskTemplateDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostProjectUUIDTemplate(ctx context.Context, request oapi.PostProjectUUIDTemplateRequestObject) (oapi.PostProjectUUIDTemplateResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.UUID)
+	if !found {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	dm, err := domain.NewTaskTemplate(project.CompanyUUID, &request.UUID, request.Body.Name, request.Body.Root, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskTemplateEdit(*dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.CreateTemplate(*dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProjectUUIDTemplate200JSONResponse(dto.NewTaskTemplateDTO(*dm)), nil
+}
+
+func (a *Web) GetTemplateUUID(ctx context.Context, request oapi.GetTemplateUUIDRequestObject) (oapi.GetTemplateUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetTemplate(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskTemplates(dm.CompanyUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTemplateUUID200JSONResponse(dto.NewTaskTemplateDTO(dm)), nil
+}
+
+func (a *Web) PutTemplateUUID(ctx context.Context, request oapi.PutTemplateUUIDRequestObject) (oapi.PutTemplateUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetTemplate(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskTemplateEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm.Name = request.Body.Name
+	dm.Root = request.Body.Root
+
+	err = a.app.TaskService.UpdateTemplate(dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutTemplateUUID200Response{}, nil
+}
+
+func (a *Web) DeleteTemplateUUID(ctx context.Context, request oapi.DeleteTemplateUUIDRequestObject) (oapi.DeleteTemplateUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetTemplate(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskTemplateEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteTemplate(dm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTemplateUUID200Response{}, nil
+}
+
+func (a *Web) PostTemplateUUIDTask(ctx context.Context, request oapi.PostTemplateUUIDTaskRequestObject) (oapi.PostTemplateUUIDTaskResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetTemplate(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskTemplateUse(dm, request.Body.ProjectUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskCreate(domain.Task{ProjectUUID: request.Body.ProjectUuid}, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.Body.ProjectUuid)
+	if !found {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	target := domain.TaskTemplateTarget{
+		FederationUUID: project.FederationUUID,
+		CompanyUUID:    project.CompanyUUID,
+		ProjectUUID:    project.UUID,
+		Path:           []string{},
+		CreatedBy:      claims.Email,
+		Vars:           lo.FromPtr(request.Body.Vars),
+	}
+
+	if request.Body.ParentUuid != nil {
+		parent, err := a.app.TaskService.GetTask(ctx, *request.Body.ParentUuid, []string{})
+		if err != nil {
+			return nil, err
+		}
+
+		if parent.ProjectUUID != project.UUID {
+			return nil, errors.New("родительская задача из другого проекта")
+		}
+
+		err = a.app.GateService.TaskPatch(parent, claims.UUID)
+		if err != nil {
+			return nil, err
+		}
+
+		target.Path = parent.Path
+	}
+
+	tasks, err := a.app.TaskService.InstantiateTemplate(dm, target, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTemplateUUIDTask200JSONResponse{
+		Uuid:  tasks[0].UUID,
+		Count: len(tasks),
+	}, nil
+}
@@ -0,0 +1,150 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDView(ctx context.Context, request oapi.GetProjectUUIDViewRequestObject) (oapi.GetProjectUUIDViewResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.TaskViews(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.TaskService.GetViews(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDView200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.TaskView, _ int) dto.TaskViewDTO {
+			return dto.NewTaskViewDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostProjectUUIDView(ctx context.Context, request oapi.PostProjectUUIDViewRequestObject) (oapi.PostProjectUUIDViewResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.FederationService.GetProject(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := domain.NewTaskView(project.FederationUUID, project.UUID, claims.UUID, request.Body.Name, lo.FromPtr(request.Body.Shared), taskViewSettings(request.Body), claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskViewEdit(*dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.CreateView(*dm, project.FieldsSort)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProjectUUIDView200JSONResponse(dto.NewTaskViewDTO(*dm)), nil
+}
+
+func (a *Web) PutProjectUUIDViewEntityUUID(ctx context.Context, request oapi.PutProjectUUIDViewEntityUUIDRequestObject) (oapi.PutProjectUUIDViewEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	view, err := a.projectView(request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := a.app.FederationService.GetProject(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := domain.NewTaskView(view.FederationUUID, view.ProjectUUID, view.UserUUID, request.Body.Name, lo.FromPtr(request.Body.Shared), taskViewSettings(request.Body), view.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	dm.UUID = view.UUID
+
+	// sharing a private view needs the same rights as editing a shared one
+	err = a.app.GateService.TaskViewEdit(*dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.UpdateView(*dm, project.FieldsSort)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := a.app.TaskService.GetView(dm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutProjectUUIDViewEntityUUID200JSONResponse(dto.NewTaskViewDTO(updated)), nil
+}
+
+func (a *Web) DeleteProjectUUIDViewEntityUUID(ctx context.Context, request oapi.DeleteProjectUUIDViewEntityUUIDRequestObject) (oapi.DeleteProjectUUIDViewEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	view, err := a.projectView(request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteView(view.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProjectUUIDViewEntityUUID200Response{}, nil
+}
+
+// projectView loads a view of the project the user may edit.
+func (a *Web) projectView(projectUUID, viewUUID, userUUID uuid.UUID) (domain.TaskView, error) {
+	view, err := a.app.TaskService.GetView(viewUUID)
+	if err != nil {
+		return view, err
+	}
+
+	if view.ProjectUUID != projectUUID {
+		return view, dto.NotFoundErr("представление не найдено")
+	}
+
+	return view, a.app.GateService.TaskViewEdit(view, userUUID)
+}
+
+func taskViewSettings(body *oapi.TaskViewRequest) domain.TaskViewSettings {
+	return domain.TaskViewSettings{
+		Filter:  lo.FromPtr(body.Filter),
+		Order:   body.Order,
+		By:      body.By,
+		Columns: lo.FromPtr(body.Columns),
+		GroupBy: body.GroupBy,
+	}
+}
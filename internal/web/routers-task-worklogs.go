@@ -0,0 +1,193 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetTaskUUIDWorklog(ctx context.Context, request oapi.GetTaskUUIDWorklogRequestObject) (oapi.GetTaskUUIDWorklogResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskView(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, total, subtree, err := a.app.TaskService.GetWorklogs(task)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDWorklog200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.TaskWorklog, _ int) dto.TaskWorklogDTO {
+			return dto.NewTaskWorklogDTO(item)
+		}),
+		Total:   dto.NewWorklogTotalDTO(total),
+		Subtree: dto.NewWorklogTotalDTO(subtree),
+	}, nil
+}
+
+func (a *Web) PostTaskUUIDWorklog(ctx context.Context, request oapi.PostTaskUUIDWorklogRequestObject) (oapi.PostTaskUUIDWorklogResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.WorklogCreate(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := domain.NewTaskWorklog(task, domain.NewCreatorFromUser(&claims), request.Body.StartedAt, request.Body.Duration, lo.FromPtr(request.Body.Comment), lo.FromPtrOr(request.Body.Billable, true))
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.CreateWorklog(*dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDWorklog200JSONResponse{
+		Uuid: dm.UUID,
+	}, nil
+}
+
+func (a *Web) PutTaskUUIDWorklogEntityUUID(ctx context.Context, request oapi.PutTaskUUIDWorklogEntityUUIDRequestObject) (oapi.PutTaskUUIDWorklogEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.taskWorklog(ctx, request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm.StartedAt = request.Body.StartedAt
+	dm.Duration = request.Body.Duration
+	dm.Comment = lo.FromPtr(request.Body.Comment)
+	dm.Billable = lo.FromPtrOr(request.Body.Billable, true)
+
+	err = a.app.TaskService.UpdateWorklog(dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutTaskUUIDWorklogEntityUUID200Response{}, nil
+}
+
+func (a *Web) DeleteTaskUUIDWorklogEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDWorklogEntityUUIDRequestObject) (oapi.DeleteTaskUUIDWorklogEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.taskWorklog(ctx, request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteWorklog(dm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTaskUUIDWorklogEntityUUID200Response{}, nil
+}
+
+// taskWorklog loads the worklog of the task and checks the user can edit it.
+func (a *Web) taskWorklog(ctx context.Context, taskUUID, worklogUUID, userUUID uuid.UUID) (domain.TaskWorklog, error) {
+	dm, err := a.app.TaskService.GetWorklog(worklogUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.TaskUUID != taskUUID {
+		return dm, dto.NotFoundErr("запись времени не найдена")
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return dm, err
+	}
+
+	return dm, a.app.GateService.WorklogEdit(task, dm, userUUID)
+}
+
+func (a *Web) PostTaskUUIDTimer(ctx context.Context, request oapi.PostTaskUUIDTimerRequestObject) (oapi.PostTaskUUIDTimerResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.WorklogCreate(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	stopped, err := a.app.TaskService.StartTimer(domain.NewCreatorFromUser(&claims), task)
+	if err != nil {
+		return nil, err
+	}
+
+	res := oapi.PostTaskUUIDTimer200JSONResponse{}
+	if stopped != nil {
+		res.Stopped = lo.ToPtr(dto.NewTaskWorklogDTO(*stopped))
+	}
+
+	return res, nil
+}
+
+func (a *Web) GetTimer(ctx context.Context, _ oapi.GetTimerRequestObject) (oapi.GetTimerResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetTimer(claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTimer200JSONResponse(dto.NewTaskTimerDTO(dm)), nil
+}
+
+func (a *Web) DeleteTimer(ctx context.Context, _ oapi.DeleteTimerRequestObject) (oapi.DeleteTimerResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.StopTimer(domain.NewCreatorFromUser(&claims))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTimer200JSONResponse(dto.NewTaskWorklogDTO(*dm)), nil
+}
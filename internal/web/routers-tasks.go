@@ -42,6 +42,11 @@ func (a *Web) PatchTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.P
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
 	form, err := request.Body.ReadForm(1000000)
 	if err != nil {
 		return nil, err
@@ -181,6 +186,20 @@ func (a *Web) PostTask(ctx context.Context, request oapi.PostTaskRequestObject)
 		return nil, err
 	}
 
+	if request.Body.Agents != nil {
+		err = a.app.AgentsService.CheckFederationAgents(ctx, project.FederationUUID, *request.Body.Agents)
+		if err != nil {
+			return nil, err
+		}
+
+		task.PatchAgents(*request.Body.Agents)
+	}
+
+	err = a.app.GateService.TaskCreate(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := a.app.TaskService.CreateTask(task)
 	if err != nil {
 		return nil, err
@@ -192,6 +211,16 @@ func (a *Web) PostTask(ctx context.Context, request oapi.PostTaskRequestObject)
 	}, nil
 }
 
+// taskGate loads the task to check the gate against its company.
+func (a *Web) taskGate(ctx context.Context, taskUUID, userUUID uuid.UUID, gate func(domain.Task, uuid.UUID) error) error {
+	task, err := a.app.TaskService.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return err
+	}
+
+	return gate(task, userUUID)
+}
+
 // Web struct should implement the missing method from otask.StrictServerInterface.
 func (a *Web) DeleteTaskUUID(ctx context.Context, request oapi.DeleteTaskUUIDRequestObject) (oapi.DeleteTaskUUIDResponseObject, error) {
 	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
@@ -199,7 +228,12 @@ func (a *Web) DeleteTaskUUID(ctx context.Context, request oapi.DeleteTaskUUIDReq
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.TaskService.DeleteTask(domain.NewCreatorFromUser(&claims), request.UUID)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteTask(domain.NewCreatorFromUser(&claims), request.UUID)
 
 	return oapi.DeleteTaskUUID200Response{}, err
 }
@@ -215,6 +249,11 @@ func (a *Web) PutTaskUUID(ctx context.Context, request oapi.PutTaskUUIDRequestOb
 		return nil, err
 	}
 
+	err = a.app.GateService.TaskPatch(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	shouldUpdate := []string{}
 
 	// @todo: active record?
@@ -249,6 +288,16 @@ func (a *Web) PutTaskUUID(ctx context.Context, request oapi.PutTaskUUIDRequestOb
 		shouldUpdate = append(shouldUpdate, "icon")
 	}
 
+	if request.Body.Agents != nil {
+		err = a.app.AgentsService.CheckFederationAgents(ctx, task.FederationUUID, *request.Body.Agents)
+		if err != nil {
+			return nil, err
+		}
+
+		task.PatchAgents(*request.Body.Agents)
+		shouldUpdate = append(shouldUpdate, "agents")
+	}
+
 	err = a.app.TaskService.UpdateTask(domain.NewCreatorFromUser(&claims), task, shouldUpdate)
 	if err != nil {
 		return nil, err
@@ -262,6 +311,12 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
+
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
 	//nolint
 	ctx = context.WithValue(ctx, "userUUID", claims.UUID)
 	//nolint
@@ -279,17 +334,24 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 		return nil, err
 	}
 
+	// links
+	links, err := a.taskLinks(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	if err == nil && dtoFromCache.UUID != uuid.Nil {
 		firstOpenDTO := a.patchFirstOpen(&dtoFromCache, claims.UUID)
 
+		dtoFromCache.Links = links
+
 		dtoFromCache.IsLiked = &isLiked
 		dtoFromCache.FirstOpen = firstOpenDTO
 		dtoFromCache.Views = len(firstOpenDTO)
 
-		err := a.app.TaskService.TaskWasOpen(request.UUID, claims.Email)
-		if err != nil {
-			logrus.Error(err)
-		}
+		a.app.Events.Publish(ctx, domain.TaskOpened{
+			EventMeta: domain.NewEventMeta(claims.Email, request.UUID, []string{claims.Email}),
+		})
 
 		logrus.Info("[module:router] GetTask: from redis")
 		return oapi.GetTaskUUID200JSONResponse{
@@ -344,6 +406,7 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 
 	go a.app.CacheService.CacheTask(ctx, &taskDto)
 	taskDto.IsLiked = &isLiked
+	taskDto.Links = links
 
 	// First Open
 	firstOpenDTO := a.patchFirstOpen(&taskDto, claims.UUID)
@@ -414,16 +477,45 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 		Tags:           request.Params.Tags,
 		Fields:         filterDto,
 		Path:           request.Params.Path,
+		Overdue:        request.Params.Overdue,
+		DueBefore:      request.Params.DueBefore,
+		DueAfter:       request.Params.DueAfter,
 
 		Order: request.Params.Order,
 		By:    request.Params.By,
 	}
 
+	if request.Params.ViewUuid != nil {
+		view, err := a.app.TaskService.GetView(*request.Params.ViewUuid)
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.app.GateService.TaskViewUse(view, claims.UUID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = filter.ApplyView(view)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = filter.Validate()
 	if err != nil {
 		return nil, err
 	}
 
+	restricted, err := a.app.GateService.TasksRestricted(filter.ProjectUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if restricted {
+		filter.VisibleTo = &claims.Email
+	}
+
 	dtos, total, err := a.app.TaskService.GetTasksDto(ctx, filter)
 	if err != nil {
 		return nil, err
@@ -474,7 +566,7 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 	}, nil
 }
 
-func toExcel(dtos []dto.TaskDTOs, storeToDisk string) (f *excelize.File, err error) {
+func toExcel[T any](dtos []T, storeToDisk string) (f *excelize.File, err error) {
 	f = excelize.NewFile()
 	defer func() {
 		if err := f.Close(); err != nil {
@@ -668,12 +760,17 @@ func parseStruct(dt interface{}, rows []interface{}, level string, names []strin
 }
 
 func (a *Web) PatchTaskUUIDParent(ctx context.Context, request oapi.PatchTaskUUIDParentRequestObject) (oapi.PatchTaskUUIDParentResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.TaskService.PatchTaskParent(ctx, request.UUID, request.Body.Uuid)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.PatchTaskParent(ctx, request.UUID, request.Body.Uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -692,6 +789,11 @@ func (a *Web) PatchTaskUUIDProject(ctx context.Context, request oapi.PatchTaskUU
 		return nil, err
 	}
 
+	err = a.app.GateService.TaskPatch(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	project, err := a.app.AgregateService.GetProject(ctx, request.Body.Uuid)
 	if err != nil {
 		return nil, err
@@ -711,7 +813,12 @@ func (a *Web) PatchTaskUUIDName(ctx context.Context, request oapi.PatchTaskUUIDN
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.TaskService.PatchName(domain.NewCreatorFromUser(&claims), request.UUID, request.Body.Name)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.PatchName(domain.NewCreatorFromUser(&claims), request.UUID, request.Body.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -730,6 +837,11 @@ func (a *Web) PatchTaskUUIDStatus(ctx context.Context, request oapi.PatchTaskUUI
 		return nil, err
 	}
 
+	err = a.app.GateService.TaskPatch(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	project, err := a.app.AgregateService.GetProject(ctx, task.ProjectUUID)
 	if err != nil {
 		return nil, err
@@ -752,7 +864,12 @@ func (a *Web) DeleteTaskUUIDStopEntityUUID(ctx context.Context, request oapi.Del
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.TaskService.DeleteStop(ctx, request.UUID, request.EntityUUID, claims.Email)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteStop(ctx, request.UUID, request.EntityUUID, claims.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -767,6 +884,11 @@ func (a *Web) PostTaskUUIDComment(ctx context.Context, request oapi.PostTaskUUID
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
 	form, err := request.Body.ReadForm(1000000)
 	if err != nil {
 		return nil, err
@@ -864,6 +986,11 @@ func (a *Web) PatchTaskUUIDCommentEntityUUIDLike(ctx context.Context, request oa
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
 	likes, liked, err := a.app.CommentService.LikeComment(ctx, request.EntityUUID, claims.Email)
 	if err != nil {
 		return nil, err
@@ -880,10 +1007,11 @@ func (a *Web) PatchTaskUUIDCommentEntityUUIDLike(ctx context.Context, request oa
 
 	notify := []string{comment.CreatedBy}
 
-	err = a.app.TaskService.TaskWasUpdatedOrCreated(comment.TaskUUID, notify)
-	if err != nil {
-		return nil, err
-	}
+	a.app.Events.Publish(ctx, domain.CommentLiked{
+		EventMeta:   domain.NewEventMeta(claims.Email, comment.TaskUUID, notify),
+		CommentUUID: comment.UUID,
+		Liked:       liked,
+	})
 
 	return oapi.PatchTaskUUIDCommentEntityUUIDLike200JSONResponse{
 		Liked: liked,
@@ -892,12 +1020,17 @@ func (a *Web) PatchTaskUUIDCommentEntityUUIDLike(ctx context.Context, request oa
 }
 
 func (a *Web) PatchTaskUUIDCommentEntityUUIDPin(ctx context.Context, request oapi.PatchTaskUUIDCommentEntityUUIDPinRequestObject) (oapi.PatchTaskUUIDCommentEntityUUIDPinResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CommentService.PinComment(ctx, request.EntityUUID)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CommentService.PinComment(ctx, request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -914,7 +1047,12 @@ func (a *Web) DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CommentService.DeleteComment(ctx, request.UUID, request.EntityUUID, &claims.UUID)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CommentService.DeleteComment(ctx, request.UUID, request.EntityUUID, &claims.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -923,7 +1061,17 @@ func (a *Web) DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.
 }
 
 // Web struct should implement the missing method from otask.StrictServerInterface.
-func (a *Web) GetTaskUUIDComment(_ context.Context, request oapi.GetTaskUUIDCommentRequestObject) (oapi.GetTaskUUIDCommentResponseObject, error) {
+func (a *Web) GetTaskUUIDComment(ctx context.Context, request oapi.GetTaskUUIDCommentRequestObject) (oapi.GetTaskUUIDCommentResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
 	dms, err := a.app.CommentService.GetTaskComments(request.UUID, true, true)
 	if err != nil {
 		return nil, err
@@ -947,7 +1095,12 @@ func (a *Web) PatchTaskUUIDTeam(ctx context.Context, request oapi.PatchTaskUUIDT
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.TaskService.PatchTeam(ctx, domain.NewCreatorFromUser(&claims), request.UUID, request.Body.ImplementBy, request.Body.ResponsibleBy, request.Body.CoworkersBy, request.Body.WatchedBy, request.Body.ManagedBy)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.PatchTeam(ctx, domain.NewCreatorFromUser(&claims), request.UUID, request.Body.ImplementBy, request.Body.ResponsibleBy, request.Body.CoworkersBy, request.Body.WatchedBy, request.Body.ManagedBy)
 	if err != nil {
 		return nil, err
 	}
@@ -974,6 +1127,11 @@ func (a *Web) PatchTaskUUIDUpload(ctx context.Context, request oapi.PatchTaskUUI
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
 	file, err := request.Body.NextPart()
 	if errors.Is(err, io.EOF) {
 		return nil, fmt.Errorf("file is required: %w", err)
@@ -1017,10 +1175,11 @@ func (a *Web) PatchTaskUUIDUpload(ctx context.Context, request oapi.PatchTaskUUI
 		return email != claims.Email
 	})
 
-	err = a.app.TaskService.TaskWasUpdatedOrCreated(request.UUID, notify)
-	if err != nil {
-		return nil, err
-	}
+	a.app.Events.Publish(ctx, domain.FileUploaded{
+		EventMeta: domain.NewEventMeta(claims.Email, request.UUID, notify),
+		FileUUID:  fileDTO.UUID,
+		Name:      fileDTO.Name,
+	})
 
 	return oapi.PatchTaskUUIDUpload200JSONResponse(dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)), nil
 }
@@ -1031,7 +1190,12 @@ func (a *Web) DeleteTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.D
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.TaskService.DeleteTaskFile(domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.DeleteTaskFile(domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -1040,12 +1204,17 @@ func (a *Web) DeleteTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.D
 }
 
 func (a *Web) PostTaskUUIDUploadEntityUUIDRename(ctx context.Context, request oapi.PostTaskUUIDUploadEntityUUIDRenameRequestObject) (oapi.PostTaskUUIDUploadEntityUUIDRenameResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.S3PrivateService.Rename(request.EntityUUID, request.Body.Name)
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.S3PrivateService.Rename(request.EntityUUID, request.Body.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -1057,11 +1226,16 @@ func (a *Web) PostTaskUUIDUploadEntityUUIDRename(ctx context.Context, request oa
 }
 
 func (a *Web) GetTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.GetTaskUUIDUploadEntityUUIDRequestObject) (oapi.GetTaskUUIDUploadEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
 	url, err := a.app.S3PrivateService.PresignedURLFromFile(request.EntityUUID)
 	if err != nil {
 		return nil, err
@@ -1075,11 +1249,16 @@ func (a *Web) GetTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.GetT
 }
 
 func (a *Web) GetTaskUUIDUpload(ctx context.Context, request oapi.GetTaskUUIDUploadRequestObject) (oapi.GetTaskUUIDUploadResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
 	commentFiles, err := a.app.CommentService.GetTaskCommentsFiles(request.UUID)
 	if err != nil {
 		return nil, err
@@ -1110,11 +1289,16 @@ func (a *Web) GetTaskUUIDUpload(ctx context.Context, request oapi.GetTaskUUIDUpl
 }
 
 func (a *Web) GetTaskUUIDActivity(ctx context.Context, request oapi.GetTaskUUIDActivityRequestObject) (oapi.GetTaskUUIDActivityResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	err := a.taskGate(ctx, request.UUID, claims.UUID, a.app.GateService.TaskView)
+	if err != nil {
+		return nil, err
+	}
+
 	offset := helpers.If(request.Params.Offset == nil, 0, *request.Params.Offset)
 	limit := helpers.If(request.Params.Limit == nil, 0, *request.Params.Limit)
 
@@ -0,0 +1,112 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDWorklog(ctx context.Context, request oapi.GetProjectUUIDWorklogRequestObject) (oapi.GetProjectUUIDWorklogResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WorklogProject(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := a.app.TaskService.GetProjectWorklogTotal(request.UUID, request.Params.From, request.Params.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDWorklog200JSONResponse(dto.NewWorklogTotalDTO(total)), nil
+}
+
+func (a *Web) GetTimesheet(ctx context.Context, request oapi.GetTimesheetRequestObject) (oapi.GetTimesheetResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	filter := domain.TimesheetFilter{
+		FederationUUID: request.Params.FederationUuid,
+		UserUUID:       lo.FromPtrOr(request.Params.UserUuid, claims.UUID),
+		From:           request.Params.From,
+		To:             request.Params.To,
+		Billable:       request.Params.Billable,
+	}
+
+	err := a.app.GateService.Timesheet(filter.FederationUUID, filter.UserUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, tasks, err := a.app.TaskService.GetTimesheet(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := lo.Map(dms, func(item domain.TaskWorklog, _ int) dto.TimesheetRowDTO {
+		projectName := ""
+		if project, found := a.app.DictionaryService.FindProject(item.ProjectUUID); found {
+			projectName = project.Name
+		}
+
+		return dto.NewTimesheetRowDTO(item, tasks[item.TaskUUID], projectName)
+	})
+
+	if request.Params.Format != nil && *request.Params.Format == "xlsx" {
+		f, err := toExcel(rows, "")
+		if err != nil {
+			return nil, err
+		}
+
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			return nil, err
+		}
+
+		name := filter.UserUUID.String()
+		if user, found := a.app.DictionaryService.FindUserByUUID(filter.UserUUID); found {
+			name = user.Email
+		}
+
+		contentDisposition := fmt.Sprintf("attachment; filename=\"%s %s-%s.xlsx\";", helpers.Scientific(name), filter.From.Format(time.DateOnly), filter.To.Format(time.DateOnly))
+
+		return oapi.GetTimesheet200ApplicationxlsxResponse{
+			Body: buf,
+
+			Headers: oapi.GetTimesheet200ResponseHeaders{
+				CacheControl:       "no-cache",
+				ContentType:        "application/octet-stream",
+				ContentDisposition: contentDisposition,
+			},
+		}, nil
+	}
+
+	return oapi.GetTimesheet200JSONResponse{
+		Body: struct {
+			Count int                   `json:"count"`
+			Items []dto.TimesheetRowDTO `json:"items"`
+			Total dto.WorklogTotalDTO   `json:"total"`
+		}{
+			Count: len(rows),
+			Items: rows,
+			Total: dto.NewWorklogTotalDTO(domain.SumWorklogs(dms)),
+		},
+		Headers: oapi.GetTimesheet200ResponseHeaders{
+			CacheControl: "no-cache",
+			ContentType:  "application/json",
+		},
+	}, nil
+}
@@ -0,0 +1,264 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+const webhookDeliveriesLimit = 500
+
+func (a *Web) GetFederationUUIDWebhook(ctx context.Context, request oapi.GetFederationUUIDWebhookRequestObject) (oapi.GetFederationUUIDWebhookResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WebhookEdit(domain.Webhook{FederationUUID: request.UUID}, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.WebhooksService.GetWebhooks(request.UUID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDWebhook200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Webhook, _ int) dto.WebhookDTO {
+			return dto.NewWebhookDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostFederationUUIDWebhook(ctx context.Context, request oapi.PostFederationUUIDWebhookRequestObject) (oapi.PostFederationUUIDWebhookResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.createWebhook(request.UUID, nil, *request.Body, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostFederationUUIDWebhook200JSONResponse(dm), nil
+}
+
+func (a *Web) GetCompanyUUIDWebhook(ctx context.Context, request oapi.GetCompanyUUIDWebhookRequestObject) (oapi.GetCompanyUUIDWebhookResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	company, found := a.app.DictionaryService.FindCompany(request.UUID)
+	if !found {
+		return nil, dto.NotFoundErr("компания не найдена")
+	}
+
+	err := a.app.GateService.WebhookEdit(domain.Webhook{FederationUUID: company.FederationUUID, CompanyUUID: &request.UUID}, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.WebhooksService.GetWebhooks(company.FederationUUID, &request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDWebhook200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Webhook, _ int) dto.WebhookDTO {
+			return dto.NewWebhookDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDWebhook(ctx context.Context, request oapi.PostCompanyUUIDWebhookRequestObject) (oapi.PostCompanyUUIDWebhookResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	company, found := a.app.DictionaryService.FindCompany(request.UUID)
+	if !found {
+		return nil, dto.NotFoundErr("компания не найдена")
+	}
+
+	dm, err := a.createWebhook(company.FederationUUID, &request.UUID, *request.Body, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDWebhook200JSONResponse(dm), nil
+}
+
+// createWebhook - the secret is shown only once, in the response of the creation.
+func (a *Web) createWebhook(federationUUID uuid.UUID, companyUUID *uuid.UUID, body oapi.WebhookRequest, claims jwt.Claims) (res dto.WebhookDTO, err error) {
+	dm, err := domain.NewWebhook(federationUUID, companyUUID, body.Url, body.Events, lo.FromPtr(body.Secret), claims.Email)
+	if err != nil {
+		return res, err
+	}
+
+	err = a.app.GateService.WebhookEdit(*dm, claims.UUID)
+	if err != nil {
+		return res, err
+	}
+
+	dm.Activate(lo.FromPtrOr(body.Active, true))
+
+	err = a.app.WebhooksService.Create(*dm)
+	if err != nil {
+		return res, err
+	}
+
+	res = dto.NewWebhookDTO(*dm)
+	res.Secret = dm.Secret
+
+	return res, nil
+}
+
+func (a *Web) GetWebhookUUID(ctx context.Context, request oapi.GetWebhookUUIDRequestObject) (oapi.GetWebhookUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.WebhooksService.Get(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.WebhookEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetWebhookUUID200JSONResponse(dto.NewWebhookDTO(dm)), nil
+}
+
+func (a *Web) PutWebhookUUID(ctx context.Context, request oapi.PutWebhookUUIDRequestObject) (oapi.PutWebhookUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.WebhooksService.Get(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.WebhookEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm.URL = request.Body.Url
+	dm.Events = lo.Uniq(request.Body.Events)
+	if lo.FromPtr(request.Body.Secret) != "" {
+		dm.Secret = *request.Body.Secret
+	}
+	dm.Activate(lo.FromPtrOr(request.Body.Active, dm.Active))
+
+	err = a.app.WebhooksService.Update(dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutWebhookUUID200Response{}, nil
+}
+
+func (a *Web) DeleteWebhookUUID(ctx context.Context, request oapi.DeleteWebhookUUIDRequestObject) (oapi.DeleteWebhookUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.WebhooksService.Get(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.WebhookEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.WebhooksService.Delete(dm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteWebhookUUID200Response{}, nil
+}
+
+func (a *Web) GetWebhookUUIDDelivery(ctx context.Context, request oapi.GetWebhookUUIDDeliveryRequestObject) (oapi.GetWebhookUUIDDeliveryResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.WebhooksService.Get(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.WebhookEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := a.app.GateService.IsSuperuser(dm.FederationUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := lo.Clamp(lo.FromPtrOr(request.Params.Limit, 50), 1, webhookDeliveriesLimit)
+
+	dms, err := a.app.WebhooksService.GetDeliveries(dm.UUID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetWebhookUUIDDelivery200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.WebhookDelivery, _ int) dto.WebhookDeliveryDTO {
+			return dto.NewWebhookDeliveryDTO(item, operator)
+		}),
+	}, nil
+}
+
+func (a *Web) PostWebhookUUIDDeliveryEntityUUIDRedeliver(ctx context.Context, request oapi.PostWebhookUUIDDeliveryEntityUUIDRedeliverRequestObject) (oapi.PostWebhookUUIDDeliveryEntityUUIDRedeliverResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.WebhooksService.Get(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.WebhookEdit(dm, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := a.app.GateService.IsSuperuser(dm.FederationUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := a.app.WebhooksService.Redeliver(dm, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostWebhookUUIDDeliveryEntityUUIDRedeliver200JSONResponse(dto.NewWebhookDeliveryDTO(delivery, operator)), nil
+}
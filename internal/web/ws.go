@@ -0,0 +1,219 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/jwt"
+	"github.com/krisch/crm-backend/internal/realtime"
+)
+
+const (
+	wsWriteWait  = 10 * time.Second
+	wsPongWait   = 60 * time.Second
+	wsPingPeriod = wsPongWait * 9 / 10
+	wsReadLimit  = 4096
+)
+
+// ws - the real-time channel, authorized by the TOKEN cookie, a bearer header or ?token= for browsers
+// that cannot set headers on a websocket. The client sends PushRequest to (un)subscribe and receives
+// PushMessage of its topics.
+func ws(a *Web) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		if token := c.QueryParam("token"); token != "" && c.Request().Header.Get("Authorization") == "" {
+			c.Request().Header.Set("Authorization", "Bearer "+token)
+		}
+
+		authCtx, err := checkAuth(c, "ws", a.app.JWT)
+		if err != nil {
+			return ErrUnauthorized
+		}
+
+		claims, ok := authCtx.Request().Context().Value(claimsKey).(jwt.Claims)
+		if !ok {
+			return ErrUnauthorized
+		}
+
+		// a refreshed token cookie is lost on the hijacked response otherwise
+		var header http.Header
+		if cookies := c.Response().Header().Values("Set-Cookie"); len(cookies) > 0 {
+			header = http.Header{"Set-Cookie": cookies}
+		}
+
+		upgrader := websocket.Upgrader{CheckOrigin: a.checkOrigin}
+
+		conn, err := upgrader.Upgrade(c.Response(), c.Request(), header)
+		if err != nil {
+			// the upgrader has already replied
+			logrus.WithError(err).Debug("ws upgrade error")
+			return nil
+		}
+
+		client := realtime.NewClient(claims.Email, claims.UUID)
+		defer a.app.Realtime.Unregister(client)
+
+		go wsWrite(conn, client)
+
+		a.wsRead(c.Request().Context(), conn, client)
+
+		return nil
+	}
+}
+
+// checkOrigin - the same host or, with CORS, one of the allowed origins.
+func (a *Web) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+
+	if !a.Options.CORS_ENABLE {
+		return false
+	}
+
+	for _, allowed := range strings.Split(a.Options.CORS_ALLOWED_ORIGINS, ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Web) wsRead(ctx context.Context, conn *websocket.Conn, client *realtime.Client) {
+	conn.SetReadLimit(wsReadLimit)
+	_ = conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				logrus.WithField("user", client.Email).WithError(err).Debug("ws read error")
+			}
+			return
+		}
+
+		req := domain.PushRequest{}
+		if err := json.Unmarshal(msg, &req); err != nil {
+			a.wsReply(client, domain.PushError, "", err)
+			continue
+		}
+
+		a.wsHandle(ctx, client, req)
+	}
+}
+
+func (a *Web) wsHandle(ctx context.Context, client *realtime.Client, req domain.PushRequest) {
+	if err := req.Validate(); err != nil {
+		a.wsReply(client, domain.PushError, "", err)
+		return
+	}
+
+	topic := req.Key(client.Email)
+
+	if req.Action == "unsubscribe" {
+		a.app.Realtime.Unsubscribe(client, topic)
+		a.wsReply(client, domain.PushUnsubscribed, topic, nil)
+		return
+	}
+
+	check := a.wsAccess(ctx, client, req)
+	if check != nil {
+		if err := check(); err != nil {
+			a.wsReply(client, domain.PushError, topic, err)
+			return
+		}
+	}
+
+	a.app.Realtime.Subscribe(client, topic, check)
+	a.wsReply(client, domain.PushSubscribed, topic, nil)
+
+	if req.Topic == domain.PushTopicNotifications {
+		if err := a.app.PushNotificationsCount(ctx, client.Email); err != nil {
+			logrus.WithField("user", client.Email).WithError(err).Error("push notifications count error")
+		}
+	}
+}
+
+// wsAccess - the check of the topic, run on subscribe and then on every message the hub dispatches,
+// the task is read anew each time as its people and privacy change, the user has to be of the task company. The notifications are the user's own.
+func (a *Web) wsAccess(ctx context.Context, client *realtime.Client, req domain.PushRequest) realtime.Check {
+	switch req.Topic {
+	case domain.PushTopicTask:
+		return func() error {
+			task, err := a.app.TaskService.GetTask(ctx, req.UUID, []string{})
+			if err != nil {
+				return err
+			}
+
+			return a.app.GateService.TaskWatch(task, client.UserUUID)
+		}
+	case domain.PushTopicProject:
+		return func() error {
+			return a.app.GateService.ProjectWatch(req.UUID, client.UserUUID)
+		}
+	}
+
+	return nil
+}
+
+func (a *Web) wsReply(client *realtime.Client, kind, topic string, err error) {
+	var data interface{}
+	if err != nil {
+		data = map[string]string{"message": err.Error()}
+	}
+
+	msg, _ := domain.NewPushMessage(kind, topic, data)
+	a.app.Realtime.Send(client, msg)
+}
+
+// wsWrite is the only writer of the connection, it closes it once the hub has closed the client.
+func wsWrite(conn *websocket.Conn, client *realtime.Client) {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer func() {
+		ticker.Stop()
+		conn.Close()
+	}()
+
+	for {
+		select {
+		case b, ok := <-client.Send():
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if !ok {
+				_ = conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+
+			if err := conn.WriteMessage(websocket.TextMessage, b); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	repo   *Repository
+	sender *Sender
+
+	timeout      time.Duration
+	maxAttempts  int
+	disableAfter int
+}
+
+func New(repo *Repository, conf *configs.Configs) *Service {
+	timeout := time.Second * time.Duration(conf.WEBHOOKS_TIMEOUT)
+
+	return &Service{
+		repo:   repo,
+		sender: NewSender(timeout, conf.WEBHOOKS_ALLOW_INTERNAL),
+
+		timeout:      timeout,
+		maxAttempts:  conf.WEBHOOKS_MAX_ATTEMPTS,
+		disableAfter: conf.WEBHOOKS_DISABLE_AFTER,
+	}
+}
+
+func (s *Service) Create(dm domain.Webhook) error {
+	return s.repo.CreateWebhook(dm)
+}
+
+func (s *Service) Update(dm domain.Webhook) error {
+	if err := dm.Validate(); err != nil {
+		return err
+	}
+
+	return s.repo.UpdateWebhook(dm)
+}
+
+func (s *Service) Delete(uid uuid.UUID) error {
+	return s.repo.DeleteWebhook(uid)
+}
+
+func (s *Service) Get(uid uuid.UUID) (domain.Webhook, error) {
+	return s.repo.GetWebhook(uid)
+}
+
+// GetWebhooks - of the federation itself when companyUUID is nil.
+func (s *Service) GetWebhooks(federationUUID uuid.UUID, companyUUID *uuid.UUID) ([]domain.Webhook, error) {
+	return s.repo.GetWebhooks(federationUUID, companyUUID)
+}
+
+func (s *Service) GetDeliveries(webhookUUID uuid.UUID, limit int) ([]domain.WebhookDelivery, error) {
+	return s.repo.GetDeliveries(webhookUUID, limit)
+}
+
+// Enqueue creates a delivery of the event for every webhook subscribed to it, they are sent by Deliver.
+func (s *Service) Enqueue(_ context.Context, e domain.Event) error {
+	federationUUID, companyUUID, err := s.repo.TaskScope(e.Meta().TaskUUID)
+	if err != nil {
+		return err
+	}
+
+	hooks, err := s.repo.GetActiveWebhooks(federationUUID)
+	if err != nil {
+		return err
+	}
+
+	deliveries := []domain.WebhookDelivery{}
+	for _, hook := range hooks {
+		if !hook.Matches(e.EventName(), federationUUID, companyUUID) {
+			continue
+		}
+
+		d, err := domain.NewWebhookDelivery(hook.UUID, e)
+		if err != nil {
+			return err
+		}
+
+		deliveries = append(deliveries, *d)
+	}
+
+	return s.repo.CreateDeliveries(deliveries)
+}
+
+// Redeliver sends the payload of the delivery once more as a new delivery.
+func (s *Service) Redeliver(hook domain.Webhook, deliveryUUID uuid.UUID) (dm domain.WebhookDelivery, err error) {
+	if !hook.Active {
+		return dm, errors.New("вебхук отключен")
+	}
+
+	d, err := s.repo.GetDelivery(deliveryUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	if d.WebhookUUID != hook.UUID {
+		return dm, errors.New("доставка другого вебхука")
+	}
+
+	dm = *d.Redeliver()
+
+	return dm, s.repo.CreateDeliveries([]domain.WebhookDelivery{dm})
+}
+
+// Deliver sends the due deliveries concurrently, returns how many of them have been sent.
+func (s *Service) Deliver(ctx context.Context, now time.Time, limit int) (int, error) {
+	// a pass lasts for a timeout at most, the lease outlives it
+	deliveries, err := s.repo.ClaimDeliveries(now, 2*s.timeout+time.Minute, limit)
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+
+	hooks, err := s.repo.GetWebhooksByUUIDs(lo.Uniq(lo.Map(deliveries, func(d domain.WebhookDelivery, _ int) uuid.UUID {
+		return d.WebhookUUID
+	})))
+	if err != nil {
+		return 0, err
+	}
+
+	byUUID := lo.KeyBy(hooks, func(hook domain.Webhook) uuid.UUID {
+		return hook.UUID
+	})
+
+	sent := 0
+	lock := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	for _, d := range deliveries {
+		hook, ok := byUUID[d.WebhookUUID]
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(hook domain.Webhook, d domain.WebhookDelivery) {
+			defer wg.Done()
+
+			if s.send(ctx, hook, d) {
+				lock.Lock()
+				sent++
+				lock.Unlock()
+			}
+		}(hook, d)
+	}
+
+	wg.Wait()
+
+	return sent, nil
+}
+
+func (s *Service) send(ctx context.Context, hook domain.Webhook, d domain.WebhookDelivery) bool {
+	started := time.Now()
+	code, body, err := s.sender.Send(ctx, hook, d, started)
+
+	success := d.Attempted(code, body, err, time.Since(started), time.Now(), s.maxAttempts)
+
+	log := logrus.WithField("webhook", hook.UUID).WithField("delivery", d.UUID).WithField("event", d.Event)
+
+	disabled, err := s.repo.RecordAttempt(d, success, s.disableAfter)
+	if err != nil {
+		log.WithError(err).Error("webhook attempt save error")
+	}
+
+	if !success {
+		log.WithField("attempts", d.Attempts).WithField("error", d.LastError).Warn("webhook delivery failed")
+	}
+
+	if disabled {
+		log.WithField("url", hook.URL).Error("webhook disabled after repeated failures")
+	}
+
+	return success
+}
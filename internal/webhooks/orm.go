@@ -0,0 +1,46 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+)
+
+type Webhook struct {
+	UUID           uuid.UUID      `gorm:"<-:create;type:uuid;primary_key"`
+	FederationUUID uuid.UUID      `gorm:"<-:create;type:uuid"`
+	CompanyUUID    *uuid.UUID     `gorm:"<-:create;type:uuid"`
+	URL            string         `gorm:"type:text"`
+	Events         pq.StringArray `gorm:"type:text[];default:'{}';not null"`
+	Secret         string         `gorm:"type:varchar(200)"`
+	Active         bool           `gorm:"type:boolean"`
+	Failures       int            `gorm:"type:integer"`
+	DisabledAt     *time.Time     `gorm:"type:timestamptz"`
+
+	CreatedBy string     `gorm:"<-:create;type:varchar(255)"`
+	CreatedAt time.Time  `gorm:"<-:create;type:timestamptz"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz"`
+	DeletedAt *time.Time `gorm:"type:timestamptz"`
+}
+
+type WebhookDelivery struct {
+	UUID        uuid.UUID      `gorm:"<-:create;type:uuid;primary_key"`
+	WebhookUUID uuid.UUID      `gorm:"<-:create;type:uuid"`
+	Event       string         `gorm:"<-:create;type:varchar(50)"`
+	EventUUID   uuid.UUID      `gorm:"<-:create;type:uuid"`
+	Payload     datatypes.JSON `gorm:"<-:create;type:jsonb"`
+	Status      string         `gorm:"type:varchar(20)"`
+
+	Attempts      int        `gorm:"type:integer"`
+	NextAttemptAt *time.Time `gorm:"type:timestamptz"`
+
+	ResponseCode int    `gorm:"type:integer"`
+	ResponseBody string `gorm:"type:text"`
+	LastError    string `gorm:"type:text"`
+	Duration     int    `gorm:"type:integer"`
+
+	CreatedAt   time.Time  `gorm:"<-:create;type:timestamptz"`
+	DeliveredAt *time.Time `gorm:"type:timestamptz"`
+}
@@ -0,0 +1,320 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) CreateWebhook(dm domain.Webhook) error {
+	orm := fromDomain(dm)
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) UpdateWebhook(dm domain.Webhook) error {
+	return r.gorm.DB.Model(&Webhook{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"url":         dm.URL,
+			"events":      pq.StringArray(dm.Events),
+			"secret":      dm.Secret,
+			"active":      dm.Active,
+			"failures":    dm.Failures,
+			"disabled_at": dm.DisabledAt,
+			"updated_at":  time.Now(),
+		}).Error
+}
+
+func (r *Repository) DeleteWebhook(uid uuid.UUID) error {
+	return r.gorm.DB.Model(&Webhook{}).
+		Where("uuid = ?", uid).
+		Update("deleted_at", "now()").Error
+}
+
+func (r *Repository) GetWebhook(uid uuid.UUID) (dm domain.Webhook, err error) {
+	orm := Webhook{}
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("вебхук не найден")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return toDomain(orm), nil
+}
+
+// GetWebhooks - of the federation itself when companyUUID is nil.
+func (r *Repository) GetWebhooks(federationUUID uuid.UUID, companyUUID *uuid.UUID) ([]domain.Webhook, error) {
+	q := r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Where("deleted_at is null")
+
+	if companyUUID != nil {
+		q = q.Where("company_uuid = ?", *companyUUID)
+	} else {
+		q = q.Where("company_uuid is null")
+	}
+
+	orms := []Webhook{}
+	err := q.Order("created_at").Find(&orms).Error
+
+	return lo.Map(orms, func(item Webhook, _ int) domain.Webhook {
+		return toDomain(item)
+	}), err
+}
+
+// GetActiveWebhooks - every active webhook of the federation, its own and of its companies.
+func (r *Repository) GetActiveWebhooks(federationUUID uuid.UUID) ([]domain.Webhook, error) {
+	orms := []Webhook{}
+	err := r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Where("active").
+		Where("deleted_at is null").
+		Find(&orms).
+		Error
+
+	return lo.Map(orms, func(item Webhook, _ int) domain.Webhook {
+		return toDomain(item)
+	}), err
+}
+
+func (r *Repository) GetWebhooksByUUIDs(uids []uuid.UUID) ([]domain.Webhook, error) {
+	orms := []Webhook{}
+	err := r.gorm.DB.
+		Where("uuid IN ?", uids).
+		Where("deleted_at is null").
+		Find(&orms).
+		Error
+
+	return lo.Map(orms, func(item Webhook, _ int) domain.Webhook {
+		return toDomain(item)
+	}), err
+}
+
+// TaskScope - the federation and the company of the task, a deleted task as well.
+func (r *Repository) TaskScope(taskUUID uuid.UUID) (federationUUID, companyUUID uuid.UUID, err error) {
+	scope := struct {
+		FederationUUID uuid.UUID
+		CompanyUUID    uuid.UUID
+	}{}
+
+	res := r.gorm.DB.
+		Raw("select federation_uuid, company_uuid from tasks where uuid = ? limit 1", taskUUID).
+		Scan(&scope)
+
+	if res.Error == nil && res.RowsAffected == 0 {
+		return federationUUID, companyUUID, dto.NotFoundErr("задача не найдена")
+	}
+
+	return scope.FederationUUID, scope.CompanyUUID, res.Error
+}
+
+// RecordAttempt stores the attempt of the delivery and counts the failures of its webhook in a row,
+// true - the webhook has been disabled by this failure.
+func (r *Repository) RecordAttempt(dm domain.WebhookDelivery, success bool, disableAfter int) (disabled bool, err error) {
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&WebhookDelivery{}).
+			Where("uuid = ?", dm.UUID).
+			Updates(map[string]interface{}{
+				"status":          dm.Status,
+				"attempts":        dm.Attempts,
+				"next_attempt_at": dm.NextAttemptAt,
+				"response_code":   dm.ResponseCode,
+				"response_body":   dm.ResponseBody,
+				"last_error":      dm.LastError,
+				"duration":        dm.Duration,
+				"delivered_at":    dm.DeliveredAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		if success {
+			return tx.Model(&Webhook{}).
+				Where("uuid = ?", dm.WebhookUUID).
+				Where("failures > 0").
+				Update("failures", 0).Error
+		}
+
+		// the counter is updated in place as the deliveries of a webhook are sent concurrently
+		return tx.Raw(`update webhooks set
+				failures = failures + 1,
+				active = active and failures + 1 < @limit,
+				disabled_at = case when active and failures + 1 >= @limit then now() else disabled_at end
+			where uuid = @uuid
+			returning active = false and disabled_at is not null and failures = @limit`,
+			map[string]interface{}{"uuid": dm.WebhookUUID, "limit": disableAfter}).
+			Scan(&disabled).Error
+	})
+
+	return disabled, err
+}
+
+func (r *Repository) CreateDeliveries(dms []domain.WebhookDelivery) error {
+	if len(dms) == 0 {
+		return nil
+	}
+
+	orms := lo.Map(dms, func(item domain.WebhookDelivery, _ int) WebhookDelivery {
+		return fromDeliveryDomain(item)
+	})
+
+	return r.gorm.DB.Create(&orms).Error
+}
+
+func (r *Repository) GetDelivery(uid uuid.UUID) (dm domain.WebhookDelivery, err error) {
+	orm := WebhookDelivery{}
+	err = r.gorm.DB.Where("uuid = ?", uid).Take(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("доставка не найдена")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return toDeliveryDomain(orm), nil
+}
+
+// GetDeliveries - the log of the webhook, the latest first.
+func (r *Repository) GetDeliveries(webhookUUID uuid.UUID, limit int) ([]domain.WebhookDelivery, error) {
+	orms := []WebhookDelivery{}
+	err := r.gorm.DB.
+		Where("webhook_uuid = ?", webhookUUID).
+		Order("created_at desc").
+		Limit(limit).
+		Find(&orms).
+		Error
+
+	return lo.Map(orms, func(item WebhookDelivery, _ int) domain.WebhookDelivery {
+		return toDeliveryDomain(item)
+	}), err
+}
+
+// ClaimDeliveries takes the due deliveries of the active webhooks and puts their next attempt off
+// by the lease, so another replica does not send them meanwhile and a crashed one does not lose them.
+func (r *Repository) ClaimDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error) {
+	orms := []WebhookDelivery{}
+	err := r.gorm.DB.Raw(`update webhook_deliveries set next_attempt_at = @until
+		where uuid in (
+			select d.uuid from webhook_deliveries d
+			join webhooks w on w.uuid = d.webhook_uuid
+			where d.status = @pending
+				and d.next_attempt_at <= @now
+				and w.active
+				and w.deleted_at is null
+			order by d.next_attempt_at
+			limit @limit
+			for update of d skip locked
+		)
+		returning *`, map[string]interface{}{
+		"until":   now.Add(lease),
+		"pending": domain.WebhookDeliveryPending,
+		"now":     now,
+		"limit":   limit,
+	}).Scan(&orms).Error
+
+	return lo.Map(orms, func(item WebhookDelivery, _ int) domain.WebhookDelivery {
+		return toDeliveryDomain(item)
+	}), err
+}
+
+func fromDomain(dm domain.Webhook) Webhook {
+	return Webhook{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		URL:            dm.URL,
+		Events:         dm.Events,
+		Secret:         dm.Secret,
+		Active:         dm.Active,
+		Failures:       dm.Failures,
+		DisabledAt:     dm.DisabledAt,
+		CreatedBy:      dm.CreatedBy,
+		CreatedAt:      dm.CreatedAt,
+		UpdatedAt:      dm.UpdatedAt,
+	}
+}
+
+func toDomain(orm Webhook) domain.Webhook {
+	return domain.Webhook{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		URL:            orm.URL,
+		Events:         orm.Events,
+		Secret:         orm.Secret,
+		Active:         orm.Active,
+		Failures:       orm.Failures,
+		DisabledAt:     orm.DisabledAt,
+		CreatedBy:      orm.CreatedBy,
+		CreatedAt:      orm.CreatedAt,
+		UpdatedAt:      orm.UpdatedAt,
+	}
+}
+
+func fromDeliveryDomain(dm domain.WebhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		UUID:          dm.UUID,
+		WebhookUUID:   dm.WebhookUUID,
+		Event:         dm.Event,
+		EventUUID:     dm.EventUUID,
+		Payload:       datatypes.JSON(dm.Payload),
+		Status:        dm.Status,
+		Attempts:      dm.Attempts,
+		NextAttemptAt: dm.NextAttemptAt,
+		ResponseCode:  dm.ResponseCode,
+		ResponseBody:  dm.ResponseBody,
+		LastError:     dm.LastError,
+		Duration:      dm.Duration,
+		CreatedAt:     dm.CreatedAt,
+		DeliveredAt:   dm.DeliveredAt,
+	}
+}
+
+func toDeliveryDomain(orm WebhookDelivery) domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		UUID:          orm.UUID,
+		WebhookUUID:   orm.WebhookUUID,
+		Event:         orm.Event,
+		EventUUID:     orm.EventUUID,
+		Payload:       json.RawMessage(orm.Payload),
+		Status:        orm.Status,
+		Attempts:      orm.Attempts,
+		NextAttemptAt: orm.NextAttemptAt,
+		ResponseCode:  orm.ResponseCode,
+		ResponseBody:  orm.ResponseBody,
+		LastError:     orm.LastError,
+		Duration:      orm.Duration,
+		CreatedAt:     orm.CreatedAt,
+		DeliveredAt:   orm.DeliveredAt,
+	}
+}
@@ -0,0 +1,94 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+const responseLimit = 64 << 10
+
+// ErrInternalAddress - the webhook url resolves to an address of the internal network.
+var ErrInternalAddress = errors.New("адрес вебхука во внутренней сети")
+
+// Sender posts the payload of a delivery signed with the secret of the webhook.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender - unless allowInternal, a webhook may only reach public addresses: the address is checked
+// when dialing, after the name is resolved, so neither a redirect nor a changed dns record gets around it.
+func NewSender(timeout time.Duration, allowInternal bool) *Sender {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+	}
+
+	if !allowInternal {
+		dialer.Control = publicOnly
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Sender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			// a redirect is a failure, the receiver is to fix the url of the webhook
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *Sender) Send(ctx context.Context, hook domain.Webhook, d domain.WebhookDelivery, now time.Time) (code int, body string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+
+	timestamp := now.Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "crm-webhooks")
+	req.Header.Set(domain.WebhookHeaderEvent, d.Event)
+	req.Header.Set(domain.WebhookHeaderDelivery, d.UUID.String())
+	req.Header.Set(domain.WebhookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(domain.WebhookHeaderSignature, domain.WebhookSignature(hook.Secret, timestamp, d.Payload))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(res.Body, responseLimit))
+
+	return res.StatusCode, string(b), err
+}
+
+func publicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return ErrInternalAddress
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestSenderSend(t *testing.T) {
+	const secret = "0123456789abcdef"
+	hook := domain.Webhook{UUID: uuid.New(), Secret: secret}
+
+	d, err := domain.NewWebhookDelivery(hook.UUID, domain.TaskCreated{
+		EventMeta: domain.NewEventMeta("user@mail.ru", uuid.New(), nil),
+		Name:      "task",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(domain.WebhookHeaderTimestamp), 10, 64)
+
+		if r.Header.Get(domain.WebhookHeaderSignature) != domain.WebhookSignature(secret, timestamp, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.Header.Get(domain.WebhookHeaderEvent) != domain.EventTaskCreated || r.Header.Get(domain.WebhookHeaderDelivery) != d.UUID.String() {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	hook.URL = srv.URL
+	sender := NewSender(time.Second, true)
+
+	code, body, err := sender.Send(context.Background(), hook, *d, time.Now())
+	if err != nil || code != http.StatusOK || body != "ok" {
+		t.Errorf("Send() = %v, %v, %v", code, body, err)
+	}
+
+	hook.Secret = "another-secret-0123"
+
+	code, _, err = sender.Send(context.Background(), hook, *d, time.Now())
+	if err != nil || code != http.StatusUnauthorized {
+		t.Errorf("Send() with a wrong secret = %v, %v", code, err)
+	}
+}
+
+func TestSenderInternalAddress(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("secret"))
+	}))
+	defer srv.Close()
+
+	hook := domain.Webhook{UUID: uuid.New(), Secret: "0123456789abcdef", URL: srv.URL}
+	d := domain.WebhookDelivery{UUID: uuid.New(), Payload: []byte("{}")}
+
+	_, body, err := NewSender(time.Second, false).Send(context.Background(), hook, d, time.Now())
+	if !errors.Is(err, ErrInternalAddress) || body != "" {
+		t.Errorf("Send() to a loopback address = %q, %v, want %v", body, err, ErrInternalAddress)
+	}
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS reminders_due_idx;
+
+ALTER TABLE
+    "public"."reminders" DROP COLUMN "fired_at";
@@ -0,0 +1,9 @@
+ALTER TABLE
+    "public"."reminders"
+ADD
+    COLUMN "fired_at" timestamptz DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS reminders_due_idx ON "public"."reminders" (date_from)
+WHERE
+    fired_at IS NULL
+    AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE
+    "public"."reminders" DROP COLUMN "recurrence",
+    DROP COLUMN "occurrence";
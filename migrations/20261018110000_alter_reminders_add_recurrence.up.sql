@@ -0,0 +1,6 @@
+ALTER TABLE
+    "public"."reminders"
+ADD
+    COLUMN "recurrence" jsonb DEFAULT NULL,
+ADD
+    COLUMN "occurrence" integer NOT NULL DEFAULT 0;
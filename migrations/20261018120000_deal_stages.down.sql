@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS deals_stage;
+
+ALTER TABLE
+    "public"."deals" DROP COLUMN "stage_uuid",
+    DROP COLUMN "amount";
+
+DROP TABLE IF EXISTS "public"."deal_stages";
@@ -0,0 +1,28 @@
+CREATE TABLE "public"."deal_stages" (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "company_uuid" uuid NOT NULL,
+    "name" varchar(100) NOT NULL DEFAULT '' :: character varying,
+    "color" varchar(20) NOT NULL DEFAULT '' :: character varying,
+    "probability" int NOT NULL DEFAULT 0,
+    "transitions" jsonb NOT NULL DEFAULT '[]' :: jsonb,
+    "sort" int NOT NULL DEFAULT 0,
+    "created_by" varchar(100) NOT NULL DEFAULT '' :: character varying,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX "deal_stages_company" ON deal_stages ("company_uuid", "sort")
+WHERE
+    deleted_at IS NULL;
+
+ALTER TABLE
+    "public"."deals"
+ADD
+    COLUMN "stage_uuid" uuid DEFAULT NULL,
+ADD
+    COLUMN "amount" numeric(18, 2) NOT NULL DEFAULT 0;
+
+CREATE INDEX deals_stage ON deals (company_uuid, stage_uuid)
+WHERE
+    deleted_at IS NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS sms_federation_created;
+
+DROP INDEX IF EXISTS tasks_agents;
+
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "agents";
@@ -0,0 +1,12 @@
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "agents" uuid [] NOT NULL DEFAULT '{}';
+
+CREATE INDEX tasks_agents ON tasks USING gin ("agents")
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX sms_federation_created ON sms (federation_uuid, created_at DESC)
+WHERE
+    deleted_at IS NULL;
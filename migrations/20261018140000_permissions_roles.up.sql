@@ -0,0 +1,18 @@
+CREATE TABLE permissions.roles (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_uuid uuid NOT NULL REFERENCES users(uuid) ON DELETE CASCADE,
+    federation_uuid uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    scope varchar(20) NOT NULL,
+    entity_uuid uuid NOT NULL,
+    role varchar(20) NOT NULL DEFAULT '',
+    allow text [] NOT NULL DEFAULT '{}',
+    deny text [] NOT NULL DEFAULT '{}',
+    created_by character varying(255),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE UNIQUE INDEX "permissions_roles_user_entity" ON permissions.roles ("user_uuid", "entity_uuid");
+
+CREATE INDEX "permissions_roles_user_federation" ON permissions.roles ("user_uuid", "federation_uuid");
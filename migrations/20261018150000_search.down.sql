@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS catalog_data_search;
+
+DROP INDEX IF EXISTS comments_search;
+
+DROP INDEX IF EXISTS tasks_search;
+
+ALTER TABLE
+    "public"."catalog_data" DROP COLUMN "search";
+
+ALTER TABLE
+    "public"."comments" DROP COLUMN "search";
+
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "search";
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.ru_en;
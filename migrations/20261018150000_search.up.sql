@@ -0,0 +1,37 @@
+-- russian stems cyrillic words, english_stem takes the latin ones
+CREATE TEXT SEARCH CONFIGURATION public.ru_en (COPY = pg_catalog.russian);
+
+ALTER TEXT SEARCH CONFIGURATION public.ru_en
+    ALTER MAPPING FOR asciiword, asciihword, hword_asciipart WITH english_stem;
+
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "search" tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('public.ru_en', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('public.ru_en', coalesce(description, '')), 'B') ||
+        setweight(jsonb_to_tsvector('public.ru_en', fields, '["string"]'), 'C')
+    ) STORED;
+
+CREATE INDEX tasks_search ON tasks USING gin ("search")
+WHERE
+    deleted_at IS NULL;
+
+ALTER TABLE
+    "public"."comments"
+ADD
+    COLUMN "search" tsvector GENERATED ALWAYS AS (
+        to_tsvector('public.ru_en', coalesce(comment, ''))
+    ) STORED;
+
+CREATE INDEX comments_search ON comments USING gin ("search");
+
+ALTER TABLE
+    "public"."catalog_data"
+ADD
+    COLUMN "search" tsvector GENERATED ALWAYS AS (
+        setweight(jsonb_to_tsvector('public.ru_en', fields, '["string"]'), 'A') ||
+        setweight(to_tsvector('public.ru_en', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX catalog_data_search ON catalog_data USING gin ("search");
@@ -0,0 +1,21 @@
+CREATE TABLE task_views (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    federation_uuid uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    project_uuid uuid NOT NULL REFERENCES projects(uuid) ON DELETE CASCADE,
+    user_uuid uuid NOT NULL REFERENCES users(uuid) ON DELETE CASCADE,
+    name varchar(100) NOT NULL,
+    shared boolean NOT NULL DEFAULT false,
+    filter jsonb NOT NULL DEFAULT '{}',
+    "order" varchar(100),
+    "by" varchar(4),
+    columns text [] NOT NULL DEFAULT '{}',
+    group_by varchar(100),
+    created_by character varying(255),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE INDEX "task_views_project_user" ON task_views ("project_uuid", "user_uuid")
+WHERE
+    deleted_at IS NULL;
@@ -0,0 +1,25 @@
+-- tasks are partitioned by project, so the task uuids are not foreign keys
+CREATE TABLE task_links (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    federation_uuid uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    from_uuid uuid NOT NULL,
+    to_uuid uuid NOT NULL,
+    type varchar(20) NOT NULL,
+    created_by character varying(255) NOT NULL DEFAULT '',
+    created_by_uuid uuid,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+-- one link of a type between two tasks, whatever the direction
+CREATE UNIQUE INDEX "task_links_pair" ON task_links (LEAST(from_uuid, to_uuid), GREATEST(from_uuid, to_uuid), type)
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX "task_links_from" ON task_links ("from_uuid")
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX "task_links_to" ON task_links ("to_uuid")
+WHERE
+    deleted_at IS NULL;
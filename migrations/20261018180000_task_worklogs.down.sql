@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_timers;
+DROP TABLE IF EXISTS task_worklogs;
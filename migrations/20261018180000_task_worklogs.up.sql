@@ -0,0 +1,37 @@
+-- tasks are partitioned by project, so the task uuids are not foreign keys
+CREATE TABLE task_worklogs (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    federation_uuid uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    project_uuid uuid NOT NULL REFERENCES projects(uuid) ON DELETE CASCADE,
+    task_uuid uuid NOT NULL,
+    user_uuid uuid NOT NULL REFERENCES users(uuid) ON DELETE CASCADE,
+    started_at timestamp with time zone NOT NULL,
+    duration integer NOT NULL CHECK (duration > 0),
+    comment text NOT NULL DEFAULT '',
+    billable boolean NOT NULL DEFAULT true,
+    created_by character varying(255),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE INDEX "task_worklogs_task" ON task_worklogs ("task_uuid")
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX "task_worklogs_project" ON task_worklogs ("project_uuid", "started_at")
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX "task_worklogs_user" ON task_worklogs ("federation_uuid", "user_uuid", "started_at")
+WHERE
+    deleted_at IS NULL;
+
+-- one running timer per user
+CREATE TABLE task_timers (
+    user_uuid uuid PRIMARY KEY REFERENCES users(uuid) ON DELETE CASCADE,
+    federation_uuid uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    project_uuid uuid NOT NULL REFERENCES projects(uuid) ON DELETE CASCADE,
+    task_uuid uuid NOT NULL,
+    started_at timestamp with time zone NOT NULL DEFAULT now()
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS tasks_deadline;
+
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "deadline_stage";
@@ -0,0 +1,30 @@
+-- 0 none, 1 approaching, 2 overdue, 3 escalated; reset when finish_to changes
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "deadline_stage" smallint NOT NULL DEFAULT 0;
+
+-- the deadlines already passed or within the warning window of their project (deadline_lead_hours,
+-- 24 by default) are not to be notified once more on the first scan
+UPDATE
+    tasks t
+SET
+    deadline_stage = CASE
+        WHEN t.finish_to < now() THEN 2
+        ELSE 1
+    END
+FROM
+    projects p
+WHERE
+    p.uuid = t.project_uuid
+    AND t.deleted_at IS NULL
+    AND t.finish_to IS NOT NULL
+    AND t.finish_to < now() + make_interval(
+        hours => coalesce((p.options ->> 'deadline_lead_hours')::int, 24)
+    );
+
+CREATE INDEX tasks_deadline ON tasks (finish_to)
+WHERE
+    deleted_at IS NULL
+    AND finish_to IS NOT NULL
+    AND deadline_stage < 3;
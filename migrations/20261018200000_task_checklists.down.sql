@@ -0,0 +1,5 @@
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "checklist_done",
+    DROP COLUMN "checklist_total";
+
+DROP TABLE IF EXISTS task_checklist_items;
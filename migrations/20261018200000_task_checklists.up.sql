@@ -0,0 +1,26 @@
+-- tasks are partitioned by project, so the task uuid is not a foreign key
+CREATE TABLE task_checklist_items (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    task_uuid uuid NOT NULL,
+    position integer NOT NULL DEFAULT 0,
+    text character varying(500) NOT NULL,
+    done boolean NOT NULL DEFAULT false,
+    assigned_to character varying(100) NOT NULL DEFAULT '',
+    due_at timestamp with time zone,
+    created_by character varying(255),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE INDEX "task_checklist_items_task" ON task_checklist_items ("task_uuid", "position")
+WHERE
+    deleted_at IS NULL;
+
+-- the progress is kept on the task for the lists
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "checklist_total" integer NOT NULL DEFAULT 0,
+ADD
+    COLUMN "checklist_done" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,16 @@
+-- project_uuid is null for the templates of the whole company
+CREATE TABLE task_templates (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    company_uuid uuid NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    project_uuid uuid REFERENCES projects(uuid) ON DELETE CASCADE,
+    name varchar(100) NOT NULL,
+    root jsonb NOT NULL DEFAULT '{}',
+    created_by character varying(255),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE INDEX "task_templates_company" ON task_templates ("company_uuid", "project_uuid")
+WHERE
+    deleted_at IS NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "recurrence_uuid";
+
+DROP TABLE IF EXISTS task_recurrences;
@@ -0,0 +1,37 @@
+-- task_uuid is the source task (tasks are partitioned by project, so it is not a foreign key)
+-- or template_uuid is set; next_at is null when the series is over
+CREATE TABLE task_recurrences (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    federation_uuid uuid NOT NULL,
+    company_uuid uuid NOT NULL,
+    project_uuid uuid NOT NULL REFERENCES projects(uuid) ON DELETE CASCADE,
+    task_uuid uuid,
+    template_uuid uuid REFERENCES task_templates(uuid) ON DELETE CASCADE,
+    vars jsonb NOT NULL DEFAULT '{}',
+    schedule jsonb NOT NULL DEFAULT '{}',
+    start_at timestamp with time zone NOT NULL,
+    next_at timestamp with time zone,
+    occurrence integer NOT NULL DEFAULT 0,
+    paused boolean NOT NULL DEFAULT false,
+    finish_offset integer,
+    created_by character varying(255),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE INDEX "task_recurrences_project" ON task_recurrences ("project_uuid")
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX "task_recurrences_next" ON task_recurrences ("next_at")
+WHERE
+    deleted_at IS NULL
+    AND paused = false
+    AND next_at IS NOT NULL;
+
+-- the series a task was spawned by
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "recurrence_uuid" uuid;
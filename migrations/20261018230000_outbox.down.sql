@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS outbox_agents ON agents;
+
+DROP TRIGGER IF EXISTS outbox_reminders ON reminders;
+
+DROP TRIGGER IF EXISTS outbox_projects ON projects;
+
+DROP TRIGGER IF EXISTS outbox_comments ON comments;
+
+DROP TRIGGER IF EXISTS outbox_tasks ON tasks;
+
+DROP FUNCTION IF EXISTS outbox_capture();
+
+DROP TABLE IF EXISTS outbox_dead_letters;
+
+DROP TABLE IF EXISTS outbox;
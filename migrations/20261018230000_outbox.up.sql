@@ -0,0 +1,86 @@
+-- changes of the aggregates captured by triggers in the transaction of the change,
+-- the relay publishes them to kafka in id order and deletes them
+CREATE TABLE outbox (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL DEFAULT gen_random_uuid(),
+    aggregate character varying(50) NOT NULL,
+    aggregate_uuid uuid NOT NULL,
+    operation character varying(10) NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_error text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+-- messages the relay gave up on, kept for a manual replay
+CREATE TABLE outbox_dead_letters (
+    id bigint PRIMARY KEY,
+    uuid uuid NOT NULL,
+    aggregate character varying(50) NOT NULL,
+    aggregate_uuid uuid NOT NULL,
+    operation character varying(10) NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}',
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL,
+    failed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX "outbox_dead_letters_aggregate" ON outbox_dead_letters ("aggregate", "aggregate_uuid");
+
+-- TG_ARGV[0] is the aggregate name; an update setting deleted_at is a delete,
+-- an update changing nothing is not captured
+CREATE OR REPLACE FUNCTION outbox_capture() RETURNS trigger AS $$
+DECLARE
+    row_new jsonb;
+    row_old jsonb;
+    op character varying(10);
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        row_new := to_jsonb(NEW);
+        op := 'insert';
+    ELSIF TG_OP = 'UPDATE' THEN
+        row_new := to_jsonb(NEW);
+        row_old := to_jsonb(OLD);
+
+        IF row_new = row_old THEN
+            RETURN NULL;
+        END IF;
+
+        IF row_new ->> 'deleted_at' IS NOT NULL AND row_old ->> 'deleted_at' IS NULL THEN
+            op := 'delete';
+        ELSE
+            op := 'update';
+        END IF;
+    ELSE
+        row_new := to_jsonb(OLD);
+        op := 'delete';
+    END IF;
+
+    INSERT INTO outbox (aggregate, aggregate_uuid, operation, payload)
+    VALUES (TG_ARGV[0], (row_new ->> 'uuid')::uuid, op, row_new);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_tasks
+AFTER INSERT OR UPDATE OR DELETE ON tasks
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('task');
+
+CREATE TRIGGER outbox_comments
+AFTER INSERT OR UPDATE OR DELETE ON comments
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('comment');
+
+CREATE TRIGGER outbox_projects
+AFTER INSERT OR UPDATE OR DELETE ON projects
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('project');
+
+CREATE TRIGGER outbox_reminders
+AFTER INSERT OR UPDATE OR DELETE ON reminders
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('reminder');
+
+CREATE TRIGGER outbox_agents
+AFTER INSERT OR UPDATE OR DELETE ON agents
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('agent');
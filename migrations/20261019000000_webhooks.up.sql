@@ -0,0 +1,44 @@
+-- company_uuid is null for a webhook of the whole federation
+CREATE TABLE webhooks (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    federation_uuid uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    company_uuid uuid REFERENCES companies(uuid) ON DELETE CASCADE,
+    url text NOT NULL,
+    events text [] NOT NULL DEFAULT '{}',
+    secret character varying(200) NOT NULL,
+    active boolean NOT NULL DEFAULT true,
+    failures integer NOT NULL DEFAULT 0,
+    disabled_at timestamp with time zone,
+    created_by character varying(255),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE INDEX "webhooks_federation" ON webhooks ("federation_uuid")
+WHERE
+    deleted_at IS NULL;
+
+-- next_attempt_at is null once the delivery is over
+CREATE TABLE webhook_deliveries (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    webhook_uuid uuid NOT NULL REFERENCES webhooks(uuid) ON DELETE CASCADE,
+    event character varying(50) NOT NULL,
+    event_uuid uuid NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}',
+    status character varying(20) NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone,
+    response_code integer NOT NULL DEFAULT 0,
+    response_body text NOT NULL DEFAULT '',
+    last_error text NOT NULL DEFAULT '',
+    duration integer NOT NULL DEFAULT 0,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    delivered_at timestamp with time zone
+);
+
+CREATE INDEX "webhook_deliveries_webhook" ON webhook_deliveries ("webhook_uuid", "created_at" DESC);
+
+CREATE INDEX "webhook_deliveries_next" ON webhook_deliveries ("next_attempt_at")
+WHERE
+    status = 'pending';
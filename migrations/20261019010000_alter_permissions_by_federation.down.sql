@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS "permissions_federation_user";
+
+DELETE FROM permissions p
+USING permissions q
+WHERE p.user_uuid = q.user_uuid
+  AND p.updated_at < q.updated_at;
+
+CREATE UNIQUE INDEX "permissions_user_uuid" ON permissions ("user_uuid");
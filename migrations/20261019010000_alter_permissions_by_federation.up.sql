@@ -0,0 +1,4 @@
+-- the stored rules are per federation: a user may be a member of several
+DROP INDEX IF EXISTS "permissions_user_uuid";
+
+CREATE UNIQUE INDEX "permissions_federation_user" ON permissions ("federation_uuid", "user_uuid");
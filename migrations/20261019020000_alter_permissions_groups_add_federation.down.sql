@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS permissions."permissions_groups_federation";
+
+ALTER TABLE permissions.groups DROP COLUMN IF EXISTS "federation_uuid";
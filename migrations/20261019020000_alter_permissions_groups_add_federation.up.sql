@@ -0,0 +1,5 @@
+-- a group grants its permissions in its federation only, the groups without one grant nothing
+ALTER TABLE permissions.groups
+    ADD COLUMN "federation_uuid" uuid REFERENCES federations(uuid) ON DELETE CASCADE;
+
+CREATE INDEX "permissions_groups_federation" ON permissions.groups ("federation_uuid");
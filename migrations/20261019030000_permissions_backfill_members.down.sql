@@ -0,0 +1,7 @@
+-- the backfilled rows never changed since
+DELETE FROM permissions
+WHERE rules = '{"federation_patch": false, "federation_invite_user": true, "federation_delete_user": true, "company_create": true, "company_delete": true, "company_patch": true, "company_add_user": true, "company_delete_user": true, "project_create": true, "project_delete": true, "project_patch": true, "project_add_user": true, "project_delete_user": true, "task_create": true, "task_delete": true, "task_patch": true, "agent_create": true, "agent_delete": true, "agent_patch": true, "deal_create": true, "deal_delete": true, "deal_patch": true, "tag_patch": true, "catalog_patch": true, "catalog_data_create": true}'::jsonb
+  AND updated_at = created_at;
+
+UPDATE permissions
+SET rules = rules - '{agent_create,agent_delete,agent_patch,deal_create,deal_delete,deal_patch,tag_patch,catalog_patch,catalog_data_create}'::text[];
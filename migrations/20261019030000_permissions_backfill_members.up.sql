@@ -0,0 +1,12 @@
+-- the members who joined before the rules were enforced keep what they could do:
+-- everything in the companies, projects, tasks, agents, deals, tags and catalogs and inviting or removing members,
+-- the federation itself stays with its owner. New members get the defaults.
+INSERT INTO permissions (user_uuid, federation_uuid, rules)
+SELECT DISTINCT fu.user_uuid, fu.federation_uuid, '{"federation_patch": false, "federation_invite_user": true, "federation_delete_user": true, "company_create": true, "company_delete": true, "company_patch": true, "company_add_user": true, "company_delete_user": true, "project_create": true, "project_delete": true, "project_patch": true, "project_add_user": true, "project_delete_user": true, "task_create": true, "task_delete": true, "task_patch": true, "agent_create": true, "agent_delete": true, "agent_patch": true, "deal_create": true, "deal_delete": true, "deal_patch": true, "tag_patch": true, "catalog_patch": true, "catalog_data_create": true}'::jsonb
+FROM federation_users fu
+WHERE fu.deleted_at IS NULL
+ON CONFLICT (federation_uuid, user_uuid) DO NOTHING;
+
+-- the rows stored before the agent, deal, tag and catalog rules keep making those changes
+UPDATE permissions
+SET rules = '{"agent_create": true, "agent_delete": true, "agent_patch": true, "deal_create": true, "deal_delete": true, "deal_patch": true, "tag_patch": true, "catalog_patch": true, "catalog_data_create": true}'::jsonb || rules;
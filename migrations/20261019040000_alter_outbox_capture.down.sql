@@ -0,0 +1,67 @@
+-- TG_ARGV[0] is the aggregate name; an update setting deleted_at is a delete,
+-- an update changing nothing is not captured
+CREATE OR REPLACE FUNCTION outbox_capture() RETURNS trigger AS $$
+DECLARE
+    row_new jsonb;
+    row_old jsonb;
+    op character varying(10);
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        row_new := to_jsonb(NEW);
+        op := 'insert';
+    ELSIF TG_OP = 'UPDATE' THEN
+        row_new := to_jsonb(NEW);
+        row_old := to_jsonb(OLD);
+
+        IF row_new = row_old THEN
+            RETURN NULL;
+        END IF;
+
+        IF row_new ->> 'deleted_at' IS NOT NULL AND row_old ->> 'deleted_at' IS NULL THEN
+            op := 'delete';
+        ELSE
+            op := 'update';
+        END IF;
+    ELSE
+        row_new := to_jsonb(OLD);
+        op := 'delete';
+    END IF;
+
+    INSERT INTO outbox (aggregate, aggregate_uuid, operation, payload)
+    VALUES (TG_ARGV[0], (row_new ->> 'uuid')::uuid, op, row_new);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS outbox_tasks ON tasks;
+
+CREATE TRIGGER outbox_tasks
+AFTER INSERT OR UPDATE OR DELETE ON tasks
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('task');
+
+DROP TRIGGER IF EXISTS outbox_comments ON comments;
+
+CREATE TRIGGER outbox_comments
+AFTER INSERT OR UPDATE OR DELETE ON comments
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('comment');
+
+DROP TRIGGER IF EXISTS outbox_projects ON projects;
+
+CREATE TRIGGER outbox_projects
+AFTER INSERT OR UPDATE OR DELETE ON projects
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('project');
+
+DROP TRIGGER IF EXISTS outbox_reminders ON reminders;
+
+CREATE TRIGGER outbox_reminders
+AFTER INSERT OR UPDATE OR DELETE ON reminders
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('reminder');
+
+DROP TRIGGER IF EXISTS outbox_agents ON agents;
+
+CREATE TRIGGER outbox_agents
+AFTER INSERT OR UPDATE OR DELETE ON agents
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('agent');
+
+DROP TABLE IF EXISTS outbox_settings;
@@ -0,0 +1,90 @@
+-- whether the triggers capture at all, off until switched on by the cli with OUTBOX_CAPTURE=on
+-- once the relay is deployed, so that nothing piles up in the outbox before
+CREATE TABLE outbox_settings (
+    id boolean PRIMARY KEY DEFAULT true CHECK (id),
+    enabled boolean NOT NULL DEFAULT false
+);
+
+INSERT INTO outbox_settings (enabled) VALUES (false);
+
+-- TG_ARGV[0] is the aggregate name, the rest are the columns the system keeps up to date by itself
+-- (counters, deadline stages), an update changing only them is not captured; the search vector is
+-- never a part of the payload
+CREATE OR REPLACE FUNCTION outbox_capture() RETURNS trigger AS $$
+DECLARE
+    row_new jsonb;
+    row_old jsonb;
+    op character varying(10);
+BEGIN
+    IF NOT coalesce((SELECT enabled FROM outbox_settings), false) THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'INSERT' THEN
+        row_new := to_jsonb(NEW) - 'search';
+        op := 'insert';
+    ELSIF TG_OP = 'UPDATE' THEN
+        row_new := to_jsonb(NEW) - 'search';
+        row_old := to_jsonb(OLD) - 'search';
+
+        IF row_new - TG_ARGV[1:] = row_old - TG_ARGV[1:] THEN
+            RETURN NULL;
+        END IF;
+
+        IF row_new ->> 'deleted_at' IS NOT NULL AND row_old ->> 'deleted_at' IS NULL THEN
+            op := 'delete';
+        ELSE
+            op := 'update';
+        END IF;
+    ELSE
+        row_new := to_jsonb(OLD) - 'search';
+        op := 'delete';
+    END IF;
+
+    INSERT INTO outbox (aggregate, aggregate_uuid, operation, payload)
+    VALUES (TG_ARGV[0], (row_new ->> 'uuid')::uuid, op, row_new);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS outbox_tasks ON tasks;
+
+CREATE TRIGGER outbox_tasks
+AFTER INSERT OR UPDATE OR DELETE ON tasks
+FOR EACH ROW EXECUTE FUNCTION outbox_capture(
+    'task',
+    'updated_at',
+    'activity_at',
+    'deadline_stage',
+    'childrens_total',
+    'childrens_uuid',
+    'comments_total',
+    'checklist_total',
+    'checklist_done',
+    'first_open'
+);
+
+DROP TRIGGER IF EXISTS outbox_comments ON comments;
+
+CREATE TRIGGER outbox_comments
+AFTER INSERT OR UPDATE OR DELETE ON comments
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('comment', 'updated_at');
+
+DROP TRIGGER IF EXISTS outbox_projects ON projects;
+
+CREATE TRIGGER outbox_projects
+AFTER INSERT OR UPDATE OR DELETE ON projects
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('project', 'updated_at');
+
+DROP TRIGGER IF EXISTS outbox_reminders ON reminders;
+
+CREATE TRIGGER outbox_reminders
+AFTER INSERT OR UPDATE OR DELETE ON reminders
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('reminder', 'updated_at');
+
+DROP TRIGGER IF EXISTS outbox_agents ON agents;
+
+CREATE TRIGGER outbox_agents
+AFTER INSERT OR UPDATE OR DELETE ON agents
+FOR EACH ROW EXECUTE FUNCTION outbox_capture('agent', 'updated_at');
@@ -0,0 +1,2 @@
+-- the relay looks for an earlier message of a key waiting for its retry
+CREATE INDEX "outbox_key" ON outbox ("aggregate", "aggregate_uuid", "id");
@@ -0,0 +1,8 @@
+ALTER TABLE
+    "public"."deals"
+ALTER COLUMN
+    "tags" DROP DEFAULT,
+ALTER COLUMN
+    "tags" TYPE text USING "tags" :: text,
+ALTER COLUMN
+    "tags" SET DEFAULT '{}' :: text [];
@@ -0,0 +1,9 @@
+-- tags were stored as the text of an array literal, the filters need a real array
+ALTER TABLE
+    "public"."deals"
+ALTER COLUMN
+    "tags" DROP DEFAULT,
+ALTER COLUMN
+    "tags" TYPE text [] USING coalesce(nullif("tags", ''), '{}') :: text [],
+ALTER COLUMN
+    "tags" SET DEFAULT '{}' :: text [];
@@ -0,0 +1,5 @@
+DROP FUNCTION IF EXISTS safe_boolean(text);
+
+DROP FUNCTION IF EXISTS safe_timestamptz(text);
+
+DROP FUNCTION IF EXISTS safe_numeric(text);
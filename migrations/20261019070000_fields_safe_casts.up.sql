@@ -0,0 +1,25 @@
+-- casts of custom field values for the filters, a value of another format is null
+-- instead of an error failing the whole query
+CREATE OR REPLACE FUNCTION safe_numeric(v text) RETURNS numeric AS $$
+BEGIN
+    RETURN v::numeric;
+EXCEPTION WHEN others THEN
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION safe_timestamptz(v text) RETURNS timestamptz AS $$
+BEGIN
+    RETURN v::timestamptz;
+EXCEPTION WHEN others THEN
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+CREATE OR REPLACE FUNCTION safe_boolean(v text) RETURNS boolean AS $$
+BEGIN
+    RETURN v::boolean;
+EXCEPTION WHEN others THEN
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;